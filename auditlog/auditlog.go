@@ -0,0 +1,214 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auditlog provides a tracker that appends terminations to a local
+// file, one JSON object per line. The file is an audit trail that doesn't
+// depend on the database, and that log collectors can ship.
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+)
+
+// AuditLog is a tracker that appends terminations to a file. When the file
+// would grow past its max size, it is renamed to path.1, path.1 to path.2,
+// and so on, and a new file is started.
+type AuditLog struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu sync.Mutex
+}
+
+// Entry is a line of the audit log
+type Entry struct {
+	Time          time.Time                  `json:"time"`
+	App           string                     `json:"app"`
+	Account       string                     `json:"account"`
+	Region        string                     `json:"region"`
+	Stack         string                     `json:"stack"`
+	Cluster       string                     `json:"cluster"`
+	ASG           string                     `json:"asg"`
+	InstanceID    string                     `json:"instanceId"`
+	CloudProvider string                     `json:"cloudProvider"`
+	Leashed       bool                       `json:"leashed"`
+	FencingToken  int64                      `json:"fencingToken,omitempty"`
+	Group         string                     `json:"group,omitempty"`
+	Eligible      int                        `json:"eligible,omitempty"`
+	Reason        string                     `json:"reason,omitempty"`
+	Seed          int64                      `json:"seed,omitempty"`
+	AppConfig     *chaosmonkey.AppConfigJSON `json:"appConfig,omitempty"`
+}
+
+// NewFromConfig creates a new AuditLog taking config parameters from cfg
+func NewFromConfig(cfg *config.Monkey) (*AuditLog, error) {
+	if cfg.FileTrackerPath() == "" {
+		return nil, errors.Errorf("%s not specified", param.FileTrackerPath)
+	}
+
+	if cfg.FileTrackerMaxSizeMB() < 0 {
+		return nil, errors.Errorf("invalid %s: %d", param.FileTrackerMaxSizeMB, cfg.FileTrackerMaxSizeMB())
+	}
+
+	if cfg.FileTrackerMaxBackups() < 0 {
+		return nil, errors.Errorf("invalid %s: %d", param.FileTrackerMaxBackups, cfg.FileTrackerMaxBackups())
+	}
+
+	return New(cfg.FileTrackerPath(), int64(cfg.FileTrackerMaxSizeMB())*1024*1024, cfg.FileTrackerMaxBackups()), nil
+}
+
+// New returns an AuditLog that appends to the file at path. The file is
+// rotated once it would exceed maxSize bytes, and maxBackups rotated files
+// are kept. If maxSize is 0, the file is never rotated.
+func New(path string, maxSize int64, maxBackups int) *AuditLog {
+	return &AuditLog{path: path, maxSize: maxSize, maxBackups: maxBackups}
+}
+
+// NewEntry returns the audit log entry of a termination
+func NewEntry(t chaosmonkey.Termination) Entry {
+	i := t.Instance
+	e := Entry{
+		Time:          t.Time.UTC(),
+		App:           i.AppName(),
+		Account:       i.AccountName(),
+		Region:        i.RegionName(),
+		Stack:         i.StackName(),
+		Cluster:       i.ClusterName(),
+		ASG:           i.ASGName(),
+		InstanceID:    i.ID(),
+		CloudProvider: i.CloudProvider(),
+		Leashed:       t.Leashed,
+		FencingToken:  t.FencingToken,
+	}
+
+	if d := t.Decision; d != nil {
+		cfg := d.AppConfig.JSON()
+		e.Group = d.Group
+		e.Eligible = d.Eligible
+		e.Reason = d.Reason
+		e.Seed = d.Seed
+		e.AppConfig = &cfg
+	}
+
+	return e
+}
+
+// Track appends the termination to the file
+func (a *AuditLog) Track(t chaosmonkey.Termination) (err error) {
+	line, err := json.Marshal(NewEntry(t))
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal audit log entry")
+	}
+	line = append(line, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	err = a.rotateIfNeeded(int64(len(line)))
+	if err != nil {
+		return err
+	}
+
+	// With O_APPEND, each line is written at the end of the file even if
+	// several processes write to it
+	f, err := os.OpenFile(a.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "could not open %s", a.path)
+	}
+
+	defer func() {
+		if cerr := f.Close(); cerr != nil && err == nil {
+			err = errors.Wrapf(cerr, "could not close %s", a.path)
+		}
+	}()
+
+	_, err = f.Write(line)
+	if err != nil {
+		return errors.Wrapf(err, "could not write to %s", a.path)
+	}
+
+	// The entry must be on disk before the termination happens
+	err = f.Sync()
+	if err != nil {
+		return errors.Wrapf(err, "could not sync %s", a.path)
+	}
+
+	return nil
+}
+
+// rotateIfNeeded rotates the file if writing n more bytes to it would make
+// it exceed the max size. A file is never rotated while it's empty, so that
+// an entry larger than the max size is still written.
+func (a *AuditLog) rotateIfNeeded(n int64) error {
+	if a.maxSize == 0 {
+		return nil
+	}
+
+	fi, err := os.Stat(a.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not stat %s", a.path)
+	}
+
+	if fi.Size() == 0 || fi.Size()+n <= a.maxSize {
+		return nil
+	}
+
+	return a.rotate()
+}
+
+// rotate shifts the backups by one, dropping the oldest, and turns the file
+// into the most recent backup
+func (a *AuditLog) rotate() error {
+	if a.maxBackups == 0 {
+		err := os.Remove(a.path)
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "could not remove %s", a.path)
+		}
+		return nil
+	}
+
+	// Renaming over the oldest backup drops it
+	for i := a.maxBackups - 1; i >= 1; i-- {
+		err := os.Rename(a.backup(i), a.backup(i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "could not rotate %s", a.backup(i))
+		}
+	}
+
+	err := os.Rename(a.path, a.backup(1))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "could not rotate %s", a.path)
+	}
+
+	return nil
+}
+
+// backup returns the path of the i-th most recent backup
+func (a *AuditLog) backup(i int) string {
+	return fmt.Sprintf("%s.%d", a.path, i)
+}
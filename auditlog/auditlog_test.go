@@ -0,0 +1,180 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/mock"
+)
+
+func termination(id string) chaosmonkey.Termination {
+	return chaosmonkey.Termination{
+		Instance: mock.Instance{
+			App:        "foo",
+			Account:    "prod",
+			Region:     "us-east-1",
+			Stack:      "prod",
+			Cluster:    "foo-prod",
+			ASG:        "foo-prod-v001",
+			InstanceID: id,
+		},
+		Time:         time.Date(2026, time.October, 13, 18, 30, 0, 0, time.UTC),
+		FencingToken: 3,
+		Decision: &chaosmonkey.Decision{
+			AppConfig: chaosmonkey.AppConfig{
+				Enabled:                        true,
+				MeanTimeBetweenKillsInWorkDays: 5,
+				MinTimeBetweenKillsInWorkDays:  1,
+				Grouping:                       chaosmonkey.Cluster,
+				Exceptions:                     []chaosmonkey.Exception{{Account: "test", Stack: "*", Detail: "*", Region: "*"}},
+			},
+			Group:    "app=foo account=prod cluster=foo-prod",
+			Eligible: 4,
+			Reason:   "picked at random from 4 eligible instance(s)",
+			Seed:     1792346400,
+		},
+	}
+}
+
+// readEntries returns the entries in the file at path
+func readEntries(t *testing.T, path string) []Entry {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var result []Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Entry
+		err := json.Unmarshal(scanner.Bytes(), &e)
+		if err != nil {
+			t.Fatalf("bad line %q: %v", scanner.Text(), err)
+		}
+		result = append(result, e)
+	}
+
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestTrack(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "terminations.jsonl")
+	a := New(path, 0, 0)
+
+	for _, id := range []string{"i-1", "i-2"} {
+		err := a.Track(termination(id))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries := readEntries(t, path)
+	if got, want := len(entries), 2; got != want {
+		t.Fatalf("got %d entries, want %d", got, want)
+	}
+
+	want := Entry{
+		Time:          time.Date(2026, time.October, 13, 18, 30, 0, 0, time.UTC),
+		App:           "foo",
+		Account:       "prod",
+		Region:        "us-east-1",
+		Stack:         "prod",
+		Cluster:       "foo-prod",
+		ASG:           "foo-prod-v001",
+		InstanceID:    "i-2",
+		CloudProvider: "aws",
+		FencingToken:  3,
+		Group:         "app=foo account=prod cluster=foo-prod",
+		Eligible:      4,
+		Reason:        "picked at random from 4 eligible instance(s)",
+		Seed:          1792346400,
+		AppConfig: &chaosmonkey.AppConfigJSON{
+			Enabled:                        true,
+			MeanTimeBetweenKillsInWorkDays: 5,
+			MinTimeBetweenKillsInWorkDays:  1,
+			Grouping:                       "cluster",
+			Exceptions:                     []chaosmonkey.Exception{{Account: "test", Stack: "*", Detail: "*", Region: "*"}},
+		},
+	}
+	if got := entries[1]; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestTrackWithoutDecision(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "terminations.jsonl")
+	trm := termination("i-1")
+	trm.Decision = nil
+
+	err := New(path, 0, 0).Track(trm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := readEntries(t, path)
+	if len(entries) != 1 || entries[0].AppConfig != nil || entries[0].InstanceID != "i-1" {
+		t.Errorf("got %+v, want one entry without decision", entries)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "terminations.jsonl")
+
+	line, err := json.Marshal(NewEntry(termination("i-0")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Room for two entries per file
+	a := New(path, int64(2*(len(line)+1)), 2)
+	for _, id := range []string{"i-0", "i-1", "i-2", "i-3", "i-4", "i-5", "i-6"} {
+		err := a.Track(termination(id))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The oldest file, with i-0 and i-1, was dropped
+	files := map[string][]string{
+		path:        {"i-6"},
+		path + ".1": {"i-4", "i-5"},
+		path + ".2": {"i-2", "i-3"},
+	}
+	for file, want := range files {
+		var got []string
+		for _, e := range readEntries(t, file) {
+			got = append(got, e.InstanceID)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %v, want %v", file, got, want)
+		}
+	}
+
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("got %s.3, want only 2 backups", path)
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// API versions of the services Chaos Monkey calls
+const (
+	autoScalingVersion = "2011-01-01"
+	ec2Version         = "2016-11-15"
+)
+
+type (
+	// queryClient calls AWS services that use the Query API, such as EC2 and
+	// Auto Scaling
+	// See: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/Query-Requests.html
+	queryClient struct {
+		// endpoint, if not blank, overrides the endpoint of every service in
+		// every region. This is used for testing against an AWS stand-in
+		endpoint string
+		creds    credentialsProvider
+		http     *http.Client
+	}
+
+	// autoScalingGroup is an Auto Scaling group as returned by
+	// DescribeAutoScalingGroups
+	autoScalingGroup struct {
+		Name               string        `xml:"AutoScalingGroupName"`
+		CreatedTime        time.Time     `xml:"CreatedTime"`
+		Instances          []asgInstance `xml:"Instances>member"`
+		Tags               []tag         `xml:"Tags>member"`
+		SuspendedProcesses []struct {
+			ProcessName string `xml:"ProcessName"`
+		} `xml:"SuspendedProcesses>member"`
+
+		// region is not part of the response, it is the region that was
+		// queried
+		region string
+	}
+
+	// asgInstance is an instance in an Auto Scaling group
+	asgInstance struct {
+		InstanceID     string `xml:"InstanceId"`
+		LifecycleState string `xml:"LifecycleState"`
+	}
+
+	tag struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	}
+
+	// apiError is an error returned by an AWS service
+	apiError struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+)
+
+// inService is the lifecycle state of instances that are running and
+// healthy in an Auto Scaling group
+const inService = "InService"
+
+// suspendedProcess returns true if the named scaling process is suspended
+func (g autoScalingGroup) suspendedProcess(name string) bool {
+	for _, p := range g.SuspendedProcesses {
+		if p.ProcessName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// disabled returns true if the group has been taken out of service, as
+// Spinnaker does when it disables a server group
+func (g autoScalingGroup) disabled() bool {
+	return g.suspendedProcess("AddToLoadBalancer")
+}
+
+// tag returns the value of the tag with the given key
+func (g autoScalingGroup) tag(key string) (string, bool) {
+	for _, t := range g.Tags {
+		if t.Key == key {
+			return t.Value, true
+		}
+	}
+	return "", false
+}
+
+// serviceURL returns the endpoint of a service in a region
+func (c *queryClient) serviceURL(service string, region string) string {
+	if c.endpoint != "" {
+		return c.endpoint
+	}
+	return fmt.Sprintf("https://%s.%s.amazonaws.com/", service, region)
+}
+
+// call calls an API action and decodes the XML response into result
+func (c *queryClient) call(service string, version string, region string, action string, params url.Values, result interface{}) (err error) {
+	creds, err := c.creds.credentials()
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	for k, v := range params {
+		form[k] = v
+	}
+	form.Set("Action", action)
+	form.Set("Version", version)
+	body := []byte(form.Encode())
+
+	u := c.serviceURL(service, region)
+	req, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to create request for %s", u)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	sign(req, body, service, region, creds, time.Now())
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "%s %s failed at %s", service, action, u)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = errors.Wrapf(cerr, "body close failed at %s", u)
+		}
+	}()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "body read failed at %s", u)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("%s %s in %s failed with status code %d: %s", service, action, region, resp.StatusCode, errorMessage(respBody))
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	err = xml.Unmarshal(respBody, result)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse %s %s response", service, action)
+	}
+
+	return nil
+}
+
+// errorMessage extracts the error from the body of a failed response.
+// EC2 and Auto Scaling use different formats.
+func errorMessage(body []byte) string {
+	var resp struct {
+		Errors []apiError `xml:"Errors>Error"` // EC2
+		Error  apiError   `xml:"Error"`        // Auto Scaling
+	}
+
+	if xml.Unmarshal(body, &resp) == nil {
+		e := resp.Error
+		if len(resp.Errors) > 0 {
+			e = resp.Errors[0]
+		}
+		if e.Code != "" {
+			return e.Code + ": " + e.Message
+		}
+	}
+
+	return strings.TrimSpace(string(body))
+}
+
+// describeAutoScalingGroups returns all of the Auto Scaling groups in a region
+func (c *queryClient) describeAutoScalingGroups(region string) ([]autoScalingGroup, error) {
+	var result []autoScalingGroup
+
+	params := url.Values{}
+	params.Set("MaxRecords", "100")
+
+	for {
+		var resp struct {
+			Result struct {
+				AutoScalingGroups []autoScalingGroup `xml:"AutoScalingGroups>member"`
+				NextToken         string             `xml:"NextToken"`
+			} `xml:"DescribeAutoScalingGroupsResult"`
+		}
+
+		err := c.call("autoscaling", autoScalingVersion, region, "DescribeAutoScalingGroups", params, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, g := range resp.Result.AutoScalingGroups {
+			g.region = region
+			result = append(result, g)
+		}
+
+		if resp.Result.NextToken == "" {
+			return result, nil
+		}
+
+		params.Set("NextToken", resp.Result.NextToken)
+	}
+}
+
+// terminateInstance terminates an EC2 instance
+func (c *queryClient) terminateInstance(region string, id string) error {
+	params := url.Values{}
+	params.Set("InstanceId.1", id)
+
+	return c.call("ec2", ec2Version, region, "TerminateInstances", params, nil)
+}
@@ -0,0 +1,223 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+)
+
+// Auto Scaling group tags that hold the Chaos Monkey configuration of an app.
+// They mirror the fields of the Spinnaker chaosMonkey app attribute.
+//
+// Example:
+//
+//	chaosmonkey:enabled                              true
+//	chaosmonkey:mean_time_between_kills_in_work_days 5
+//	chaosmonkey:min_time_between_kills_in_work_days  1
+//	chaosmonkey:grouping                             cluster
+//	chaosmonkey:regions_are_independent              true
+//	chaosmonkey:exceptions                           [{"account": "test", "stack": "*", "detail": "*", "region": "*"}]
+//	chaosmonkey:notification_channel                 #foo-team
+//	chaosmonkey:kill_windows                         [{"startHour": 10, "endHour": 12}]
+//	chaosmonkey:time_zone                            Europe/Dublin
+const (
+	tagPrefix                = "chaosmonkey:"
+	tagEnabled               = tagPrefix + "enabled"
+	tagMeanTimeBetweenKills  = tagPrefix + "mean_time_between_kills_in_work_days"
+	tagMinTimeBetweenKills   = tagPrefix + "min_time_between_kills_in_work_days"
+	tagGrouping              = tagPrefix + "grouping"
+	tagRegionsAreIndependent = tagPrefix + "regions_are_independent"
+	tagExceptions            = tagPrefix + "exceptions"
+	tagNotificationChannel   = tagPrefix + "notification_channel"
+	tagKillWindows           = tagPrefix + "kill_windows"
+	tagTimeZone              = tagPrefix + "time_zone"
+)
+
+// Get implements chaosmonkey.AppConfigGetter.Get
+//
+// The configuration is read from the tags of the app's Auto Scaling groups.
+// Every group that has Chaos Monkey tags must have the same configuration.
+// If none of them do, Chaos Monkey is disabled for the app.
+func (a AWS) Get(app string) (*chaosmonkey.AppConfig, error) {
+	groups, err := a.groups(app)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) == 0 {
+		return nil, errors.Errorf("no auto scaling groups found for app %s", app)
+	}
+
+	var result *chaosmonkey.AppConfig
+	var source string
+
+	for _, g := range groups {
+		if !hasConfigTags(g) {
+			continue
+		}
+
+		cfg, err := fromTags(g)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid chaos monkey tags on %s in %s", g.Name, g.region)
+		}
+
+		if result == nil {
+			result = cfg
+			source = g.Name
+			continue
+		}
+
+		if !reflect.DeepEqual(cfg, result) {
+			return nil, errors.Errorf("chaos monkey tags on %s conflict with tags on %s", g.Name, source)
+		}
+	}
+
+	if result == nil {
+		return &chaosmonkey.AppConfig{Enabled: false, Grouping: chaosmonkey.Cluster}, nil
+	}
+
+	return result, nil
+}
+
+// hasConfigTags returns true if a group has any Chaos Monkey tags
+func hasConfigTags(g autoScalingGroup) bool {
+	for _, t := range g.Tags {
+		if strings.HasPrefix(t.Key, tagPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// fromTags returns the app config described by the tags of a group
+func fromTags(g autoScalingGroup) (*chaosmonkey.AppConfig, error) {
+	val, ok := g.tag(tagEnabled)
+	if !ok {
+		return nil, errors.Errorf("%s tag missing", tagEnabled)
+	}
+
+	enabled, err := strconv.ParseBool(val)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", tagEnabled)
+	}
+
+	cfg := chaosmonkey.AppConfig{Enabled: enabled, Grouping: chaosmonkey.Cluster}
+
+	if val, ok := g.tag(tagRegionsAreIndependent); ok {
+		cfg.RegionsAreIndependent, err = strconv.ParseBool(val)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", tagRegionsAreIndependent)
+		}
+	}
+
+	if val, ok := g.tag(tagNotificationChannel); ok {
+		cfg.NotificationChannel = val
+	}
+
+	// If not enabled, the remaining tags may be missing
+	if !enabled {
+		return &cfg, nil
+	}
+
+	cfg.MeanTimeBetweenKillsInWorkDays, err = intTag(g, tagMeanTimeBetweenKills)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.MeanTimeBetweenKillsInWorkDays <= 0 {
+		return nil, errors.Errorf("invalid %s: %d", tagMeanTimeBetweenKills, cfg.MeanTimeBetweenKillsInWorkDays)
+	}
+
+	cfg.MinTimeBetweenKillsInWorkDays, err = intTag(g, tagMinTimeBetweenKills)
+	if err != nil {
+		return nil, err
+	}
+
+	if val, ok := g.tag(tagGrouping); ok {
+		switch val {
+		case "app":
+			cfg.Grouping = chaosmonkey.App
+		case "stack":
+			cfg.Grouping = chaosmonkey.Stack
+		case "cluster":
+			cfg.Grouping = chaosmonkey.Cluster
+		default:
+			return nil, errors.Errorf("unknown grouping: %s", val)
+		}
+	}
+
+	if val, ok := g.tag(tagExceptions); ok {
+		err = json.Unmarshal([]byte(val), &cfg.Exceptions)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", tagExceptions)
+		}
+
+		// Exceptions must have a non-blank account and region field
+		for _, exception := range cfg.Exceptions {
+			if exception.Account == "" {
+				return nil, errors.New("missing account field in exception")
+			}
+
+			if exception.Region == "" {
+				return nil, errors.New("missing region field in exception")
+			}
+		}
+	}
+
+	if val, ok := g.tag(tagKillWindows); ok {
+		err = json.Unmarshal([]byte(val), &cfg.KillWindows)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", tagKillWindows)
+		}
+
+		err = chaosmonkey.CheckKillWindows(cfg.KillWindows)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", tagKillWindows)
+		}
+	}
+
+	if val, ok := g.tag(tagTimeZone); ok {
+		cfg.TimeZone = val
+		_, err = cfg.Location(time.UTC)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", tagTimeZone)
+		}
+	}
+
+	return &cfg, nil
+}
+
+// intTag returns the value of a required integer tag
+func intTag(g autoScalingGroup, key string) (int, error) {
+	val, ok := g.tag(key)
+	if !ok {
+		return 0, errors.Errorf("%s tag missing", key)
+	}
+
+	result, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s", key)
+	}
+
+	return result, nil
+}
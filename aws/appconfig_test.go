@@ -0,0 +1,93 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Netflix/chaosmonkey/v2"
+)
+
+func groupWithTags(tags map[string]string) autoScalingGroup {
+	g := autoScalingGroup{Name: "foo-prod-v001"}
+	for k, v := range tags {
+		g.Tags = append(g.Tags, tag{Key: k, Value: v})
+	}
+	return g
+}
+
+func TestFromTags(t *testing.T) {
+	tests := []struct {
+		tags map[string]string
+		want chaosmonkey.AppConfig
+	}{
+		{
+			map[string]string{tagEnabled: "false"},
+			chaosmonkey.AppConfig{Enabled: false, Grouping: chaosmonkey.Cluster},
+		},
+		{
+			map[string]string{
+				tagEnabled:               "true",
+				tagMeanTimeBetweenKills:  "3",
+				tagMinTimeBetweenKills:   "2",
+				tagRegionsAreIndependent: "true",
+				tagGrouping:              "app",
+				tagExceptions:            `[{"account": "legacy", "stack": "*", "detail": "*", "region": "eu-west-1"}]`,
+				tagNotificationChannel:   "#foo-team",
+			},
+			chaosmonkey.AppConfig{
+				Enabled:                        true,
+				RegionsAreIndependent:          true,
+				MeanTimeBetweenKillsInWorkDays: 3,
+				MinTimeBetweenKillsInWorkDays:  2,
+				Grouping:                       chaosmonkey.App,
+				Exceptions:                     []chaosmonkey.Exception{{Account: "legacy", Stack: "*", Detail: "*", Region: "eu-west-1"}},
+				NotificationChannel:            "#foo-team",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		cfg, err := fromTags(groupWithTags(tt.tags))
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", tt.tags, err)
+			continue
+		}
+
+		if got, want := *cfg, tt.want; !reflect.DeepEqual(got, want) {
+			t.Errorf("%v: got %+v, want %+v", tt.tags, got, want)
+		}
+	}
+}
+
+func TestFromTagsInvalid(t *testing.T) {
+	tests := []map[string]string{
+		{tagGrouping: "app"},
+		{tagEnabled: "yes please"},
+		{tagEnabled: "true", tagMinTimeBetweenKills: "1"},
+		{tagEnabled: "true", tagMeanTimeBetweenKills: "0", tagMinTimeBetweenKills: "1"},
+		{tagEnabled: "true", tagMeanTimeBetweenKills: "5"},
+		{tagEnabled: "true", tagMeanTimeBetweenKills: "5", tagMinTimeBetweenKills: "1", tagGrouping: "region"},
+		{tagEnabled: "true", tagMeanTimeBetweenKills: "5", tagMinTimeBetweenKills: "1", tagExceptions: `[{"account": "legacy"}]`},
+	}
+
+	for _, tags := range tests {
+		_, err := fromTags(groupWithTags(tags))
+		if err == nil {
+			t.Errorf("%v: expected error", tags)
+		}
+	}
+}
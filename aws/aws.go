@@ -0,0 +1,346 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aws provides a deploy.Deployment, a chaosmonkey.Terminator and a
+// chaosmonkey.AppConfigGetter that call the EC2 and Auto Scaling APIs
+// directly, for AWS accounts that are not managed by Spinnaker.
+//
+// Apps, stacks and clusters are derived from Auto Scaling group names, which
+// must follow the app-stack-detail-vNNN naming convention. App configuration
+// is read from Auto Scaling group tags.
+package aws
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/SmartThingsOSS/frigga-go"
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	D "github.com/Netflix/chaosmonkey/v2/deploy"
+	"github.com/Netflix/chaosmonkey/v2/deps"
+)
+
+// CloudProvider is the cloud provider of AWS accounts
+const CloudProvider = "aws"
+
+// AWS implements the deploy.Deployment interface by querying Auto Scaling
+// groups, the chaosmonkey.Terminator interface by terminating EC2
+// instances, and the chaosmonkey.AppConfigGetter interface by reading Auto
+// Scaling group tags
+type AWS struct {
+	api     *queryClient
+	account string
+	regions []string
+}
+
+// NewFromConfig returns an AWS based on config.
+//
+// If no access key is configured, the credentials are taken from the
+// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment
+// variables if they are set, and from the instance profile otherwise.
+func NewFromConfig(cfg *config.Monkey) (AWS, error) {
+	if cfg.AWSAccount() == "" {
+		return AWS{}, errors.Errorf("%s not specified", param.AWSAccount)
+	}
+
+	regions, err := cfg.AWSRegions()
+	if err != nil {
+		return AWS{}, err
+	}
+
+	if len(regions) == 0 {
+		return AWS{}, errors.Errorf("%s not specified", param.AWSRegions)
+	}
+
+	accessKeyID := cfg.AWSAccessKeyID()
+	var secretAccessKey, sessionToken string
+
+	if accessKeyID != "" {
+		decryptor, err := deps.GetDecryptor(cfg)
+		if err != nil {
+			return AWS{}, err
+		}
+
+		secretAccessKey, err = decryptor.Decrypt(cfg.AWSEncryptedSecretAccessKey())
+		if err != nil {
+			return AWS{}, err
+		}
+	} else {
+		accessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+		secretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		sessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+
+	return New(cfg.AWSEndpoint(), cfg.AWSAccount(), regions, accessKeyID, secretAccessKey, sessionToken, cfg.AWSTimeout()), nil
+}
+
+// New returns an AWS that reports the Auto Scaling groups in regions as
+// belonging to account.
+//
+// If endpoint is not blank, all API calls are sent to it instead of the AWS
+// endpoints. If accessKeyID is blank, the credentials of the instance profile
+// are used. Each API call times out after timeout.
+func New(endpoint string, account string, regions []string, accessKeyID string, secretAccessKey string, sessionToken string, timeout time.Duration) AWS {
+	var creds credentialsProvider
+	if accessKeyID != "" {
+		creds = staticCredentials{AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey, SessionToken: sessionToken}
+	} else {
+		creds = newInstanceCredentials()
+	}
+
+	api := &queryClient{endpoint: endpoint, creds: creds, http: &http.Client{Timeout: timeout}}
+
+	return AWS{api: api, account: account, regions: regions}
+}
+
+// groups returns the Auto Scaling groups of an app in all regions, or of all
+// apps if app is blank. Groups whose names can't be parsed are ignored.
+func (a AWS) groups(app string) ([]autoScalingGroup, error) {
+	var result []autoScalingGroup
+
+	for _, region := range a.regions {
+		groups, err := a.api.describeAutoScalingGroups(region)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, g := range groups {
+			names, err := frigga.Parse(g.Name)
+			if err != nil {
+				log.Printf("WARNING: ignoring auto scaling group %s in %s: %v", g.Name, region, err)
+				continue
+			}
+
+			if app == "" || names.App == app {
+				result = append(result, g)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// clusterName returns the cluster that an Auto Scaling group belongs to
+func clusterName(g autoScalingGroup) D.ClusterName {
+	names, err := frigga.Parse(g.Name)
+	if err != nil {
+		// groups() only returns groups with valid names
+		panic(err)
+	}
+	return D.ClusterName(names.Cluster)
+}
+
+// instanceIDs returns the ids of the in-service instances of a group
+func instanceIDs(g autoScalingGroup) []D.InstanceID {
+	result := []D.InstanceID{}
+	for _, ins := range g.Instances {
+		if ins.LifecycleState == inService {
+			result = append(result, D.InstanceID(ins.InstanceID))
+		}
+	}
+	return result
+}
+
+// Apps implements deploy.Deployment.Apps
+func (a AWS) Apps(c chan<- *D.App, appNames []string) {
+	// Close the channel we're done
+	defer close(c)
+
+	for _, appName := range appNames {
+		app, err := a.GetApp(appName)
+		if err != nil {
+			// If we have a problem with one app, we go to the next one
+			log.Printf("WARNING: GetApp failed for %s: %v", appName, err)
+			continue
+		}
+
+		c <- app
+	}
+}
+
+// GetApp implements deploy.Deployment.GetApp
+func (a AWS) GetApp(appName string) (*D.App, error) {
+	groups, err := a.groups(appName)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make(D.ClusterMap)
+
+	for _, g := range groups {
+		// We don't terminate instances in disabled ASGs
+		if g.disabled() {
+			continue
+		}
+
+		cluster := clusterName(g)
+		region := D.RegionName(g.region)
+
+		if _, ok := clusters[cluster]; !ok {
+			clusters[cluster] = make(map[D.RegionName]map[D.ASGName][]D.InstanceID)
+		}
+
+		if _, ok := clusters[cluster][region]; !ok {
+			clusters[cluster][region] = make(map[D.ASGName][]D.InstanceID)
+		}
+
+		clusters[cluster][region][D.ASGName(g.Name)] = instanceIDs(g)
+	}
+
+	data := D.AppMap{
+		D.AccountName(a.account): D.AccountInfo{
+			CloudProvider: CloudProvider,
+			Clusters:      clusters,
+		},
+	}
+
+	return D.NewApp(appName, data), nil
+}
+
+// AppNames implements deploy.Deployment.AppNames
+func (a AWS) AppNames() ([]string, error) {
+	groups, err := a.groups("")
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]bool)
+	for _, g := range groups {
+		names, err := frigga.Parse(g.Name)
+		if err != nil {
+			continue
+		}
+		m[names.App] = true
+	}
+
+	result := make([]string, 0, len(m))
+	for name := range m {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// GetInstanceIDs implements deploy.Deployment.GetInstanceIDs
+// If a cluster has several enabled Auto Scaling groups in the region, the
+// most recently created one is used
+func (a AWS) GetInstanceIDs(app string, account D.AccountName, cloudProvider string, region D.RegionName, cluster D.ClusterName) (D.ASGName, []D.InstanceID, error) {
+	if string(account) != a.account {
+		return "", nil, errors.Errorf("unknown aws account: %s", account)
+	}
+
+	groups, err := a.groups(app)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var current *autoScalingGroup
+	for i, g := range groups {
+		if g.region != string(region) || clusterName(g) != cluster || g.disabled() {
+			continue
+		}
+
+		if current == nil || g.CreatedTime.After(current.CreatedTime) {
+			current = &groups[i]
+		}
+	}
+
+	if current == nil {
+		return "", nil, errors.Errorf("no enabled auto scaling group for cluster %s in %s", cluster, region)
+	}
+
+	return D.ASGName(current.Name), instanceIDs(*current), nil
+}
+
+// GetClusterNames implements deploy.Deployment.GetClusterNames
+func (a AWS) GetClusterNames(app string, account D.AccountName) ([]D.ClusterName, error) {
+	if string(account) != a.account {
+		return nil, nil
+	}
+
+	groups, err := a.groups(app)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[D.ClusterName]bool)
+	result := []D.ClusterName{}
+	for _, g := range groups {
+		cluster := clusterName(g)
+		if !m[cluster] {
+			m[cluster] = true
+			result = append(result, cluster)
+		}
+	}
+
+	return result, nil
+}
+
+// GetRegionNames implements deploy.Deployment.GetRegionNames
+func (a AWS) GetRegionNames(app string, account D.AccountName, cluster D.ClusterName) ([]D.RegionName, error) {
+	if string(account) != a.account {
+		return nil, nil
+	}
+
+	groups, err := a.groups(app)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[D.RegionName]bool)
+	result := []D.RegionName{}
+	for _, g := range groups {
+		region := D.RegionName(g.region)
+		if clusterName(g) == cluster && !m[region] {
+			m[region] = true
+			result = append(result, region)
+		}
+	}
+
+	return result, nil
+}
+
+// CloudProvider implements deploy.Deployment.CloudProvider
+func (a AWS) CloudProvider(account string) (string, error) {
+	if account != a.account {
+		return "", errors.Errorf("unknown aws account: %s", account)
+	}
+
+	return CloudProvider, nil
+}
+
+// Execute implements chaosmonkey.Terminator.Execute by terminating the EC2
+// instance. Its Auto Scaling group is responsible for replacing it.
+func (a AWS) Execute(trm chaosmonkey.Termination) error {
+	ins := trm.Instance
+
+	if ins.AccountName() != a.account {
+		return errors.Errorf("unknown aws account: %s", ins.AccountName())
+	}
+
+	err := a.api.terminateInstance(ins.RegionName(), ins.ID())
+	if err != nil {
+		return errors.Wrapf(err, "failed to terminate instance %s in %s", ins.ID(), ins.RegionName())
+	}
+
+	return nil
+}
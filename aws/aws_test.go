@@ -0,0 +1,346 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	D "github.com/Netflix/chaosmonkey/v2/deploy"
+	"github.com/Netflix/chaosmonkey/v2/eligible"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/mock"
+)
+
+// standIn is a local stand-in for the EC2 and Auto Scaling APIs
+type standIn struct {
+	t *testing.T
+
+	// groups maps region to the DescribeAutoScalingGroups response members,
+	// one page per entry
+	groups map[string][]string
+
+	terminated []string
+}
+
+var scopeRegion = regexp.MustCompile(`Credential=AKIDEXAMPLE/\d{8}/([a-z0-9-]+)/([a-z0-9]+)/aws4_request`)
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m := scopeRegion.FindStringSubmatch(r.Header.Get("Authorization"))
+	if m == nil {
+		s.t.Errorf("unsigned request: %s", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	region, service := m[1], m[2]
+
+	if err := r.ParseForm(); err != nil {
+		s.t.Fatal(err)
+	}
+
+	switch action := r.PostForm.Get("Action"); {
+	case service == "autoscaling" && action == "DescribeAutoScalingGroups":
+		pages := s.groups[region]
+		page := 0
+		if token := r.PostForm.Get("NextToken"); token != "" {
+			fmt.Sscanf(token, "page-%d", &page)
+		}
+
+		var next string
+		if page+1 < len(pages) {
+			next = fmt.Sprintf("<NextToken>page-%d</NextToken>", page+1)
+		}
+
+		var members string
+		if page < len(pages) {
+			members = pages[page]
+		}
+
+		fmt.Fprintf(w, `<DescribeAutoScalingGroupsResponse xmlns="http://autoscaling.amazonaws.com/doc/2011-01-01/">
+  <DescribeAutoScalingGroupsResult>
+    <AutoScalingGroups>%s</AutoScalingGroups>
+    %s
+  </DescribeAutoScalingGroupsResult>
+</DescribeAutoScalingGroupsResponse>`, members, next)
+	case service == "ec2" && action == "TerminateInstances":
+		id := r.PostForm.Get("InstanceId.1")
+		if !strings.HasPrefix(id, "i-") {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `<Response><Errors><Error><Code>InvalidInstanceID.Malformed</Code><Message>Invalid id: "%s"</Message></Error></Errors></Response>`, id)
+			return
+		}
+		s.terminated = append(s.terminated, region+"/"+id)
+		fmt.Fprint(w, `<TerminateInstancesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"></TerminateInstancesResponse>`)
+	default:
+		s.t.Errorf("unexpected request: %s %s", service, action)
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+// asgXML returns an Auto Scaling group response member
+func asgXML(name string, created string, disabled bool, tags map[string]string, instances ...string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<member><AutoScalingGroupName>%s</AutoScalingGroupName><CreatedTime>%s</CreatedTime><Instances>", name, created)
+	for _, ins := range instances {
+		state := inService
+		if strings.HasSuffix(ins, "-pending") {
+			state = "Pending"
+		}
+		fmt.Fprintf(&b, "<member><InstanceId>%s</InstanceId><LifecycleState>%s</LifecycleState></member>", ins, state)
+	}
+	b.WriteString("</Instances><SuspendedProcesses>")
+	if disabled {
+		b.WriteString("<member><ProcessName>AddToLoadBalancer</ProcessName></member>")
+	}
+	b.WriteString("</SuspendedProcesses><Tags>")
+	for k, v := range tags {
+		fmt.Fprintf(&b, "<member><Key>%s</Key><Value>%s</Value></member>", k, v)
+	}
+	b.WriteString("</Tags></member>")
+	return b.String()
+}
+
+var fooTags = map[string]string{
+	"chaosmonkey:enabled":                              "true",
+	"chaosmonkey:mean_time_between_kills_in_work_days": "5",
+	"chaosmonkey:min_time_between_kills_in_work_days":  "1",
+	"chaosmonkey:grouping":                             "stack",
+}
+
+// newStandIn returns a stand-in with:
+//
+//	us-east-1: foo-prod-v001 (disabled), foo-prod-v002, bar (page 1)
+//	           foo-staging-v010 (page 2)
+//	us-west-2: foo-prod-v005
+func newStandIn(t *testing.T) *standIn {
+	return &standIn{
+		t: t,
+		groups: map[string][]string{
+			"us-east-1": {
+				asgXML("foo-prod-v001", "2026-01-01T10:00:00Z", true, fooTags, "i-00000001") +
+					asgXML("foo-prod-v002", "2026-01-02T10:00:00Z", false, fooTags, "i-00000002", "i-00000003", "i-00000004-pending") +
+					asgXML("bar", "2025-06-01T10:00:00Z", false, nil, "i-0000000b"),
+				asgXML("foo-staging-v010", "2026-01-03T10:00:00.123Z", false, nil, "i-00000005"),
+			},
+			"us-west-2": {
+				asgXML("foo-prod-v005", "2026-01-02T10:00:00Z", false, fooTags, "i-00000006"),
+			},
+		},
+	}
+}
+
+func newTestAWS(t *testing.T) (AWS, *standIn, func()) {
+	s := newStandIn(t)
+	ts := httptest.NewServer(s)
+	a := New(ts.URL, "legacy", []string{"us-east-1", "us-west-2"}, "AKIDEXAMPLE", "secret", "", 5*time.Second)
+	return a, s, ts.Close
+}
+
+func TestAppNames(t *testing.T) {
+	a, _, done := newTestAWS(t)
+	defer done()
+
+	names, err := a.AppNames()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := names, []string{"bar", "foo"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got AppNames()=%v, want %v", got, want)
+	}
+}
+
+func TestGetApp(t *testing.T) {
+	a, _, done := newTestAWS(t)
+	defer done()
+
+	app, err := a.GetApp("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// asg -> instance ids
+	got := make(map[string][]string)
+	for _, account := range app.Accounts() {
+		if account.Name() != "legacy" || account.CloudProvider() != CloudProvider {
+			t.Errorf("got account %s (%s), want legacy (%s)", account.Name(), account.CloudProvider(), CloudProvider)
+		}
+		for _, cluster := range account.Clusters() {
+			for _, asg := range cluster.ASGs() {
+				var ids []string
+				for _, ins := range asg.Instances() {
+					ids = append(ids, ins.ID())
+				}
+				got[asg.RegionName()+"/"+cluster.Name()+"/"+asg.Name()] = ids
+			}
+		}
+	}
+
+	want := map[string][]string{
+		"us-east-1/foo-prod/foo-prod-v002":       {"i-00000002", "i-00000003"},
+		"us-east-1/foo-staging/foo-staging-v010": {"i-00000005"},
+		"us-west-2/foo-prod/foo-prod-v005":       {"i-00000006"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestGetInstanceIDs(t *testing.T) {
+	a, _, done := newTestAWS(t)
+	defer done()
+
+	asg, ids, err := a.GetInstanceIDs("foo", "legacy", CloudProvider, "us-east-1", "foo-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := asg, D.ASGName("foo-prod-v002"); got != want {
+		t.Errorf("got asg=%s, want %s", got, want)
+	}
+
+	if got, want := ids, []D.InstanceID{"i-00000002", "i-00000003"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got ids=%v, want %v", got, want)
+	}
+}
+
+func TestRegionsAndClusters(t *testing.T) {
+	a, _, done := newTestAWS(t)
+	defer done()
+
+	clusters, err := a.GetClusterNames("foo", "legacy")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := clusters, []D.ClusterName{"foo-prod", "foo-staging"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got GetClusterNames()=%v, want %v", got, want)
+	}
+
+	regions, err := a.GetRegionNames("foo", "legacy", "foo-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := regions, []D.RegionName{"us-east-1", "us-west-2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got GetRegionNames()=%v, want %v", got, want)
+	}
+
+	_, err = a.CloudProvider("prod")
+	if err == nil {
+		t.Error("expected error for unknown account")
+	}
+}
+
+// eligible.Instances must work on top of the AWS deployment
+func TestEligibleInstances(t *testing.T) {
+	a, _, done := newTestAWS(t)
+	defer done()
+
+	instances, err := eligible.Instances(grp.New("foo", "legacy", "", "prod", ""), nil, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, ins := range instances {
+		ids = append(ids, ins.ID())
+	}
+	sort.Strings(ids)
+
+	if got, want := ids, []string{"i-00000002", "i-00000003", "i-00000006"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestExecute(t *testing.T) {
+	a, s, done := newTestAWS(t)
+	defer done()
+
+	ins := mock.Instance{App: "foo", Account: "legacy", Region: "us-west-2", Stack: "prod", Cluster: "foo-prod", ASG: "foo-prod-v005", InstanceID: "i-00000006"}
+
+	err := a.Execute(chaosmonkey.Termination{Instance: ins, Time: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := s.terminated, []string{"us-west-2/i-00000006"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got terminated=%v, want %v", got, want)
+	}
+
+	ins.InstanceID = "bogus"
+	err = a.Execute(chaosmonkey.Termination{Instance: ins, Time: time.Now()})
+	if err == nil || !strings.Contains(err.Error(), "InvalidInstanceID.Malformed") {
+		t.Errorf("got err=%v, want InvalidInstanceID.Malformed error", err)
+	}
+}
+
+func TestGet(t *testing.T) {
+	a, _, done := newTestAWS(t)
+	defer done()
+
+	cfg, err := a.Get("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := chaosmonkey.AppConfig{
+		Enabled:                        true,
+		MeanTimeBetweenKillsInWorkDays: 5,
+		MinTimeBetweenKillsInWorkDays:  1,
+		Grouping:                       chaosmonkey.Stack,
+	}
+
+	if got := *cfg; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	// No tags means disabled
+	cfg, err = a.Get("bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Enabled {
+		t.Error("got enabled config for app without tags")
+	}
+
+	_, err = a.Get("missing")
+	if err == nil {
+		t.Error("expected error for app without auto scaling groups")
+	}
+}
+
+func TestGetConflictingTags(t *testing.T) {
+	a, s, done := newTestAWS(t)
+	defer done()
+
+	tags := map[string]string{"chaosmonkey:enabled": "false"}
+	s.groups["us-west-2"] = []string{asgXML("foo-prod-v005", "2026-01-02T10:00:00Z", false, tags, "i-00000006")}
+
+	_, err := a.Get("foo")
+	if err == nil || !strings.Contains(err.Error(), "conflict") {
+		t.Errorf("got err=%v, want conflict error", err)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// credentials are the AWS credentials used to sign requests
+	credentials struct {
+		AccessKeyID     string
+		SecretAccessKey string
+		SessionToken    string
+		Expiration      time.Time
+	}
+
+	// credentialsProvider supplies the credentials for each request
+	credentialsProvider interface {
+		credentials() (credentials, error)
+	}
+
+	// staticCredentials are credentials that never change
+	staticCredentials credentials
+
+	// instanceCredentials retrieves the credentials of the instance profile
+	// from the EC2 instance metadata service, and caches them until shortly
+	// before they expire
+	instanceCredentials struct {
+		endpoint string
+		client   *http.Client
+
+		mu     sync.Mutex
+		cached credentials
+	}
+)
+
+const (
+	// imdsEndpoint is the address of the EC2 instance metadata service
+	imdsEndpoint = "http://169.254.169.254"
+
+	// refreshWindow is how long before they expire instance credentials
+	// are refreshed
+	refreshWindow = 5 * time.Minute
+)
+
+func (s staticCredentials) credentials() (credentials, error) {
+	return credentials(s), nil
+}
+
+// newInstanceCredentials returns a provider for the credentials of the
+// instance profile of the EC2 instance Chaos Monkey is running on
+func newInstanceCredentials() *instanceCredentials {
+	return &instanceCredentials{endpoint: imdsEndpoint, client: &http.Client{Timeout: 5 * time.Second}}
+}
+
+func (c *instanceCredentials) credentials() (credentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cached.AccessKeyID != "" && time.Now().Add(refreshWindow).Before(c.cached.Expiration) {
+		return c.cached, nil
+	}
+
+	creds, err := c.retrieve()
+	if err != nil {
+		return credentials{}, errors.Wrap(err, "failed to retrieve instance profile credentials")
+	}
+
+	c.cached = creds
+	return creds, nil
+}
+
+// retrieve retrieves credentials from the instance metadata service, using
+// IMDSv2 session tokens
+// See: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/iam-roles-for-amazon-ec2.html
+func (c *instanceCredentials) retrieve() (credentials, error) {
+	req, err := http.NewRequest(http.MethodPut, c.endpoint+"/latest/api/token", nil)
+	if err != nil {
+		return credentials{}, err
+	}
+	req.Header.Set("X-aws-ec2-metadata-token-ttl-seconds", "60")
+
+	token, err := c.get(req)
+	if err != nil {
+		return credentials{}, err
+	}
+
+	path := "/latest/meta-data/iam/security-credentials/"
+	role, err := c.getMetadata(path, token)
+	if err != nil {
+		return credentials{}, err
+	}
+
+	// The response is a list of roles, with only one entry
+	role = strings.TrimSpace(strings.SplitN(role, "\n", 2)[0])
+	if role == "" {
+		return credentials{}, errors.New("no instance profile associated with the instance")
+	}
+
+	body, err := c.getMetadata(path+role, token)
+	if err != nil {
+		return credentials{}, err
+	}
+
+	var resp struct {
+		AccessKeyID     string `json:"AccessKeyId"`
+		SecretAccessKey string
+		Token           string
+		Expiration      time.Time
+	}
+
+	err = json.Unmarshal([]byte(body), &resp)
+	if err != nil {
+		return credentials{}, errors.Wrap(err, "failed to parse instance profile credentials")
+	}
+
+	return credentials{
+		AccessKeyID:     resp.AccessKeyID,
+		SecretAccessKey: resp.SecretAccessKey,
+		SessionToken:    resp.Token,
+		Expiration:      resp.Expiration,
+	}, nil
+}
+
+func (c *instanceCredentials) getMetadata(path string, token string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, c.endpoint+path, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-aws-ec2-metadata-token", token)
+
+	return c.get(req)
+}
+
+func (c *instanceCredentials) get(req *http.Request) (result string, err error) {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "%s failed at %s", req.Method, req.URL)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = errors.Wrapf(cerr, "body close failed at %s", req.URL)
+		}
+	}()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "body read failed at %s", req.URL)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("unexpected response code (%d) from %s", resp.StatusCode, req.URL)
+	}
+
+	return string(body), nil
+}
@@ -15,13 +15,18 @@
 // Package cal has calendar-related functions
 package cal
 
-import "fmt"
-import "time"
+import (
+	"fmt"
+	"time"
 
-// IsWorkday returns true if the date associated with t is a work day
+	"github.com/pkg/errors"
+)
+
+// IsWorkday returns true if the date associated with t is a work day in the
+// current calendar, see Set
 // Uses the location associated with t to make this calculation
 func IsWorkday(t time.Time) bool {
-	return isWeekday(t)
+	return Current().IsWorkday(t)
 }
 
 func isWeekday(t time.Time) bool {
@@ -34,3 +39,66 @@ func isWeekday(t time.Time) bool {
 
 	panic(fmt.Sprintf("Unknown weekday: %s", t.Weekday()))
 }
+
+// NoKillsSince computes the date of the most recent kill
+// that conforms to the min time between kills specified
+// by days
+//
+// Note that the calculation is min time in work days, so it does not count
+// weekends or holidays.
+//
+// now is the current time
+// endHour is the hour of the end of a workday in 24-hour time. For example, if
+// workday ends at 5PM, this would be 17
+// loc is the location that corresponds to endHour, e.g. America/Los_Angeles for PST
+//
+// # The returned time will be in UTC
+//
+// If days=1, then we allow
+// kills each day, so the most recent kill will be at the
+// end of the previous workday. For example:
+//
+//	days: 1
+//	endHour: 17 (i.e. work day ends at 5PM local time)
+//	loc:  America/Los_Angeles (PST)
+//	now: Wed, Dec. 16, 2015 2:30 PM PST
+//	Output: Tue, Dec. 15, 2015 5:00 PM PST
+//
+// If days=0, returns the current date, with
+// the time set to endHour. For example:
+//
+//	days: 0
+//	endHour: 17 (i.e. work day ends at 5PM local time)
+//	loc:  America/Los_Angeles (PST)
+//	now: Wed, Dec. 16, 2015 2:30 PM PST
+//	Output: Wed, Dec. 16, 2015 5:00 PM PST
+//
+// NoKillsSince returns the a datetime that is the last allowed time that a kill
+// is permitted to have happened.
+func NoKillsSince(days int, now time.Time, endHour int, loc *time.Location) (time.Time, error) {
+	if days < 0 {
+		return time.Time{}, errors.Errorf("NoKillsSince passed illegal input: days=%d", days)
+	}
+
+	oneDay := time.Hour * 24
+
+	// Tail-recursive helper function reads clearer than writing a
+	// traditional loop
+	//
+	// It expects a time localized to the zone associated with endHour because
+	// workday and year-month-day values depend on the local timezone
+	var helper func(N int, tInLoc time.Time) time.Time
+
+	helper = func(N int, tInLoc time.Time) time.Time {
+		switch {
+		case !IsWorkday(tInLoc):
+			return helper(N, tInLoc.Add(-oneDay))
+		case N == 0:
+			return time.Date(tInLoc.Year(), tInLoc.Month(), tInLoc.Day(), endHour, 0, 0, 0, loc).UTC()
+		default:
+			return helper(N-1, tInLoc.Add(-oneDay))
+		}
+	}
+
+	return helper(days, now.In(loc)), nil
+}
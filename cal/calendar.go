@@ -0,0 +1,253 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cal
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/config"
+)
+
+// Calendar decides which days are work days
+type Calendar interface {
+	// IsWorkday returns true if the date of t, in the location of t, is a
+	// work day
+	IsWorkday(t time.Time) bool
+}
+
+var (
+	mu      sync.RWMutex
+	current Calendar = Weekdays()
+)
+
+// Set sets the calendar used by IsWorkday and NoKillsSince. Commands set it
+// from the config on start.
+func Set(c Calendar) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = c
+}
+
+// Current returns the calendar used by IsWorkday and NoKillsSince
+func Current() Calendar {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// Date is a day of the calendar
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of t in the location of t
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{y, m, d}
+}
+
+func (d Date) String() string {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+}
+
+// Holiday is a day off
+type Holiday struct {
+	Date Date
+	Name string
+}
+
+// HolidaySource provides the holidays of a year
+type HolidaySource interface {
+	Holidays(year int) []Holiday
+}
+
+// WorkCalendar is a calendar whose work days are days of the week that are
+// not holidays
+type WorkCalendar struct {
+	workdays map[time.Weekday]bool
+	sources  []HolidaySource
+
+	mu    sync.Mutex
+	years map[int]map[Date]string // holidays by year, filled on demand
+}
+
+// Weekdays returns a calendar whose work days are Monday to Friday, with no
+// holidays
+func Weekdays() *WorkCalendar {
+	return New([]time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday})
+}
+
+// New returns a calendar whose work days are the workdays of the week,
+// except for the holidays of sources
+func New(workdays []time.Weekday, sources ...HolidaySource) *WorkCalendar {
+	c := &WorkCalendar{
+		workdays: make(map[time.Weekday]bool),
+		sources:  sources,
+		years:    make(map[int]map[Date]string),
+	}
+	for _, d := range workdays {
+		c.workdays[d] = true
+	}
+	return c
+}
+
+// NewFromConfig returns the calendar specified in cfg
+func NewFromConfig(cfg *config.Monkey) (*WorkCalendar, error) {
+	workdays, err := cfg.CalendarWorkdays()
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := cfg.Location()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve location")
+	}
+
+	var sources []HolidaySource
+
+	if code := cfg.CalendarCountry(); code != "" {
+		c, err := Country(code)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, c)
+	}
+
+	if holidays := cfg.CalendarHolidays(); len(holidays) > 0 {
+		s, err := ParseHolidays(holidays)
+		if err != nil {
+			return nil, errors.Wrap(err, "bad calendar.holidays")
+		}
+		sources = append(sources, s)
+	}
+
+	if path := cfg.CalendarICSFile(); path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open holiday calendar")
+		}
+		defer func() { _ = f.Close() }()
+
+		events, err := ParseICS(f, loc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "bad holiday calendar %s", path)
+		}
+		sources = append(sources, EventHolidays(events, loc))
+	}
+
+	return New(workdays, sources...), nil
+}
+
+// IsWorkday implements Calendar.IsWorkday
+func (c *WorkCalendar) IsWorkday(t time.Time) bool {
+	if !c.workdays[t.Weekday()] {
+		return false
+	}
+
+	_, ok := c.Holiday(t)
+	return !ok
+}
+
+// Holiday returns the name of the holiday on the date of t, and false if it
+// isn't a holiday
+func (c *WorkCalendar) Holiday(t time.Time) (string, bool) {
+	d := DateOf(t)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	holidays, ok := c.years[d.Year]
+	if !ok {
+		holidays = make(map[Date]string)
+		for _, s := range c.sources {
+			for _, h := range s.Holidays(d.Year) {
+				if _, dup := holidays[h.Date]; !dup {
+					holidays[h.Date] = h.Name
+				}
+			}
+		}
+		c.years[d.Year] = holidays
+	}
+
+	name, ok := holidays[d]
+	return name, ok
+}
+
+// Static is a list of holidays. Holidays whose year is zero happen every
+// year.
+type Static []Holiday
+
+// Holidays implements HolidaySource.Holidays
+func (s Static) Holidays(year int) []Holiday {
+	var result []Holiday
+	for _, h := range s {
+		switch h.Date.Year {
+		case 0:
+			h.Date.Year = year
+			result = append(result, h)
+		case year:
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+// ParseHolidays parses holidays formatted as 2006-01-02, or 01-02 for every
+// year, optionally followed by a name, e.g. "12-24 Christmas Eve"
+func ParseHolidays(list []string) (Static, error) {
+	var result Static
+	for _, s := range list {
+		date, name := s, ""
+		if i := strings.Index(s, " "); i >= 0 {
+			date, name = s[:i], strings.TrimSpace(s[i+1:])
+		}
+
+		if t, err := time.Parse("2006-01-02", date); err == nil {
+			result = append(result, Holiday{DateOf(t), name})
+			continue
+		}
+
+		// Parse within a leap year, so that 02-29 is valid
+		t, err := time.Parse("2006-01-02", "2000-"+date)
+		if err != nil {
+			return nil, errors.Errorf("%q is not a date", s)
+		}
+		result = append(result, Holiday{Date{0, t.Month(), t.Day()}, name})
+	}
+	return result, nil
+}
+
+// EventHolidays returns the days of events, in loc, as holidays
+func EventHolidays(events []Event, loc *time.Location) Static {
+	var result Static
+	for _, e := range events {
+		start := e.Start.In(loc)
+		day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+
+		// An event without duration still takes its day
+		for first := true; first || day.Before(e.End); first = false {
+			result = append(result, Holiday{DateOf(day), e.Summary})
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+	return result
+}
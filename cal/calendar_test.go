@@ -0,0 +1,184 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cal_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/cal"
+	"github.com/Netflix/chaosmonkey/v2/config"
+)
+
+func day(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestCountryHolidays(t *testing.T) {
+	tests := []struct {
+		country string
+		date    string
+		want    string
+	}{
+		{"US", "2026-01-19", "Martin Luther King Jr. Day"},
+		{"US", "2026-05-25", "Memorial Day"},
+		{"US", "2026-07-03", "Independence Day"}, // Saturday, observed on Friday
+		{"US", "2026-11-26", "Thanksgiving Day"},
+		{"US", "2021-12-31", "New Year's Day"}, // 2022-01-01 is a Saturday
+		{"GB", "2026-04-03", "Good Friday"},
+		{"GB", "2026-04-06", "Easter Monday"},
+		{"GB", "2026-12-28", "Boxing Day"}, // Saturday, substituted on Monday
+		{"GB", "2027-12-27", "Christmas Day"},
+		{"GB", "2027-12-28", "Boxing Day"},
+		{"DE", "2026-05-14", "Christi Himmelfahrt"},
+		{"DE", "2026-05-25", "Pfingstmontag"},
+		{"de", "2026-10-03", "Tag der Deutschen Einheit"},
+	}
+
+	for _, tt := range tests {
+		source, err := cal.Country(tt.country)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		c := cal.New([]time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}, source)
+
+		got, ok := c.Holiday(day(tt.date))
+		if !ok || got != tt.want {
+			t.Errorf("%s %s: got %q, %t, want %q", tt.country, tt.date, got, ok, tt.want)
+		}
+	}
+
+	if _, err := cal.Country("XX"); err == nil {
+		t.Error("got no error for an unknown country")
+	}
+}
+
+func TestHolidaysAreNotWorkdays(t *testing.T) {
+	static, err := cal.ParseHolidays([]string{"12-24 Christmas Eve", "2026-10-20"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := cal.New([]time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday}, static)
+
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{"2026-10-18", true}, // Sunday
+		{"2026-10-19", true},
+		{"2026-10-20", false},
+		{"2026-10-23", false}, // Friday
+		{"2026-12-24", false},
+		{"2027-12-24", false},
+		{"2027-10-20", true},
+	}
+
+	for _, tt := range tests {
+		if got := c.IsWorkday(day(tt.date)); got != tt.want {
+			t.Errorf("%s: got workday=%t, want %t", tt.date, got, tt.want)
+		}
+	}
+
+	if _, err := cal.ParseHolidays([]string{"12/24"}); err == nil {
+		t.Error("got no error for a bad date")
+	}
+}
+
+func TestEventHolidays(t *testing.T) {
+	events := []cal.Event{
+		{Summary: "Summer shutdown", Start: day("2026-08-03"), End: day("2026-08-06")},
+		{Summary: "Offsite", Start: day("2026-09-10").Add(9 * time.Hour), End: day("2026-09-10").Add(17 * time.Hour)},
+	}
+	c := cal.New([]time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, cal.EventHolidays(events, time.UTC))
+
+	for _, d := range []string{"2026-08-03", "2026-08-04", "2026-08-05", "2026-09-10"} {
+		if c.IsWorkday(day(d)) {
+			t.Errorf("%s: got workday, want holiday", d)
+		}
+	}
+
+	for _, d := range []string{"2026-08-06", "2026-09-11"} {
+		if !c.IsWorkday(day(d)) {
+			t.Errorf("%s: got holiday, want workday", d)
+		}
+	}
+}
+
+// Holidays don't count toward the min time between kills
+func TestNoKillsSinceSkipsHolidays(t *testing.T) {
+	source, err := cal.Country("US")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cal.Set(cal.New([]time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, source))
+	defer cal.Set(cal.Weekdays())
+
+	// Friday after Thanksgiving
+	now := time.Date(2026, time.November, 27, 10, 0, 0, 0, time.UTC)
+	got, err := cal.NoKillsSince(1, now, 15, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := time.Date(2026, time.November, 25, 15, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestNewFromConfig(t *testing.T) {
+	ics := filepath.Join(t.TempDir(), "holidays.ics")
+	err := os.WriteFile(ics, []byte("BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:Company day\nDTSTART;VALUE=DATE:20261016\nEND:VEVENT\nEND:VCALENDAR\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := config.NewFromReader(strings.NewReader(`
+[chaosmonkey]
+time_zone = "UTC"
+
+[calendar]
+workdays = ["mon", "tue", "wed", "thu", "fri"]
+country = "US"
+holidays = ["2026-10-14 Hackathon"]
+ics_file = "` + ics + `"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := cal.NewFromConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range []string{"2026-10-12", "2026-10-14", "2026-10-16"} {
+		if c.IsWorkday(day(d)) {
+			t.Errorf("%s: got workday, want holiday", d)
+		}
+	}
+
+	if !c.IsWorkday(day("2026-10-15")) {
+		t.Error("2026-10-15: got holiday, want workday")
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cal
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// rules returns the holidays of a year, as dates in UTC
+type rules func(year int) []holiday
+
+type holiday struct {
+	name string
+	t    time.Time
+}
+
+// countries are the supported countries, by ISO 3166 code
+var countries = map[string]rules{
+	"US": unitedStates,
+	"GB": unitedKingdom,
+	"DE": germany,
+}
+
+// countryHolidays are the public holidays of a country
+type countryHolidays struct {
+	rules rules
+}
+
+// Country returns the public holidays of a country by its ISO 3166 code,
+// e.g. "US". Holidays that fall on a weekend are observed on a weekday as in
+// that country. Only nationwide holidays are included.
+func Country(code string) (HolidaySource, error) {
+	r, ok := countries[strings.ToUpper(code)]
+	if !ok {
+		var codes []string
+		for c := range countries {
+			codes = append(codes, c)
+		}
+		sort.Strings(codes)
+		return nil, errors.Errorf("unsupported country %q, want one of %s", code, strings.Join(codes, ", "))
+	}
+	return countryHolidays{r}, nil
+}
+
+// Holidays implements HolidaySource.Holidays
+func (c countryHolidays) Holidays(year int) []Holiday {
+	// Holidays may be observed in the year before, e.g. a New Year's Day
+	// that falls on a Saturday
+	var result []Holiday
+	for _, y := range []int{year, year + 1} {
+		for _, h := range c.rules(y) {
+			if h.t.Year() == year {
+				result = append(result, Holiday{DateOf(h.t), h.name})
+			}
+		}
+	}
+	return result
+}
+
+func unitedStates(year int) []holiday {
+	hs := []holiday{
+		{"New Year's Day", date(year, time.January, 1)},
+		{"Martin Luther King Jr. Day", nthWeekday(year, time.January, time.Monday, 3)},
+		{"Washington's Birthday", nthWeekday(year, time.February, time.Monday, 3)},
+		{"Memorial Day", nthWeekday(year, time.May, time.Monday, -1)},
+		{"Independence Day", date(year, time.July, 4)},
+		{"Labor Day", nthWeekday(year, time.September, time.Monday, 1)},
+		{"Columbus Day", nthWeekday(year, time.October, time.Monday, 2)},
+		{"Veterans Day", date(year, time.November, 11)},
+		{"Thanksgiving Day", nthWeekday(year, time.November, time.Thursday, 4)},
+		{"Christmas Day", date(year, time.December, 25)},
+	}
+	if year >= 2021 {
+		hs = append(hs, holiday{"Juneteenth", date(year, time.June, 19)})
+	}
+
+	// Saturdays are observed on Friday, Sundays on Monday
+	for i, h := range hs {
+		switch h.t.Weekday() {
+		case time.Saturday:
+			hs[i].t = h.t.AddDate(0, 0, -1)
+		case time.Sunday:
+			hs[i].t = h.t.AddDate(0, 0, 1)
+		}
+	}
+	return hs
+}
+
+// unitedKingdom returns the bank holidays of England and Wales. One-off bank
+// holidays, e.g. for a coronation, can be listed in calendar.holidays.
+func unitedKingdom(year int) []holiday {
+	easter := easterSunday(year)
+	return substitute([]holiday{
+		{"New Year's Day", date(year, time.January, 1)},
+		{"Good Friday", easter.AddDate(0, 0, -2)},
+		{"Easter Monday", easter.AddDate(0, 0, 1)},
+		{"Early May bank holiday", nthWeekday(year, time.May, time.Monday, 1)},
+		{"Spring bank holiday", nthWeekday(year, time.May, time.Monday, -1)},
+		{"Summer bank holiday", nthWeekday(year, time.August, time.Monday, -1)},
+		{"Christmas Day", date(year, time.December, 25)},
+		{"Boxing Day", date(year, time.December, 26)},
+	})
+}
+
+func germany(year int) []holiday {
+	easter := easterSunday(year)
+	return []holiday{
+		{"Neujahr", date(year, time.January, 1)},
+		{"Karfreitag", easter.AddDate(0, 0, -2)},
+		{"Ostermontag", easter.AddDate(0, 0, 1)},
+		{"Tag der Arbeit", date(year, time.May, 1)},
+		{"Christi Himmelfahrt", easter.AddDate(0, 0, 39)},
+		{"Pfingstmontag", easter.AddDate(0, 0, 50)},
+		{"Tag der Deutschen Einheit", date(year, time.October, 3)},
+		{"1. Weihnachtstag", date(year, time.December, 25)},
+		{"2. Weihnachtstag", date(year, time.December, 26)},
+	}
+}
+
+// substitute moves holidays that fall on a weekend to the next weekday that
+// isn't already a holiday, in order
+func substitute(hs []holiday) []holiday {
+	taken := make(map[time.Time]bool)
+	for _, h := range hs {
+		if isWeekday(h.t) {
+			taken[h.t] = true
+		}
+	}
+
+	for i, h := range hs {
+		if isWeekday(h.t) {
+			continue
+		}
+
+		t := h.t
+		for !isWeekday(t) || taken[t] {
+			t = t.AddDate(0, 0, 1)
+		}
+		taken[t] = true
+		hs[i].t = t
+	}
+	return hs
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// nthWeekday returns the nth weekday of a month, or the last one if n is -1
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	if n < 0 {
+		last := date(year, month+1, 0)
+		return last.AddDate(0, 0, -((int(last.Weekday()) - int(weekday) + 7) % 7))
+	}
+
+	first := date(year, month, 1)
+	offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, offset+7*(n-1))
+}
+
+// easterSunday returns the date of Easter Sunday in the Gregorian calendar,
+// using the anonymous Gregorian algorithm
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	n := h + l - 7*m + 114
+	return date(year, time.Month(n/31), n%31+1)
+}
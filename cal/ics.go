@@ -0,0 +1,164 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cal
+
+import (
+	"bufio"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Event is an event of an iCalendar file
+type Event struct {
+	Summary    string
+	Start, End time.Time
+}
+
+// ParseICS returns the events of the iCalendar (RFC 5545) data in r. Times
+// without a time zone are in loc.
+//
+// Only single events are supported: recurring events are skipped with a
+// warning, since a freeze calendar rarely needs them and the recurrence
+// rules are a large part of the format.
+func ParseICS(r io.Reader, loc *time.Location) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	var cur *Event
+	var hasEnd, isDate, recurring bool
+
+	for _, line := range lines {
+		name, params, value := splitProperty(line)
+
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			cur = &Event{}
+			hasEnd, isDate, recurring = false, false, false
+		case cur == nil:
+			continue
+		case name == "END" && value == "VEVENT":
+			switch {
+			case recurring:
+				log.Printf("WARNING: skipping recurring calendar event %q, recurring events are not supported", cur.Summary)
+			case cur.Start.IsZero():
+				return nil, errors.Errorf("event %q has no start", cur.Summary)
+			default:
+				if !hasEnd {
+					// A date lasts the whole day, a time is an instant
+					cur.End = cur.Start
+					if isDate {
+						cur.End = cur.Start.AddDate(0, 0, 1)
+					}
+				}
+				events = append(events, *cur)
+			}
+			cur = nil
+		case name == "SUMMARY":
+			cur.Summary = unescape(value)
+		case name == "RRULE" || name == "RDATE":
+			recurring = true
+		case name == "DTSTART":
+			cur.Start, isDate, err = parseICSTime(params, value, loc)
+			if err != nil {
+				return nil, errors.Wrapf(err, "bad start of event %q", cur.Summary)
+			}
+		case name == "DTEND":
+			cur.End, _, err = parseICSTime(params, value, loc)
+			if err != nil {
+				return nil, errors.Wrapf(err, "bad end of event %q", cur.Summary)
+			}
+			hasEnd = true
+		}
+	}
+
+	return events, nil
+}
+
+// unfold returns the content lines of r, joining lines that continue on the
+// next one
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "could not read calendar")
+	}
+	return lines, nil
+}
+
+// splitProperty splits a content line, e.g.
+// DTSTART;TZID=Europe/Paris:20261224T000000, into its name, parameters and
+// value
+func splitProperty(line string) (string, map[string]string, string) {
+	i := strings.Index(line, ":")
+	if i < 0 {
+		return strings.ToUpper(line), nil, ""
+	}
+
+	fields := strings.Split(line[:i], ";")
+	params := make(map[string]string)
+	for _, p := range fields[1:] {
+		if kv := strings.SplitN(p, "=", 2); len(kv) == 2 {
+			params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+		}
+	}
+
+	return strings.ToUpper(fields[0]), params, line[i+1:]
+}
+
+// parseICSTime parses a DTSTART or DTEND value. It returns true if the value
+// is a date.
+func parseICSTime(params map[string]string, value string, loc *time.Location) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", value, loc)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	}
+
+	if tzid, ok := params["TZID"]; ok {
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, false, errors.Wrapf(err, "unknown time zone %s", tzid)
+		}
+		loc = l
+	}
+
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	return t, false, err
+}
+
+// unescape replaces the escape sequences of a text value
+func unescape(s string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(s)
+}
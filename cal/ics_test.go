@@ -0,0 +1,79 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cal_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/cal"
+)
+
+func TestParseICS(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"SUMMARY:Year-end\\, freeze",
+		"DTSTART;VALUE=DATE:20261221",
+		"DTEND;VALUE=DATE:20270104",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Launch",
+		"DTSTART:20261019T100000Z",
+		"DTEND:20261019T",
+		" 120000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Audit",
+		"DTSTART;TZID=America/New_York:20261020T090000",
+		"DTEND;TZID=America/New_York:20261020T100000",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Holiday",
+		"DTSTART;VALUE=DATE:20261126",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Weekly",
+		"DTSTART:20261019T100000Z",
+		"RRULE:FREQ=WEEKLY",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	events, err := cal.ParseICS(strings.NewReader(ics), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []cal.Event{
+		{"Year-end, freeze", time.Date(2026, 12, 21, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 4, 0, 0, 0, 0, time.UTC)},
+		{"Launch", time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC), time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)},
+		{"Audit", time.Date(2026, 10, 20, 13, 0, 0, 0, time.UTC), time.Date(2026, 10, 20, 14, 0, 0, 0, time.UTC)},
+		{"Holiday", time.Date(2026, 11, 26, 0, 0, 0, 0, time.UTC), time.Date(2026, 11, 27, 0, 0, 0, 0, time.UTC)},
+	}
+
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d: %v", len(events), len(want), events)
+	}
+
+	for i, e := range events {
+		w := want[i]
+		if e.Summary != w.Summary || !e.Start.Equal(w.Start) || !e.End.Equal(w.End) {
+			t.Errorf("got %v, want %v", e, w)
+		}
+	}
+}
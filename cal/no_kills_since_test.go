@@ -12,9 +12,9 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package mysql
+package cal
 
-// This file contains test for the config.NoKillsSince method
+// This file contains test for the NoKillsSince function
 
 import (
 	"testing"
@@ -140,12 +140,12 @@ func TestZeroDaysBetweenKills(t *testing.T) {
 
 	endHour := 15 // typically we run chaos monkey until 3PM
 	for _, tt := range tests {
-		got, err := noKillsSince(tt.days, parse(tt.now), endHour, tz)
+		got, err := NoKillsSince(tt.days, parse(tt.now), endHour, tz)
 		if err != nil {
 			t.Fatal(err)
 		}
 		if want := parse(tt.since); got != want {
-			t.Errorf("noKillsSince(%d, \"%s\")=\"%s\", want \"%s\"", tt.days, tt.now, format(got.In(tz)), format(want.In(tz)))
+			t.Errorf("NoKillsSince(%d, \"%s\")=\"%s\", want \"%s\"", tt.days, tt.now, format(got.In(tz)), format(want.In(tz)))
 		}
 	}
 }
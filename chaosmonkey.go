@@ -18,6 +18,10 @@ package chaosmonkey
 import (
 	"fmt"
 	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/grp"
 )
 
 const (
@@ -40,6 +44,42 @@ type (
 		Grouping                       Group
 		Exceptions                     []Exception
 		Whitelist                      *[]Exception
+
+		// NotificationChannel is the chat channel that is told about the
+		// app's terminations, blank if the app didn't specify one
+		NotificationChannel string
+
+		// KillWindows are the times of day that the app's instances may be
+		// terminated, in the app's time zone. If empty, the start and end
+		// hours of the Chaos Monkey config are used.
+		KillWindows []KillWindow
+
+		// TimeZone is the app's time zone in tzdata format, e.g.
+		// Europe/Dublin, blank to use the time zone of the Chaos Monkey
+		// config
+		TimeZone string
+	}
+
+	// AppConfigJSON is the form of an AppConfig that is serialized, e.g. in
+	// the audit log and the HTTP API. It uses the same names as the
+	// chaosMonkey attribute of a Spinnaker application.
+	AppConfigJSON struct {
+		Enabled                        bool         `json:"enabled"`
+		RegionsAreIndependent          bool         `json:"regionsAreIndependent"`
+		MeanTimeBetweenKillsInWorkDays int          `json:"meanTimeBetweenKillsInWorkDays"`
+		MinTimeBetweenKillsInWorkDays  int          `json:"minTimeBetweenKillsInWorkDays"`
+		Grouping                       string       `json:"grouping"`
+		Exceptions                     []Exception  `json:"exceptions"`
+		NotificationChannel            string       `json:"notificationChannel,omitempty"`
+		KillWindows                    []KillWindow `json:"killWindows,omitempty"`
+		TimeZone                       string       `json:"timeZone,omitempty"`
+	}
+
+	// KillWindow is a time of day during which instances may be terminated,
+	// from StartHour:00 up to EndHour:00
+	KillWindow struct {
+		StartHour int `json:"startHour"`
+		EndHour   int `json:"endHour"`
 	}
 
 	// Group describes what Chaos Monkey considers a group of instances
@@ -54,10 +94,10 @@ type (
 	// For example, this will opt-out all of the cluters in the test account:
 	// Exception{ Account:"test", Stack:"*", Cluster:"*", Region: "*"}
 	Exception struct {
-		Account string
-		Stack   string
-		Detail  string
-		Region  string
+		Account string `json:"account"`
+		Stack   string `json:"stack"`
+		Detail  string `json:"detail"`
+		Region  string `json:"region"`
 	}
 
 	// Instance contains naming info about an instance
@@ -89,9 +129,20 @@ type (
 
 	// Termination contains information about an instance termination.
 	Termination struct {
-		Instance Instance  // The instance that will be terminated
-		Time     time.Time // Termination time
-		Leashed  bool      // If true, track the termination but do not execute it
+		Instance     Instance  // The instance that will be terminated
+		Time         time.Time // Termination time
+		Leashed      bool      // If true, track the termination but do not execute it
+		FencingToken int64     // Token of the leader lease the termination is made under, 0 if none
+		Decision     *Decision // How the instance was picked, nil if unknown
+	}
+
+	// Decision describes how Chaos Monkey decided on a termination
+	Decision struct {
+		AppConfig AppConfig // Config of the app at the time of the decision
+		Group     string    // Group the instance was picked from, e.g. "app=foo account=prod cluster=foo-prod"
+		Eligible  int       // Number of eligible instances in the group
+		Reason    string    // Why this instance was picked
+		Seed      int64     // Seed the instance was picked with, see package seed
 	}
 
 	// Tracker records termination events an a tracking system such as Chronos
@@ -154,6 +205,17 @@ type (
 		Outage() (bool, error)
 	}
 
+	// ScopedOutage is an Outage that can tell which instance groups an
+	// ongoing outage affects, so that an outage in one account or region
+	// doesn't stop terminations elsewhere
+	ScopedOutage interface {
+		Outage
+
+		// OutageIn returns true if there is an ongoing outage that affects
+		// group
+		OutageIn(group grp.InstanceGroup) (bool, error)
+	}
+
 	// ErrViolatesMinTime represents an error when trying to record a termination
 	// that violates the min time between terminations for that particular app
 	ErrViolatesMinTime struct {
@@ -191,6 +253,80 @@ func NewAppConfig(exceptions []Exception) AppConfig {
 	return result
 }
 
+// JSON returns the serialized form of the app config. Exceptions are never
+// nil, so that they are serialized as a list.
+func (c AppConfig) JSON() AppConfigJSON {
+	result := AppConfigJSON{
+		Enabled:                        c.Enabled,
+		RegionsAreIndependent:          c.RegionsAreIndependent,
+		MeanTimeBetweenKillsInWorkDays: c.MeanTimeBetweenKillsInWorkDays,
+		MinTimeBetweenKillsInWorkDays:  c.MinTimeBetweenKillsInWorkDays,
+		Grouping:                       c.Grouping.String(),
+		Exceptions:                     []Exception{},
+		NotificationChannel:            c.NotificationChannel,
+		KillWindows:                    c.KillWindows,
+		TimeZone:                       c.TimeZone,
+	}
+
+	result.Exceptions = append(result.Exceptions, c.Exceptions...)
+	return result
+}
+
+// Location returns the app's time zone, or def if the app doesn't set one
+func (c AppConfig) Location(def *time.Location) (*time.Location, error) {
+	if c.TimeZone == "" {
+		return def, nil
+	}
+
+	loc, err := time.LoadLocation(c.TimeZone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid time zone %q", c.TimeZone)
+	}
+	return loc, nil
+}
+
+// Windows returns the app's kill windows, or a window from startHour to
+// endHour if the app doesn't set any
+func (c AppConfig) Windows(startHour, endHour int) []KillWindow {
+	if len(c.KillWindows) == 0 {
+		return []KillWindow{{StartHour: startHour, EndHour: endHour}}
+	}
+	return c.KillWindows
+}
+
+// EndHour returns the hour the app's last kill window ends, or def if the
+// app doesn't set any
+func (c AppConfig) EndHour(def int) int {
+	if len(c.KillWindows) == 0 {
+		return def
+	}
+
+	result := 0
+	for _, w := range c.KillWindows {
+		if w.EndHour > result {
+			result = w.EndHour
+		}
+	}
+	return result
+}
+
+// CheckKillWindows returns an error if a window isn't within a day, or if
+// windows overlap
+func CheckKillWindows(windows []KillWindow) error {
+	for i, w := range windows {
+		if w.StartHour < 0 || w.EndHour > 24 || w.StartHour >= w.EndHour {
+			return errors.Errorf("invalid kill window %d-%d", w.StartHour, w.EndHour)
+		}
+
+		for _, o := range windows[:i] {
+			if w.StartHour < o.EndHour && o.StartHour < w.EndHour {
+				return errors.Errorf("kill window %d-%d overlaps %d-%d", w.StartHour, w.EndHour, o.StartHour, o.EndHour)
+			}
+		}
+	}
+	return nil
+}
+
 // Matches returns true if an exception matches an ASG
 func (ex Exception) Matches(account, stack, detail, region string) bool {
 	return exFieldMatches(ex.Account, account) &&
@@ -15,6 +15,7 @@
 package chaosmonkey_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/Netflix/chaosmonkey/v2"
@@ -27,3 +28,25 @@ func TestExceptionMatches(t *testing.T) {
 		t.Error("Expected exception match")
 	}
 }
+
+// App configs serialize with the names of Spinnaker's chaosMonkey attribute
+func TestAppConfigJSON(t *testing.T) {
+	tests := []struct {
+		cfg  chaosmonkey.AppConfig
+		want string
+	}{
+		{chaosmonkey.NewAppConfig(nil), `{"enabled":true,"regionsAreIndependent":true,"meanTimeBetweenKillsInWorkDays":5,"minTimeBetweenKillsInWorkDays":0,"grouping":"cluster","exceptions":[]}`},
+		{chaosmonkey.NewAppConfig([]chaosmonkey.Exception{{Account: "test", Stack: "*", Detail: "*", Region: "*"}}), `{"enabled":true,"regionsAreIndependent":true,"meanTimeBetweenKillsInWorkDays":5,"minTimeBetweenKillsInWorkDays":0,"grouping":"cluster","exceptions":[{"account":"test","stack":"*","detail":"*","region":"*"}]}`},
+	}
+
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.cfg.JSON())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := string(data); got != tt.want {
+			t.Errorf("got %s, want %s", got, tt.want)
+		}
+	}
+}
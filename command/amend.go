@@ -0,0 +1,179 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/user"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+)
+
+// Amend executes the "amend" command. This cancels, adds or reschedules a
+// termination of group in today's published schedule, and records who made
+// the change and why. at is the time of the termination to cancel or
+// reschedule, and to its new time, as 15:04 today or RFC 3339. If by is
+// empty, the change is made by the current user.
+//
+// Cron jobs are updated by "fetch-schedule".
+func Amend(a schedstore.Amender, cfg *config.Monkey, op string, group grp.InstanceGroup, at, to, by, reason string) {
+	loc, err := cfg.Location()
+	if err != nil {
+		log.Fatalf("FATAL: could not retrieve location: %v", err)
+	}
+
+	if by == "" {
+		by = currentUser()
+	}
+
+	now := time.Now().In(loc)
+	amendment, err := newAmendment(op, group, at, to, by, reason, now)
+	if err != nil {
+		log.Fatalf("FATAL: %v", err)
+	}
+
+	err = a.Amend(now, amendment)
+	if err != nil {
+		log.Fatalf("FATAL: could not %s termination of %s: %v", op, grp.String(group), err)
+	}
+
+	log.Printf("%s: %s amended today's schedule, run fetch-schedule to update the cron jobs", op, grp.String(group))
+}
+
+// ListAmendments executes the "amend list" command. This prints the
+// amendments made to today's schedule, oldest first.
+func ListAmendments(a schedstore.Amender, cfg *config.Monkey) {
+	loc, err := cfg.Location()
+	if err != nil {
+		log.Fatalf("FATAL: could not retrieve location: %v", err)
+	}
+
+	amendments, err := a.Amendments(time.Now().In(loc))
+	if err != nil {
+		log.Fatalf("FATAL: could not retrieve amendments: %v", err)
+	}
+
+	err = writeAmendments(os.Stdout, amendments, loc)
+	if err != nil {
+		log.Fatalf("FATAL: %v", err)
+	}
+}
+
+// newAmendment returns the amendment for the "amend" command's arguments.
+// Times are on the date of now, in the location of now.
+func newAmendment(op string, group grp.InstanceGroup, at, to, by, reason string, now time.Time) (schedstore.Amendment, error) {
+	a := schedstore.Amendment{Op: schedstore.Op(op), Group: group, By: by, Reason: reason, At: now}
+
+	var err error
+	switch a.Op {
+	case schedstore.Cancel, schedstore.Reschedule:
+		if at != "" {
+			a.Time, err = parseScheduleTime(at, now)
+			if err != nil {
+				return schedstore.Amendment{}, errors.Wrap(err, "--at")
+			}
+		}
+	case schedstore.Add:
+		if at != "" {
+			return schedstore.Amendment{}, errors.New("--at: not allowed when adding a termination")
+		}
+	default:
+		return schedstore.Amendment{}, errors.Errorf("unknown amendment %q, want cancel, add or reschedule", op)
+	}
+
+	switch {
+	case a.Op == schedstore.Cancel && to != "":
+		return schedstore.Amendment{}, errors.New("--to: not allowed when cancelling a termination")
+	case a.Op != schedstore.Cancel && to == "":
+		return schedstore.Amendment{}, errors.Errorf("--to: required to %s a termination", op)
+	case to != "":
+		a.NewTime, err = parseScheduleTime(to, now)
+		if err != nil {
+			return schedstore.Amendment{}, errors.Wrap(err, "--to")
+		}
+
+		// Cron jobs only run today's terminations, and not in the past
+		y, m, d := a.NewTime.In(now.Location()).Date()
+		if ny, nm, nd := now.Date(); y != ny || m != nm || d != nd {
+			return schedstore.Amendment{}, errors.Errorf("--to: %s is not today", to)
+		}
+		if !a.NewTime.After(now) {
+			return schedstore.Amendment{}, errors.Errorf("--to: %s is in the past", to)
+		}
+	}
+
+	return a, a.Check()
+}
+
+// parseScheduleTime parses s as a time of day, 15:04, on the date of now in
+// the location of now, or as an RFC 3339 time
+func parseScheduleTime(s string, now time.Time) (time.Time, error) {
+	if t, err := time.ParseInLocation("15:04", s, now.Location()); err == nil {
+		y, m, d := now.Date()
+		return time.Date(y, m, d, t.Hour(), t.Minute(), 0, 0, now.Location()), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, errors.Errorf("%q is not a time, want 15:04 or RFC 3339", s)
+	}
+	return t, nil
+}
+
+// currentUser returns the name of the user running the command
+func currentUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+
+	if name := os.Getenv("USER"); name != "" {
+		return name
+	}
+
+	return "unknown"
+}
+
+// writeAmendments writes amendments to w as a table, with times in loc
+func writeAmendments(w io.Writer, amendments []schedstore.Amendment, loc *time.Location) error {
+	if len(amendments) == 0 {
+		_, err := fmt.Fprintln(w, "no amendments to today's schedule")
+		return err
+	}
+
+	// clock formats a time of the schedule, blank if there is none
+	clock := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.In(loc).Format("15:04")
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "AMENDED AT\tBY\tOP\tGROUP\tTIME\tNEW TIME\tREASON")
+	for _, a := range amendments {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			a.At.In(loc).Format(historyTimeFormat), a.By, a.Op, grp.String(a.Group), clock(a.Time), clock(a.NewTime), a.Reason)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+)
+
+func TestNewAmendment(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2026, time.October, 19, 11, 0, 0, 0, la)
+	group := grp.New("foo", "prod", "us-east-1", "", "foo-prod")
+
+	tests := []struct {
+		op, at, to  string
+		wantTime    time.Time
+		wantNewTime time.Time
+	}{
+		{"cancel", "", "", time.Time{}, time.Time{}},
+		{"cancel", "12:30", "", time.Date(2026, time.October, 19, 12, 30, 0, 0, la), time.Time{}},
+		{"add", "", "14:00", time.Time{}, time.Date(2026, time.October, 19, 14, 0, 0, 0, la)},
+		{"reschedule", "2026-10-19T19:30:00Z", "2026-10-19T15:00:00-07:00", time.Date(2026, time.October, 19, 12, 30, 0, 0, la), time.Date(2026, time.October, 19, 15, 0, 0, 0, la)},
+	}
+
+	for _, tt := range tests {
+		a, err := newAmendment(tt.op, group, tt.at, tt.to, "alice", "testing", now)
+		if err != nil {
+			t.Errorf("%s %s %s: %v", tt.op, tt.at, tt.to, err)
+			continue
+		}
+
+		if a.Op != schedstore.Op(tt.op) || !a.Time.Equal(tt.wantTime) || !a.NewTime.Equal(tt.wantNewTime) {
+			t.Errorf("%s %s %s: got %+v, want time %s and new time %s", tt.op, tt.at, tt.to, a, tt.wantTime, tt.wantNewTime)
+		}
+
+		if a.By != "alice" || a.Reason != "testing" || !a.At.Equal(now) {
+			t.Errorf("%s %s %s: got %+v, want by alice for testing at %s", tt.op, tt.at, tt.to, a, now)
+		}
+	}
+
+	bad := []struct {
+		op, at, to string
+	}{
+		{"delete", "", ""},
+		{"cancel", "", "14:00"},
+		{"cancel", "noon", ""},
+		{"add", "12:30", "14:00"},
+		{"add", "", ""},
+		{"reschedule", "", "10:00"},
+		{"reschedule", "", "2026-10-20T14:00:00-07:00"},
+	}
+
+	for _, tt := range bad {
+		if _, err := newAmendment(tt.op, group, tt.at, tt.to, "alice", "", now); err == nil {
+			t.Errorf("%s --at=%s --to=%s: got no error", tt.op, tt.at, tt.to)
+		}
+	}
+}
+
+func TestWriteAmendments(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	amendments := []schedstore.Amendment{
+		{Op: schedstore.Cancel, Group: grp.New("foo", "prod", "us-east-1", "", "foo-prod"), Time: time.Date(2026, time.October, 19, 19, 30, 0, 0, time.UTC), By: "alice", Reason: "incident", At: time.Date(2026, time.October, 19, 17, 0, 0, 0, time.UTC)},
+		{Op: schedstore.Add, Group: grp.New("bar", "prod", "", "", ""), NewTime: time.Date(2026, time.October, 19, 21, 0, 0, 0, time.UTC), By: "bob", At: time.Date(2026, time.October, 19, 17, 5, 0, 0, time.UTC)},
+	}
+
+	var buf bytes.Buffer
+	err = writeAmendments(&buf, amendments, la)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `AMENDED AT               BY     OP      GROUP                                                   TIME   NEW TIME  REASON
+2026-10-19 10:00:00 PDT  alice  cancel  app=foo account=prod region=us-east-1 cluster=foo-prod  12:30            incident
+2026-10-19 10:05:00 PDT  bob    add     app=bar account=prod                                           14:00     
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
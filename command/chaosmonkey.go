@@ -26,12 +26,14 @@ import (
 	flag "github.com/spf13/pflag"
 
 	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/cal"
 	"github.com/Netflix/chaosmonkey/v2/clock"
 	"github.com/Netflix/chaosmonkey/v2/config"
 	"github.com/Netflix/chaosmonkey/v2/config/param"
 	"github.com/Netflix/chaosmonkey/v2/deploy"
 	"github.com/Netflix/chaosmonkey/v2/deps"
-	"github.com/Netflix/chaosmonkey/v2/mysql"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/history"
 	"github.com/Netflix/chaosmonkey/v2/schedstore"
 	"github.com/Netflix/chaosmonkey/v2/schedule"
 	"github.com/Netflix/chaosmonkey/v2/spinnaker"
@@ -58,7 +60,7 @@ Chaos Monkey
 Usage:
 	chaosmonkey <command> ...
 
-command: migrate | schedule | terminate | fetch-schedule | outage | config  | email | eligible | intest
+command: migrate | schedule | terminate | daemon | serve | history | fetch-schedule | amend | snooze | unsnooze | outage | config  | email | eligible | intest
 
 Install
 -------
@@ -68,8 +70,8 @@ migrate
 -------
 Applies database migration to the database defined in the configuration file.
 
-schedule [--max-apps=<N>] [--apps=foo,bar,baz] [--no-record-schedule]
---------------------------------------------------------------------
+schedule [--max-apps=<N>] [--apps=foo,bar,baz] [--no-record-schedule] [--dry-run [--format=table|json] [--seed=<N> [--anchor=<time>]]]
+-------------------------------------------------------------------------------------------------------------------------------------
 Generates a schedule of terminations for the day and installs the
 terminations as local cron jobs that call "chaosmonkey terminate ..."
 
@@ -82,6 +84,24 @@ terminations as local cron jobs that call "chaosmonkey terminate ..."
 --no-record-schedule   Do not record the schedule with the database.
                        This is primarily used for debugging.
 
+--seed=<N>             With --dry-run, populate the schedule from a recorded
+                       seed instead of a new one. With the same apps and
+                       configs, this reproduces the schedule of the day the
+                       seed was recorded for.
+
+--anchor=<time>        With --seed, populate the schedule for the time logged
+                       with the seed as anchor=<time>, in RFC 3339, instead of
+                       now, with the snoozes active then. Together they
+                       reproduce the recorded schedule.
+
+--dry-run              Print the schedule instead of recording it with the
+                       database and cron. Shows each termination's time in the
+                       configured time zone and in UTC, the app's mean time
+                       between kills, and which terminations the constrainers
+                       moved or removed, and why.
+
+--format               With --dry-run, output as a table (default) or JSON.
+
 
 terminate <app> <account> [--region=<region>] [--stack=<stack>] [--cluster=<cluster>] [--leashed]
 -----------------------------------------------------------------------------------------------------------------
@@ -93,12 +113,109 @@ The --leashed flag forces chaosmonkey to run in leashed mode. When leashed,
 Chaos Monkey will check if an instance should be terminated, but will not
 actually terminate it.
 
+daemon
+------
+Runs Chaos Monkey as a single long-lived process instead of using cron.
+Generates the schedule of terminations each day at the time given by
+cron_expression (in the configured time zone) and terminates instances at
+the scheduled times. On start, today's schedule is reloaded from the
+database and terminations scheduled in the past are skipped. Stops
+gracefully on SIGINT or SIGTERM.
+
+serve
+-----
+Serves an HTTP API with JSON endpoints on the configured server.address:
+
+	GET  /v1/schedule?date=YYYY-MM-DD                        schedule for date, today if omitted
+	GET  /v1/eligible?app=&account=&region=&stack=&cluster=  instances eligible for termination
+	GET  /v1/config?app=                                     app config
+	GET  /v1/terminations?app=&account=&limit=               recorded terminations
+	POST /v1/terminations                                    terminate an instance
+
+The POST body is a JSON object with app, account and optionally region,
+stack, cluster and leashed. POST requires the server.encrypted_token as a
+bearer token, and unleashed requests are refused unless server.allow_unleashed
+is set. Stops gracefully on SIGINT or SIGTERM.
+
+history [<app> [<account>]] [--region=<region>] [--stack=<stack>] [--cluster=<cluster>] [--since=<time>] [--until=<time>] [--only=leashed|unleashed] [--limit=<N>] [--format=table|json|csv]
+-------------------------------------------------------------------------------------------------------------------------------------------------------------------------------------
+Shows the recorded terminations, most recent first, optionally filtered by
+app, account, region, stack and cluster.
+
+--since, --until   Only show terminations in a time range. Accepts a date
+                   (YYYY-MM-DD, in the configured time zone) or an RFC 3339
+                   time. A date in --until includes the whole day.
+
+--only             Only show leashed or unleashed terminations.
+
+--limit=<N>        Show at most N terminations (default 100).
+
+--format           Output as a table (default), JSON or CSV.
+
+Examples:
+
+	chaosmonkey history chaosguineapig prod --cluster=chaosguineapig-prod --limit=1
+
+	chaosmonkey history --since=2026-10-01 --only=unleashed --format=csv
+
 fetch-schedule
 --------------
 Queries the database to see if there is an existing schedule of
 terminations for today. If so, downloads the schedule and sets up cron jobs to
 implement the schedule.
 
+amend cancel|add|reschedule <app> <account> [--region=<region>] [--stack=<stack>] [--cluster=<cluster>] [--at=<time>] [--to=<time>] [--reason=<text>] [--by=<name>]
+amend list
+----------------------------------------------------------------------------------------------------------------------------------------------------------------
+Changes today's published schedule, e.g. to cancel a termination while the
+app's owner is handling an incident. Each change is recorded with who made
+it, when and why; "amend list" shows them. Run fetch-schedule afterwards to
+update the cron jobs.
+
+cancel       Cancels the termination of the group.
+add          Adds a termination of the group at --to.
+reschedule   Moves the termination of the group to --to.
+
+--at=<time>   Time of the termination to cancel or reschedule, needed if the
+              group has several. Times are 15:04 today in the configured
+              time zone, or RFC 3339.
+
+--to=<time>   New time of the termination, later today.
+
+--by=<name>   Who makes the change, the current user if omitted.
+
+Examples:
+
+	chaosmonkey amend cancel chaosguineapig prod --cluster=chaosguineapig-prod --reason="incident in progress"
+
+	chaosmonkey amend reschedule chaosguineapig prod --cluster=chaosguineapig-prod --to=15:30
+
+snooze <app> [--account=<account>] [--cluster=<cluster>] --until=<time> --reason=<text> [--by=<name>]
+snooze list
+-----------------------------------------------------------------------------------------------------
+Opts an app out of terminations until --until, in all its accounts and
+clusters unless --account or --cluster is given. Snoozed apps are left out of
+new schedules, and terminations already scheduled for them are skipped. The
+snooze expires by itself. Snoozing the same app, account and cluster again
+replaces the snooze. "snooze list" shows the snoozes that have not expired.
+
+--until=<time>   When the snooze expires: a duration from now (e.g. 72h), a
+                 date (YYYY-MM-DD, in the configured time zone), which
+                 includes the whole day, or an RFC 3339 time.
+
+--by=<name>      Who snoozes the app, the current user if omitted.
+
+Examples:
+
+	chaosmonkey snooze chaosguineapig --until=72h --reason="database migration"
+
+	chaosmonkey snooze chaosguineapig --account=prod --cluster=chaosguineapig-prod --until=2026-10-23 --reason="load test"
+
+unsnooze <app> [--account=<account>] [--cluster=<cluster>]
+----------------------------------------------------------
+Ends a snooze before it expires. --account and --cluster must match the
+snooze's.
+
 outage
 ------
 Output "true" if there is an ongoing outage, otherwise "false". Used for debugging.
@@ -106,7 +223,8 @@ Output "true" if there is an ongoing outage, otherwise "false". Used for debuggi
 
 config [<app>]
 ------------
-Query Spinnaker for the config for a specific app and dump it to
+Query the config for a specific app (from Spinnaker, unless the deployment
+provider supplies app configs) and dump it to
 standard out. This is only used for debugging.
 
 If no app is specified, dump the Monkey-level configuration options to standard out.
@@ -176,6 +294,43 @@ func init() {
 	log.SetPrefix(fmt.Sprintf("[%5d] ", os.Getpid()))
 }
 
+// commandName returns the command in args, which is the first argument that
+// is neither a flag nor the value of one. The values of flags given as
+// "--name value" are recognized by looking the flag up in global, then in
+// any of commands.
+func commandName(args []string, global *flag.FlagSet, commands []*flag.FlagSet) string {
+	takesValue := func(name string) bool {
+		for _, fs := range append([]*flag.FlagSet{global}, commands...) {
+			if f := fs.Lookup(name); f != nil {
+				// Boolean flags have a default for when no value is given
+				return f.NoOptDefVal == ""
+			}
+		}
+		return false
+	}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			if i+1 < len(args) {
+				return args[i+1]
+			}
+			return ""
+		case strings.HasPrefix(arg, "--"):
+			if !strings.Contains(arg, "=") && takesValue(arg[2:]) {
+				i++
+			}
+		case strings.HasPrefix(arg, "-") && arg != "-":
+			// Shorthands such as -v, none of which take a value
+		default:
+			return arg
+		}
+	}
+
+	return ""
+}
+
 // Execute is the main entry point for the chaosmonkey cli.
 func Execute() {
 	regionPtr := flag.String("region", "", "region of termination group")
@@ -183,7 +338,42 @@ func Execute() {
 	clusterPtr := flag.String("cluster", "", "cluster of termination group")
 	appsPtr := flag.String("apps", "", "comma-separated list of apps to schedule for termination")
 	noRecordSchedulePtr := flag.Bool("no-record-schedule", false, "do not record schedule")
+	seedPtr := flag.Int64("seed", 0, "seed to populate the schedule from")
+	anchorPtr := flag.String("anchor", "", "time to populate the schedule for, as logged with its seed")
+	dryRunPtr := flag.Bool("dry-run", false, "print the schedule instead of publishing it")
 	versionPtr := flag.BoolP("version", "v", false, "show version")
+	formatPtr := flag.String("format", "table", "output format: table, json or csv")
+
+	// Flags that only some commands take. They are only accepted by those
+	// commands, and may mean different things to each.
+	historyFlags := flag.NewFlagSet("history", flag.ExitOnError)
+	sincePtr := historyFlags.String("since", "", "only show terminations from this date or time")
+	historyUntilPtr := historyFlags.String("until", "", "only show terminations up to this date or time")
+	onlyPtr := historyFlags.String("only", "", "only show leashed or unleashed terminations")
+	limitPtr := historyFlags.Int("limit", history.DefaultLimit, "max number of terminations to show")
+
+	amendFlags := flag.NewFlagSet("amend", flag.ExitOnError)
+	atPtr := amendFlags.String("at", "", "time of the termination to amend")
+	toPtr := amendFlags.String("to", "", "new time of the amended termination")
+	amendReasonPtr := amendFlags.String("reason", "", "why the schedule is amended")
+	amendByPtr := amendFlags.String("by", "", "who amends the schedule, the current user if omitted")
+
+	snoozeFlags := flag.NewFlagSet("snooze", flag.ExitOnError)
+	snoozeAccountPtr := snoozeFlags.String("account", "", "account of the app to snooze, all if omitted")
+	snoozeUntilPtr := snoozeFlags.String("until", "", "when the snooze expires")
+	snoozeReasonPtr := snoozeFlags.String("reason", "", "why the app is snoozed")
+	snoozeByPtr := snoozeFlags.String("by", "", "who snoozes the app, the current user if omitted")
+
+	unsnoozeFlags := flag.NewFlagSet("unsnooze", flag.ExitOnError)
+	unsnoozeAccountPtr := unsnoozeFlags.String("account", "", "account of the snooze to end")
+
+	commandFlags := map[string]*flag.FlagSet{
+		"history":  historyFlags,
+		"amend":    amendFlags,
+		"snooze":   snoozeFlags,
+		"unsnooze": unsnoozeFlags,
+	}
+
 	flag.Usage = Usage
 
 	// These flags, if specified, override config values
@@ -192,6 +382,14 @@ func Execute() {
 	flag.Int(maxAppsFlag, math.MaxInt32, "max number of apps to examine for termination")
 	flag.Bool(leashedFlag, false, "force leashed mode")
 
+	var all []*flag.FlagSet
+	for _, fs := range commandFlags {
+		all = append(all, fs)
+	}
+	if fs, ok := commandFlags[commandName(os.Args[1:], flag.CommandLine, all)]; ok {
+		flag.CommandLine.AddFlagSet(fs)
+	}
+
 	flag.Parse()
 	if len(flag.Args()) == 0 {
 		if *versionPtr {
@@ -221,10 +419,25 @@ func Execute() {
 		log.Fatalf("FATAL: failed to bind flag: --%s: %v", leashedFlag, err)
 	}
 
-	spin, err := spinnaker.NewFromConfig(cfg)
+	err = addMetricsSinks(cfg)
+	if err != nil {
+		log.Fatalf("FATAL: could not initialize metrics: %+v", err)
+	}
 
+	calendar, err := cal.NewFromConfig(cfg)
 	if err != nil {
-		log.Fatalf("FATAL: spinnaker.New failed: %+v", err)
+		log.Fatalf("FATAL: could not initialize work calendar: %+v", err)
+	}
+	cal.Set(calendar)
+
+	dep, err := newDeployment(cfg)
+	if err != nil {
+		log.Fatalf("FATAL: could not initialize deployment provider: %+v", err)
+	}
+
+	confGetter, err := newAppConfigGetter(cfg, dep)
+	if err != nil {
+		log.Fatalf("FATAL: could not initialize app config source: %+v", err)
 	}
 
 	outage, err := deps.GetOutage(cfg)
@@ -232,9 +445,9 @@ func Execute() {
 		log.Fatalf("FATAL: deps.GetOutage fail: %+v", err)
 	}
 
-	sql, err := mysql.NewFromConfig(cfg)
+	db, err := newDatabase(cfg)
 	if err != nil {
-		log.Fatalf("FATAL: could not initialize mysql connection: %+v", err)
+		log.Fatalf("FATAL: could not initialize database connection: %+v", err)
 	}
 
 	cons, err := deps.GetConstrainer(cfg)
@@ -242,17 +455,17 @@ func Execute() {
 		log.Fatalf("FATAL: deps.GetConstrainer failed: %+v", err)
 	}
 
-	// Ensure mysql object gets closed
+	// Ensure database object gets closed
 	defer func() {
-		_ = sql.Close()
+		_ = db.Close()
 	}()
 
 	switch cmd {
 	case "install":
 		executable := ChaosmonkeyExecutable{}
-		Install(cfg, executable, sql)
+		Install(cfg, executable, db)
 	case "migrate":
-		Migrate(sql)
+		Migrate(db)
 	case "schedule":
 		log.Println("chaosmonkey schedule starting")
 		defer log.Println("chaosmonkey schedule done")
@@ -264,22 +477,47 @@ func Execute() {
 		} else {
 			// User did not explicitly specify list of apps, get 'em all
 			var err error
-			apps, err = spin.AppNames()
+			apps, err = dep.AppNames()
 			if err != nil {
 				log.Fatalf("FATAL: could not retrieve list of app names: %v", err)
 			}
 		}
 
+		anchor, err := parseAnchor(*anchorPtr)
+		if err != nil {
+			log.Fatalf("FATAL: %v", err)
+		}
+
+		if *dryRunPtr {
+			Plan(confGetter, db, cfg, dep, cons, apps, *seedPtr, anchor, *formatPtr)
+			return
+		}
+
+		// A replayed schedule is for a past day, so it must not be
+		// published or installed as today's
+		if *seedPtr != 0 || !anchor.IsZero() {
+			log.Fatalf("FATAL: --seed and --anchor replay a recorded schedule and require --dry-run")
+		}
+
+		_, leader, err := acquireLease(cfg, db, clock.New())
+		if err != nil {
+			log.Fatalf("FATAL: %+v", err)
+		}
+		if !leader {
+			log.Println("another replica is the leader, not scheduling")
+			return
+		}
+
 		var schedStore schedstore.SchedStore
 
-		schedStore = sql
+		schedStore = db
 		if *noRecordSchedulePtr {
 			schedStore = nullSchedStore{}
 		}
 
-		Schedule(spin, schedStore, cfg, spin, cons, apps)
+		Schedule(confGetter, schedStore, db, cfg, dep, cons, apps)
 	case "fetch-schedule":
-		FetchSchedule(sql, cfg)
+		FetchSchedule(db, cfg)
 	case "terminate":
 		if len(flag.Args()) != 3 {
 			flag.Usage()
@@ -287,35 +525,76 @@ func Execute() {
 		}
 		app := flag.Arg(1)
 		account := flag.Arg(2)
-		trackers, err := deps.GetTrackers(cfg)
+		d := terminationDeps(cfg, db, confGetter, dep, outage)
+		defer logOnPanic(d.ErrCounter) // Handler in case of panic
+		token, leader, err := acquireLease(cfg, db, d.Cl)
 		if err != nil {
-			log.Fatalf("FATAL: could not create trackers: %+v", err)
+			log.Fatalf("FATAL: %+v", err)
 		}
-
-		errCounter, err := deps.GetErrorCounter(cfg)
+		if !leader {
+			log.Println("another replica is the leader, not terminating")
+			return
+		}
+		d.FencingToken = token
+		d.Seed = scheduleSeed(db, cfg)
+		Terminate(d, app, account, *regionPtr, *stackPtr, *clusterPtr)
+	case "daemon":
+		d := terminationDeps(cfg, db, confGetter, dep, outage)
+		defer logOnPanic(d.ErrCounter) // Handler in case of panic
+		elector, err := newElector(cfg, db, d.Cl)
 		if err != nil {
-			log.Fatalf("FATAL: could not create error counter: %+v", err)
+			log.Fatalf("FATAL: could not initialize leader election: %+v", err)
 		}
-
-		env, err := deps.GetEnv(cfg)
+		warner, warnBefore, err := newWarner(cfg, confGetter)
 		if err != nil {
-			log.Fatalf("FATAL: could not determine environment: %+v", err)
+			log.Fatalf("FATAL: could not initialize termination warnings: %+v", err)
 		}
-
-		defer logOnPanic(errCounter) // Handler in case of panic
-		deps := deps.Deps{
-			MonkeyCfg:  cfg,
-			Checker:    sql,
-			ConfGetter: spin,
-			Cl:         clock.New(),
-			Dep:        spin,
-			T:          spin,
-			Trackers:   trackers,
-			Ou:         outage,
-			ErrCounter: errCounter,
-			Env:        env,
-		}
-		Terminate(deps, app, account, *regionPtr, *stackPtr, *clusterPtr)
+		Daemon(d, db, cons, elector, warner, warnBefore)
+	case "serve":
+		d := terminationDeps(cfg, db, confGetter, dep, outage)
+		defer logOnPanic(d.ErrCounter) // Handler in case of panic
+		Serve(d, db, db, func() (int64, bool, error) { return acquireLease(cfg, db, d.Cl) })
+	case "history":
+		if len(flag.Args()) > 3 {
+			flag.Usage()
+			os.Exit(1)
+		}
+		loc, err := cfg.Location()
+		if err != nil {
+			log.Fatalf("FATAL: could not retrieve location: %v", err)
+		}
+		q, err := historyQuery(flag.Arg(1), flag.Arg(2), *regionPtr, *stackPtr, *clusterPtr, *sincePtr, *historyUntilPtr, *onlyPtr, *limitPtr, loc)
+		if err != nil {
+			log.Fatalf("FATAL: %v", err)
+		}
+		History(db, q, *formatPtr, loc)
+	case "amend":
+		if flag.Arg(1) == "list" && len(flag.Args()) == 2 {
+			ListAmendments(db, cfg)
+			return
+		}
+		if len(flag.Args()) != 4 {
+			flag.Usage()
+			os.Exit(1)
+		}
+		group := grp.New(flag.Arg(2), flag.Arg(3), *regionPtr, *stackPtr, *clusterPtr)
+		Amend(db, cfg, flag.Arg(1), group, *atPtr, *toPtr, *amendByPtr, *amendReasonPtr)
+	case "snooze":
+		if flag.Arg(1) == "list" && len(flag.Args()) == 2 {
+			ListSnoozes(db, cfg)
+			return
+		}
+		if len(flag.Args()) != 2 {
+			flag.Usage()
+			os.Exit(1)
+		}
+		Snooze(db, cfg, flag.Arg(1), *snoozeAccountPtr, *clusterPtr, *snoozeUntilPtr, *snoozeByPtr, *snoozeReasonPtr)
+	case "unsnooze":
+		if len(flag.Args()) != 2 {
+			flag.Usage()
+			os.Exit(1)
+		}
+		Unsnooze(db, flag.Arg(1), *unsnoozeAccountPtr, *clusterPtr)
 	case "outage":
 		Outage(outage)
 	case "config":
@@ -324,7 +603,7 @@ func Execute() {
 			return
 		}
 		app := flag.Arg(1)
-		DumpConfig(spin, app)
+		DumpConfig(confGetter, app)
 	case "eligible":
 		if len(flag.Args()) != 3 {
 			flag.Usage()
@@ -332,7 +611,7 @@ func Execute() {
 		}
 		app := flag.Arg(1)
 		account := flag.Arg(2)
-		Eligible(spin, spin, app, account, *regionPtr, *stackPtr, *clusterPtr)
+		Eligible(confGetter, dep, app, account, *regionPtr, *stackPtr, *clusterPtr)
 	case "intest":
 		env, err := deps.GetEnv(cfg)
 		if err != nil {
@@ -345,6 +624,11 @@ func Execute() {
 			os.Exit(1)
 		}
 
+		spin, err := spinnaker.NewFromConfig(cfg)
+		if err != nil {
+			log.Fatalf("FATAL: spinnaker.New failed: %+v", err)
+		}
+
 		account := flag.Arg(1)
 		id, err := spin.AccountID(account)
 		if err != nil {
@@ -358,7 +642,7 @@ func Execute() {
 			os.Exit(1)
 		}
 		account := flag.Arg(1)
-		provider, err := spin.CloudProvider(account)
+		provider, err := dep.CloudProvider(account)
 		if err != nil {
 			fmt.Printf("ERROR: Could not retrieve provider for account: %s. Reason: %v\n", account, err)
 			return
@@ -372,7 +656,7 @@ func Execute() {
 
 		app := flag.Arg(1)
 		account := flag.Arg(2)
-		clusters, err := spin.GetClusterNames(app, deploy.AccountName(account))
+		clusters, err := dep.GetClusterNames(app, deploy.AccountName(account))
 		if err != nil {
 			fmt.Printf("ERROR: %v\n", err)
 			os.Exit(1)
@@ -390,7 +674,7 @@ func Execute() {
 		cluster := flag.Arg(1)
 		account := flag.Arg(2)
 
-		DumpRegions(cluster, account, spin)
+		DumpRegions(cluster, account, dep)
 
 	default:
 		flag.Usage()
@@ -414,6 +698,44 @@ func logOnPanic(errCounter chaosmonkey.ErrorCounter) {
 	}
 }
 
+// terminationDeps returns the dependencies needed to terminate instances
+func terminationDeps(cfg *config.Monkey, db Database, confGetter chaosmonkey.AppConfigGetter, dep Deployment, outage chaosmonkey.Outage) deps.Deps {
+	trackers, err := deps.GetTrackers(cfg)
+	if err != nil {
+		log.Fatalf("FATAL: could not create trackers: %+v", err)
+	}
+
+	for _, tr := range trackers {
+		if u, ok := tr.(appConfigUser); ok {
+			u.SetAppConfigGetter(confGetter)
+		}
+	}
+
+	errCounter, err := deps.GetErrorCounter(cfg)
+	if err != nil {
+		log.Fatalf("FATAL: could not create error counter: %+v", err)
+	}
+
+	env, err := deps.GetEnv(cfg)
+	if err != nil {
+		log.Fatalf("FATAL: could not determine environment: %+v", err)
+	}
+
+	return deps.Deps{
+		MonkeyCfg:  cfg,
+		Checker:    db,
+		ConfGetter: confGetter,
+		Cl:         clock.New(),
+		Dep:        dep,
+		T:          dep,
+		Trackers:   trackers,
+		Ou:         outage,
+		ErrCounter: errCounter,
+		Env:        env,
+		Snoozes:    db,
+	}
+}
+
 // return configuration info
 func getConfig() (*config.Monkey, error) {
 	cfg, err := config.Load(configPaths[:])
@@ -0,0 +1,51 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"strings"
+	"testing"
+
+	flag "github.com/spf13/pflag"
+)
+
+func TestCommandName(t *testing.T) {
+	global := flag.NewFlagSet("chaosmonkey", flag.ContinueOnError)
+	global.String("region", "", "")
+	global.Bool("leashed", false, "")
+	global.BoolP("version", "v", false, "")
+
+	snooze := flag.NewFlagSet("snooze", flag.ContinueOnError)
+	snooze.String("until", "", "")
+
+	tests := []struct {
+		args string
+		want string
+	}{
+		{"terminate foo prod", "terminate"},
+		{"--region=us-east-1 terminate foo prod", "terminate"},
+		{"--region us-east-1 terminate foo prod", "terminate"},
+		{"--leashed terminate foo prod", "terminate"},
+		{"-v", ""},
+		{"--until 72h snooze foo", "snooze"},
+		{"-- history", "history"},
+	}
+
+	for _, tt := range tests {
+		if got, want := commandName(strings.Fields(tt.args), global, []*flag.FlagSet{snooze}), tt.want; got != want {
+			t.Errorf("%q: got command %q, want %q", tt.args, got, want)
+		}
+	}
+}
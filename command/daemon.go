@@ -0,0 +1,59 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/daemon"
+	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/lease"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+// Daemon executes the "daemon" command. This runs the daily schedule and the
+// terminations in a single process until it receives SIGINT or SIGTERM.
+// If elector is not nil, it only does so while it holds the leader lease.
+// If warner is not nil, each termination is announced warnBefore ahead.
+func Daemon(d deps.Deps, ss schedstore.SchedStore, cons schedule.Constrainer, elector *lease.Elector, warner schedule.Warner, warnBefore time.Duration) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+
+	go func() {
+		select {
+		case sig := <-sigs:
+			log.Printf("received %s, shutting down", sig)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	serveMetrics(ctx, d.MonkeyCfg)
+
+	err := daemon.New(d, ss, cons, elector, warner, warnBefore).Run(ctx)
+	if err != nil {
+		log.Fatalf("FATAL: %+v", err)
+	}
+}
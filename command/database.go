@@ -0,0 +1,73 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	"github.com/Netflix/chaosmonkey/v2/history"
+	"github.com/Netflix/chaosmonkey/v2/lease"
+	"github.com/Netflix/chaosmonkey/v2/mysql"
+	"github.com/Netflix/chaosmonkey/v2/postgres"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
+	"github.com/Netflix/chaosmonkey/v2/sqlite"
+)
+
+// Database stores the schedules and their amendments, the terminations, the
+// leader lease and the snoozes
+type Database interface {
+	schedstore.SchedStore
+	schedstore.Amender
+	chaosmonkey.Checker
+	history.Store
+	lease.Store
+	snooze.Store
+
+	// Close closes the connection to the database
+	Close() error
+}
+
+// newDatabase returns the database specified by the database.driver
+// config parameter
+func newDatabase(cfg *config.Monkey) (Database, error) {
+	switch driver := cfg.DatabaseDriver(); driver {
+	case "mysql":
+		return mysql.NewFromConfig(cfg)
+	case "postgres":
+		return postgres.NewFromConfig(cfg)
+	case "sqlite":
+		return sqlite.NewFromConfig(cfg)
+	default:
+		return nil, errors.Errorf("unsupported %s: %s", param.DatabaseDriver, driver)
+	}
+}
+
+// migrateDatabase upgrades the database to the latest schema version
+func migrateDatabase(db Database) error {
+	switch db := db.(type) {
+	case mysql.MySQL:
+		return mysql.Migrate(db)
+	case postgres.Postgres:
+		return postgres.Migrate(db)
+	case sqlite.SQLite:
+		return sqlite.Migrate(db)
+	default:
+		return errors.Errorf("migrations not supported for %T", db)
+	}
+}
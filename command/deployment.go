@@ -0,0 +1,62 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/aws"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	"github.com/Netflix/chaosmonkey/v2/deploy"
+	"github.com/Netflix/chaosmonkey/v2/inventory"
+	"github.com/Netflix/chaosmonkey/v2/kubernetes"
+	"github.com/Netflix/chaosmonkey/v2/spinnaker"
+)
+
+// Deployment provides the deployed instances and terminates them
+type Deployment interface {
+	deploy.Deployment
+	chaosmonkey.Terminator
+}
+
+// newDeployment returns the deployment provider specified by the
+// chaosmonkey.deployment config parameter
+func newDeployment(cfg *config.Monkey) (Deployment, error) {
+	switch provider := cfg.Deployment(); provider {
+	case "spinnaker":
+		return spinnaker.NewFromConfig(cfg)
+	case "kubernetes":
+		return kubernetes.NewFromConfig(cfg)
+	case "aws":
+		return aws.NewFromConfig(cfg)
+	case "inventory":
+		return inventory.NewFromConfig(cfg)
+	default:
+		return nil, errors.Errorf("unsupported %s: %s", param.Deployment, provider)
+	}
+}
+
+// newAppConfigGetter returns the source of app configs. Deployment providers
+// that know the app configs (aws and kubernetes) are used directly, otherwise
+// the app configs are retrieved from Spinnaker
+func newAppConfigGetter(cfg *config.Monkey, dep Deployment) (chaosmonkey.AppConfigGetter, error) {
+	if getter, ok := dep.(chaosmonkey.AppConfigGetter); ok {
+		return getter, nil
+	}
+
+	return spinnaker.NewFromConfig(cfg)
+}
@@ -28,12 +28,17 @@ import (
 // and installs it locally.
 func FetchSchedule(s schedstore.SchedStore, cfg *config.Monkey) {
 	log.Println("chaosmonkey fetch-schedule starting")
+	err := checkCronMode(cfg)
+	if err != nil {
+		log.Fatalf("FATAL: %v", err)
+	}
+
 	sched, err := s.Retrieve(today(cfg))
 	if err != nil {
 		log.Fatalf("FATAL: could not fetch schedule: %v", err)
 	}
 
-	if sched == nil {
+	if !schedstore.Published(sched) {
 		log.Println("no schedule to retrieve")
 		return
 	}
@@ -0,0 +1,135 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/history"
+)
+
+// historyTimeFormat is how termination times are shown in tables and CSV
+const historyTimeFormat = "2006-01-02 15:04:05 MST"
+
+// History executes the "history" command. This prints the recorded
+// terminations that match q, most recent first, in format: "table", "json"
+// or "csv". Times are shown in loc.
+func History(hist history.Store, q history.Query, format string, loc *time.Location) {
+	records, err := hist.Terminations(q)
+	if err != nil {
+		log.Fatalf("FATAL: could not retrieve terminations: %v", err)
+	}
+
+	err = writeHistory(os.Stdout, records, format, loc)
+	if err != nil {
+		log.Fatalf("FATAL: %v", err)
+	}
+}
+
+// historyQuery returns the query for the history command's arguments
+func historyQuery(app, account, region, stack, cluster, since, until, only string, limit int, loc *time.Location) (history.Query, error) {
+	q := history.Query{App: app, Account: account, Region: region, Stack: stack, Cluster: cluster, Limit: limit}
+
+	var err error
+	if since != "" {
+		q.Since, err = history.ParseSince(since, loc)
+		if err != nil {
+			return history.Query{}, errors.Wrap(err, "--since")
+		}
+	}
+
+	if until != "" {
+		q.Until, err = history.ParseUntil(until, loc)
+		if err != nil {
+			return history.Query{}, errors.Wrap(err, "--until")
+		}
+	}
+
+	switch only {
+	case "":
+	case "leashed":
+		leashed := true
+		q.Leashed = &leashed
+	case "unleashed":
+		leashed := false
+		q.Leashed = &leashed
+	default:
+		return history.Query{}, errors.Errorf("--only: invalid value %q, want leashed or unleashed", only)
+	}
+
+	return q, nil
+}
+
+// writeHistory writes records to w in format
+func writeHistory(w io.Writer, records []history.Record, format string, loc *time.Location) error {
+	switch format {
+	case "table":
+		return writeHistoryTable(w, records, loc)
+	case "json":
+		if records == nil {
+			records = []history.Record{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(records)
+	case "csv":
+		return writeHistoryCSV(w, records, loc)
+	default:
+		return errors.Errorf("unknown format %q, want table, json or csv", format)
+	}
+}
+
+func writeHistoryTable(w io.Writer, records []history.Record, loc *time.Location) error {
+	if len(records) == 0 {
+		_, err := fmt.Fprintln(w, "no terminations found")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "KILLED AT\tAPP\tACCOUNT\tREGION\tSTACK\tCLUSTER\tASG\tINSTANCE\tLEASHED")
+	for _, r := range records {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%t\n",
+			r.KilledAt.In(loc).Format(historyTimeFormat), r.App, r.Account, r.Region, r.Stack, r.Cluster, r.ASG, r.InstanceID, r.Leashed)
+	}
+	return tw.Flush()
+}
+
+func writeHistoryCSV(w io.Writer, records []history.Record, loc *time.Location) error {
+	cw := csv.NewWriter(w)
+	err := cw.Write([]string{"killed_at", "app", "account", "region", "stack", "cluster", "asg", "instance_id", "leashed"})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		err = cw.Write([]string{r.KilledAt.In(loc).Format(time.RFC3339), r.App, r.Account, r.Region, r.Stack, r.Cluster, r.ASG, r.InstanceID, strconv.FormatBool(r.Leashed)})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/history"
+)
+
+var historyRecords = []history.Record{
+	{App: "foo", Account: "prod", Stack: "prod", Cluster: "foo-prod", Region: "us-east-1", ASG: "foo-prod-v001", InstanceID: "i-d3e3d611", KilledAt: time.Date(2026, time.October, 16, 17, 0, 0, 0, time.UTC), Leashed: false},
+	{App: "foo", Account: "prod", Stack: "prod", Cluster: "foo-prod", Region: "us-east-1", ASG: "foo-prod-v001", InstanceID: "i-63f52e25", KilledAt: time.Date(2026, time.October, 9, 18, 30, 0, 0, time.UTC), Leashed: true},
+}
+
+func TestWriteHistory(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"table", `KILLED AT                APP  ACCOUNT  REGION     STACK  CLUSTER   ASG            INSTANCE    LEASHED
+2026-10-16 10:00:00 PDT  foo  prod     us-east-1  prod   foo-prod  foo-prod-v001  i-d3e3d611  false
+2026-10-09 11:30:00 PDT  foo  prod     us-east-1  prod   foo-prod  foo-prod-v001  i-63f52e25  true
+`},
+		{"csv", `killed_at,app,account,region,stack,cluster,asg,instance_id,leashed
+2026-10-16T10:00:00-07:00,foo,prod,us-east-1,prod,foo-prod,foo-prod-v001,i-d3e3d611,false
+2026-10-09T11:30:00-07:00,foo,prod,us-east-1,prod,foo-prod,foo-prod-v001,i-63f52e25,true
+`},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		err := writeHistory(&buf, historyRecords, tt.format, la)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.format, err)
+		}
+
+		if got := buf.String(); got != tt.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestWriteHistoryJSON(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeHistory(&buf, historyRecords[:1], "json", time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{`"instanceId": "i-d3e3d611"`, `"killedAt": "2026-10-16T17:00:00Z"`, `"leashed": false`} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("got %s, want it to contain %s", buf.String(), want)
+		}
+	}
+
+	buf.Reset()
+	err = writeHistory(&buf, nil, "json", time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := buf.String(), "[]\n"; got != want {
+		t.Errorf("got %q for no records, want %q", got, want)
+	}
+}
+
+func TestWriteHistoryUnknownFormat(t *testing.T) {
+	err := writeHistory(&bytes.Buffer{}, historyRecords, "xml", time.UTC)
+	if err == nil {
+		t.Error("got nil error for unknown format")
+	}
+}
+
+func TestHistoryQuery(t *testing.T) {
+	q, err := historyQuery("foo", "prod", "us-east-1", "", "foo-prod", "2026-10-01", "2026-10-16", "unleashed", 10, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if q.App != "foo" || q.Account != "prod" || q.Region != "us-east-1" || q.Cluster != "foo-prod" || q.Limit != 10 {
+		t.Errorf("got %+v", q)
+	}
+
+	if got, want := q.Since, time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("got since=%s, want %s", got, want)
+	}
+
+	if got, want := q.Until, time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("got until=%s, want %s", got, want)
+	}
+
+	if q.Leashed == nil || *q.Leashed {
+		t.Errorf("got leashed=%v, want false", q.Leashed)
+	}
+
+	for _, args := range [][]string{{"yesterday", "", ""}, {"", "soon", ""}, {"", "", "both"}} {
+		_, err := historyQuery("", "", "", "", "", args[0], args[1], args[2], 0, time.UTC)
+		if err == nil {
+			t.Errorf("got nil error for since=%q until=%q only=%q", args[0], args[1], args[2])
+		}
+	}
+}
@@ -17,7 +17,6 @@ package command
 import (
 	"fmt"
 	"github.com/Netflix/chaosmonkey/v2/config"
-	"github.com/Netflix/chaosmonkey/v2/mysql"
 	"io/ioutil"
 	"log"
 	"os"
@@ -38,7 +37,7 @@ type CurrentExecutable interface {
 }
 
 // Install installs chaosmonkey and runs database migration
-func Install(cfg *config.Monkey, exec CurrentExecutable, db mysql.MySQL) {
+func Install(cfg *config.Monkey, exec CurrentExecutable, db Database) {
 	InstallCron(cfg, exec)
 	Migrate(db)
 	log.Println("installation done!")
@@ -0,0 +1,89 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"log"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/clock"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	"github.com/Netflix/chaosmonkey/v2/lease"
+)
+
+// leaseParams returns the holder name and lease duration of this replica
+func leaseParams(cfg *config.Monkey) (string, time.Duration, error) {
+	ttl := cfg.LeaderElectionLeaseDuration()
+	if ttl <= 0 {
+		return "", 0, errors.Errorf("%s must be positive, got %s", param.LeaderElectionLeaseDuration, ttl)
+	}
+
+	holder := cfg.LeaderElectionHolder()
+	if holder == "" {
+		var err error
+		holder, err = os.Hostname()
+		if err != nil {
+			return "", 0, errors.Wrapf(err, "%s not specified and could not determine hostname", param.LeaderElectionHolder)
+		}
+	}
+
+	return holder, ttl, nil
+}
+
+// newElector returns an elector for the leader lease, or nil if leader
+// election is disabled
+func newElector(cfg *config.Monkey, store lease.Store, cl clock.Clock) (*lease.Elector, error) {
+	if !cfg.LeaderElectionEnabled() {
+		return nil, nil
+	}
+
+	holder, ttl, err := leaseParams(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return lease.NewElector(store, cl, lease.Name, holder, ttl), nil
+}
+
+// acquireLease tries once to acquire the leader lease, for the commands that
+// are run by cron. It returns the fencing token and true if this replica is
+// the leader. If leader election is disabled, every replica is the leader and
+// the token is 0.
+func acquireLease(cfg *config.Monkey, store lease.Store, cl clock.Clock) (int64, bool, error) {
+	if !cfg.LeaderElectionEnabled() {
+		return 0, true, nil
+	}
+
+	holder, ttl, err := leaseParams(cfg)
+	if err != nil {
+		return 0, false, err
+	}
+
+	l, err := store.Acquire(lease.Name, holder, cl.Now(), ttl)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "could not acquire leader lease")
+	}
+
+	if l.Holder != holder {
+		log.Printf("not the leader, lease %s is held by %s until %s", lease.Name, l.Holder, l.Expires)
+		return 0, false, nil
+	}
+
+	return l.Token, true, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/metrics"
+	"github.com/Netflix/chaosmonkey/v2/statsd"
+)
+
+// addMetricsSinks sends metrics to StatsD, if it is configured, in addition
+// to Prometheus
+func addMetricsSinks(cfg *config.Monkey) error {
+	if cfg.StatsdAddress() == "" {
+		return nil
+	}
+
+	c, err := statsd.NewFromConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	metrics.AddSink(c)
+	return nil
+}
+
+// pushMetrics pushes the metrics of a short-lived command to the Pushgateway,
+// if one is configured. Commands must call it before exiting, since
+// log.Fatalf doesn't run deferred calls.
+func pushMetrics(cfg *config.Monkey, cmd string) {
+	metrics.Default.PushOnExit(cfg.MetricsPushURL(), cfg.MetricsPushJob(), "command", cmd)
+}
+
+// serveMetrics serves /metrics on the configured address, if any, until ctx
+// is done
+func serveMetrics(ctx context.Context, cfg *config.Monkey) {
+	addr := cfg.MetricsAddress()
+	if addr == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Default.Handler())
+	srv := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+
+	go func() {
+		log.Printf("serving metrics on %s", addr)
+		err := srv.ListenAndServe()
+		if err != http.ErrServerClosed {
+			log.Printf("ERROR: could not serve metrics: %v", err)
+		}
+	}()
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/cal"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/deploy"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
+)
+
+// Plan statuses
+const (
+	planScheduled = "scheduled"
+	planMoved     = "moved"
+	planRemoved   = "removed"
+)
+
+// plan is a schedule of terminations that hasn't been published, with how
+// the constrainers changed it
+type plan struct {
+	Seed    int64       `json:"seed"`
+	Anchor  time.Time   `json:"anchor"`
+	Entries []planEntry `json:"entries"`
+}
+
+// planEntry is a termination of a plan
+type planEntry struct {
+	App     string    `json:"app"`
+	Account string    `json:"account"`
+	Region  string    `json:"region"`
+	Stack   string    `json:"stack"`
+	Cluster string    `json:"cluster"`
+	Time    time.Time `json:"time"` // in the configured time zone
+	UTC     time.Time `json:"utc"`  // Time, in UTC
+	MTBK    int       `json:"meanTimeBetweenKillsInWorkDays"`
+	Status  string    `json:"status"` // scheduled, moved or removed
+	From    time.Time `json:"from"`   // time the termination was populated at
+	Reason  string    `json:"reason"` // why it was moved or removed, if it was
+}
+
+// Plan executes the "schedule --dry-run" command. This populates and
+// constrains the schedule of terminations for the day like "schedule", and
+// prints it in format, "table" or "json", instead of publishing it and
+// registering it with cron. Terminations the constrainers removed are shown
+// with the reason. If seed is not zero, the schedule is populated from it,
+// and if anchor is not zero, for the day of anchor. Groups snoozed in sn are
+// left out.
+func Plan(g chaosmonkey.AppConfigGetter, sn snooze.Store, cfg *config.Monkey, d deploy.Deployment, cons schedule.Constrainer, apps []string, seed int64, anchor time.Time, format string) {
+	// Still show what would be scheduled, e.g. before enabling it
+	enabled, err := cfg.ScheduleEnabled()
+	if err != nil {
+		log.Fatalf("FATAL: cannot determine if schedule is enabled: %v", err)
+	}
+	if !enabled {
+		log.Println("WARNING: schedule disabled, terminations would not be scheduled")
+	}
+
+	loc, err := cfg.Location()
+	if err != nil {
+		log.Fatalf("FATAL: could not retrieve location: %v", err)
+	}
+
+	if anchor.IsZero() {
+		anchor = time.Now()
+	}
+
+	if today := anchor.In(loc); !cal.IsWorkday(today) {
+		log.Printf("%s is not a work day, no terminations would be scheduled", today.Format("2006-01-02"))
+		return
+	}
+
+	p, err := makePlan(d, g, sn, cfg, cons, apps, seed, anchor, loc)
+	if err != nil {
+		log.Fatalf("FATAL: %v", err)
+	}
+
+	err = writePlan(os.Stdout, p, format)
+	if err != nil {
+		log.Fatalf("FATAL: %v", err)
+	}
+}
+
+// makePlan populates a schedule and constrains it, keeping track of the
+// changes made to each entry. Times are in loc.
+func makePlan(d deploy.Deployment, g chaosmonkey.AppConfigGetter, sn snooze.Store, cfg *config.Monkey, cons schedule.Constrainer, apps []string, seed int64, anchor time.Time, loc *time.Location) (*plan, error) {
+	s := schedule.New()
+	s.SetSeed(seed)
+	s.SetAnchor(anchor)
+	err := setSnoozes(s, sn)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Populate(d, g, cfg, apps)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to populate schedule")
+	}
+
+	mtbk := make(map[string]int)
+	current := make(map[string]*planEntry)
+	var entries []*planEntry
+	for _, e := range s.Entries() {
+		app := e.Group.App()
+		if _, ok := mtbk[app]; !ok {
+			appCfg, err := g.Get(app)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not retrieve config for app=%s", app)
+			}
+			mtbk[app] = appCfg.MeanTimeBetweenKillsInWorkDays
+		}
+
+		pe := newPlanEntry(e, mtbk[app], loc)
+		entries = append(entries, pe)
+		current[planKey(e.Group, e.Time)] = pe
+	}
+
+	// Follow each entry through the changes, which are in order
+	sched, changes := schedule.Explain(cons, *s)
+	for _, c := range changes {
+		k := planKey(c.Entry.Group, c.Entry.Time)
+		pe, ok := current[k]
+		if !ok {
+			continue
+		}
+		delete(current, k)
+
+		pe.Reason = c.Reason
+		if c.Removed() {
+			pe.Status = planRemoved
+			continue
+		}
+
+		pe.Status = planMoved
+		pe.setTime(c.Moved, loc)
+		current[planKey(c.Entry.Group, c.Moved)] = pe
+	}
+
+	// Entries that a constrainer added without explaining are shown as
+	// scheduled
+	for _, e := range sched.Entries() {
+		if _, ok := current[planKey(e.Group, e.Time)]; !ok {
+			entries = append(entries, newPlanEntry(e, mtbk[e.Group.App()], loc))
+		}
+	}
+
+	result := &plan{Seed: s.Seed(), Anchor: s.Anchor().In(loc), Entries: make([]planEntry, 0, len(entries))}
+	for _, pe := range entries {
+		result.Entries = append(result.Entries, *pe)
+	}
+	sort.SliceStable(result.Entries, func(i, j int) bool { return result.Entries[i].Time.Before(result.Entries[j].Time) })
+
+	return result, nil
+}
+
+func newPlanEntry(e schedule.Entry, mtbk int, loc *time.Location) *planEntry {
+	pe := &planEntry{
+		App:     e.Group.App(),
+		Account: e.Group.Account(),
+		MTBK:    mtbk,
+		Status:  planScheduled,
+		From:    e.Time.In(loc),
+	}
+	pe.Region, _ = e.Group.Region()
+	pe.Stack, _ = e.Group.Stack()
+	pe.Cluster, _ = e.Group.Cluster()
+	pe.setTime(e.Time, loc)
+	return pe
+}
+
+func (pe *planEntry) setTime(t time.Time, loc *time.Location) {
+	pe.Time = t.In(loc)
+	pe.UTC = t.UTC()
+}
+
+// planKey identifies an entry of the schedule by group and time
+func planKey(group grp.InstanceGroup, t time.Time) string {
+	return fmt.Sprintf("%s@%d", grp.String(group), t.UnixNano())
+}
+
+// writePlan writes p to w in format
+func writePlan(w io.Writer, p *plan, format string) error {
+	switch format {
+	case "table":
+		return writePlanTable(w, p)
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(p)
+	default:
+		return errors.Errorf("unknown format %q, want table or json", format)
+	}
+}
+
+func writePlanTable(w io.Writer, p *plan) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tUTC\tAPP\tACCOUNT\tREGION\tSTACK\tCLUSTER\tMTBK\tSTATUS\tREASON")
+	scheduled := 0
+	for _, e := range p.Entries {
+		status := e.Status
+		switch e.Status {
+		case planMoved:
+			status = fmt.Sprintf("moved from %s", e.From.Format("15:04"))
+			scheduled++
+		case planScheduled:
+			scheduled++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			e.Time.Format(historyTimeFormat), e.UTC.Format("15:04"), e.App, e.Account, e.Region, e.Stack, e.Cluster, e.MTBK, status, e.Reason)
+	}
+	err := tw.Flush()
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "\n%d terminations would be scheduled, %d removed (seed=%d anchor=%s)\n", scheduled, len(p.Entries)-scheduled, p.Seed, p.Anchor.Format(time.RFC3339))
+	return err
+}
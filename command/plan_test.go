@@ -0,0 +1,168 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/constrainer"
+	"github.com/Netflix/chaosmonkey/v2/mock"
+)
+
+func TestMakePlan(t *testing.T) {
+	appCfg := chaosmonkey.NewAppConfig(nil)
+	appCfg.Grouping = chaosmonkey.App
+	appCfg.MeanTimeBetweenKillsInWorkDays = 1
+
+	cfg := config.Defaults()
+	loc, err := cfg.Location()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cons := constrainer.NewRateLimit(constrainer.Limits{PerDay: 3}, false, cfg.EndHour(), loc)
+
+	p, err := makePlan(mock.Dep(), mock.NewConfigGetter(appCfg), nil, cfg, cons, nil, 42, time.Time{}, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := p.Seed, int64(42); got != want {
+		t.Errorf("got seed %d, want %d", got, want)
+	}
+
+	if got, want := len(p.Entries), 4; got != want {
+		t.Fatalf("got %d entries, want %d", got, want)
+	}
+
+	// The mock deployment has 4 apps, and the last of them is rate limited
+	for i, e := range p.Entries {
+		want := planScheduled
+		if i == 3 {
+			want = planRemoved
+		}
+		if e.Status != want {
+			t.Errorf("entry %d: got status %s, want %s", i, e.Status, want)
+		}
+
+		if got, want := e.MTBK, 1; got != want {
+			t.Errorf("entry %d: got mtbk %d, want %d", i, got, want)
+		}
+
+		if !e.UTC.Equal(e.Time) || e.UTC.Location() != time.UTC {
+			t.Errorf("entry %d: got utc %s, want %s in UTC", i, e.UTC, e.Time)
+		}
+	}
+
+	if got, want := p.Entries[3].Reason, "rate limit: 3 terminations per day"; got != want {
+		t.Errorf("got reason %q, want %q", got, want)
+	}
+}
+
+func TestWritePlan(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := func(hh, mm int) time.Time { return time.Date(2026, time.October, 19, hh, mm, 0, 0, la) }
+	p := &plan{
+		Seed:   42,
+		Anchor: at(7, 0),
+		Entries: []planEntry{
+			{App: "foo", Account: "prod", Region: "us-east-1", Cluster: "foo-prod", Time: at(9, 5), UTC: at(9, 5).UTC(), MTBK: 5, Status: planScheduled, From: at(9, 5)},
+			{App: "bar", Account: "prod", Cluster: "bar-prod", Time: at(13, 0), UTC: at(13, 0).UTC(), MTBK: 2, Status: planMoved, From: at(12, 10), Reason: "blackout window lunch"},
+			{App: "baz", Account: "test", Time: at(14, 30), UTC: at(14, 30).UTC(), MTBK: 1, Status: planRemoved, From: at(14, 30), Reason: "rate limit: 2 terminations per day"},
+		},
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"table", `TIME                     UTC    APP  ACCOUNT  REGION     STACK  CLUSTER   MTBK  STATUS            REASON
+2026-10-19 09:05:00 PDT  16:05  foo  prod     us-east-1         foo-prod  5     scheduled         
+2026-10-19 13:00:00 PDT  20:00  bar  prod                       bar-prod  2     moved from 12:10  blackout window lunch
+2026-10-19 14:30:00 PDT  21:30  baz  test                                 1     removed           rate limit: 2 terminations per day
+
+2 terminations would be scheduled, 1 removed (seed=42 anchor=2026-10-19T07:00:00-07:00)
+`},
+		{"json", `{
+  "seed": 42,
+  "anchor": "2026-10-19T07:00:00-07:00",
+  "entries": [
+    {
+      "app": "foo",
+      "account": "prod",
+      "region": "us-east-1",
+      "stack": "",
+      "cluster": "foo-prod",
+      "time": "2026-10-19T09:05:00-07:00",
+      "utc": "2026-10-19T16:05:00Z",
+      "meanTimeBetweenKillsInWorkDays": 5,
+      "status": "scheduled",
+      "from": "2026-10-19T09:05:00-07:00",
+      "reason": ""
+    },
+    {
+      "app": "bar",
+      "account": "prod",
+      "region": "",
+      "stack": "",
+      "cluster": "bar-prod",
+      "time": "2026-10-19T13:00:00-07:00",
+      "utc": "2026-10-19T20:00:00Z",
+      "meanTimeBetweenKillsInWorkDays": 2,
+      "status": "moved",
+      "from": "2026-10-19T12:10:00-07:00",
+      "reason": "blackout window lunch"
+    },
+    {
+      "app": "baz",
+      "account": "test",
+      "region": "",
+      "stack": "",
+      "cluster": "",
+      "time": "2026-10-19T14:30:00-07:00",
+      "utc": "2026-10-19T21:30:00Z",
+      "meanTimeBetweenKillsInWorkDays": 1,
+      "status": "removed",
+      "from": "2026-10-19T14:30:00-07:00",
+      "reason": "rate limit: 2 terminations per day"
+    }
+  ]
+}
+`},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		err := writePlan(&buf, p, tt.format)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.format, err)
+		}
+
+		if got := buf.String(); got != tt.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.format, got, tt.want)
+		}
+	}
+
+	if err := writePlan(&bytes.Buffer{}, p, "csv"); err == nil {
+		t.Error("got no error for csv")
+	}
+}
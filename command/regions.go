@@ -17,13 +17,12 @@ package command
 import (
 	"fmt"
 	"github.com/Netflix/chaosmonkey/v2/deploy"
-	"github.com/Netflix/chaosmonkey/v2/spinnaker"
 	"github.com/SmartThingsOSS/frigga-go"
 	"os"
 )
 
 // DumpRegions lists the regions that a cluster is in
-func DumpRegions(cluster, account string, spin spinnaker.Spinnaker) {
+func DumpRegions(cluster, account string, dep deploy.Deployment) {
 
 	names, err := frigga.Parse(cluster)
 	if err != nil {
@@ -31,7 +30,7 @@ func DumpRegions(cluster, account string, spin spinnaker.Spinnaker) {
 		os.Exit(1)
 	}
 
-	regions, err := spin.GetRegionNames(names.App, deploy.AccountName(account), deploy.ClusterName(cluster))
+	regions, err := dep.GetRegionNames(names.App, deploy.AccountName(account), deploy.ClusterName(cluster))
 	if err != nil {
 		fmt.Printf("ERROR: %v", err)
 		os.Exit(1)
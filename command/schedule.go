@@ -22,15 +22,19 @@ import (
 	"time"
 
 	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/cal"
 	"github.com/Netflix/chaosmonkey/v2/config"
 	"github.com/Netflix/chaosmonkey/v2/deploy"
+	"github.com/Netflix/chaosmonkey/v2/metrics"
 	"github.com/Netflix/chaosmonkey/v2/schedstore"
 	"github.com/Netflix/chaosmonkey/v2/schedule"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
 )
 
 // Schedule executes the "schedule" command. This defines the schedule
-// of terminations for the day and records them as cron jobs
-func Schedule(g chaosmonkey.AppConfigGetter, ss schedstore.SchedStore, cfg *config.Monkey, d deploy.Deployment, cons schedule.Constrainer, apps []string) {
+// of terminations for the day and records them as cron jobs. Groups snoozed in
+// sn are not scheduled.
+func Schedule(g chaosmonkey.AppConfigGetter, ss schedstore.SchedStore, sn snooze.Store, cfg *config.Monkey, d deploy.Deployment, cons schedule.Constrainer, apps []string) {
 
 	enabled, err := cfg.ScheduleEnabled()
 	if err != nil {
@@ -41,13 +45,26 @@ func Schedule(g chaosmonkey.AppConfigGetter, ss schedstore.SchedStore, cfg *conf
 		return
 	}
 
+	loc, err := cfg.Location()
+	if err != nil {
+		log.Fatalf("FATAL: could not retrieve location: %v", err)
+	}
+
+	now := time.Now()
+	if today := now.In(loc); !cal.IsWorkday(today) {
+		log.Printf("%s is not a work day, not scheduling terminations", today.Format("2006-01-02"))
+		return
+	}
+
 	/*
 	 Note: We don't check for the enable flag during scheduling, only
 	 during terminations. That way, if chaos monkey is disabled during
 	 scheduling time but later in the day becomes enabled, it still
 	 functions correctly.
 	*/
-	err = do(d, g, ss, cfg, cons, apps)
+	err = do(d, g, ss, sn, cfg, cons, apps, now)
+
+	pushMetrics(cfg, "schedule")
 
 	if err != nil {
 		log.Fatalf("FATAL: %v", err)
@@ -55,17 +72,32 @@ func Schedule(g chaosmonkey.AppConfigGetter, ss schedstore.SchedStore, cfg *conf
 
 }
 
-// do is the actual implementation for the Schedule function
-func do(d deploy.Deployment, g chaosmonkey.AppConfigGetter, ss schedstore.SchedStore, cfg *config.Monkey, cons schedule.Constrainer, apps []string) error {
+// do is the actual implementation for the Schedule function. The schedule is
+// made for the day of now.
+func do(d deploy.Deployment, g chaosmonkey.AppConfigGetter, ss schedstore.SchedStore, sn snooze.Store, cfg *config.Monkey, cons schedule.Constrainer, apps []string, now time.Time) error {
+	err := checkCronMode(cfg)
+	if err != nil {
+		return err
+	}
 
 	s := schedule.New()
-	err := s.Populate(d, g, cfg, apps)
+	s.SetAnchor(now)
+	err = setSnoozes(s, sn)
+	if err != nil {
+		return err
+	}
+
+	err = s.Populate(d, g, cfg, apps)
 	if err != nil {
 		return fmt.Errorf("failed to populate schedule: %v", err)
 	}
 
-	// Filter out terminations that violate constrains
+	// Filter out terminations that violate constrains. Constrainers don't
+	// necessarily keep the seed and anchor.
 	sched := cons.Filter(*s)
+	sched.SetSeed(s.Seed())
+	sched.SetAnchor(s.Anchor())
+	metrics.RecordSchedule(len(sched.Entries()), time.Now())
 
 	err = deploySchedule(&sched, ss, cfg)
 	if err != nil {
@@ -75,15 +107,37 @@ func do(d deploy.Deployment, g chaosmonkey.AppConfigGetter, ss schedstore.SchedS
 	return nil
 }
 
-// deploySchedule publishes the schedule to chaosmonkey-api
-// and registers the schedule with the local cron
+// setSnoozes sets the snoozes of s to those active in sn at its anchor, or now
+// if it has none, so that a replayed schedule skips the groups that were
+// snoozed then. None are set if sn is nil.
+func setSnoozes(s *schedule.Schedule, sn snooze.Store) error {
+	if sn == nil {
+		return nil
+	}
+
+	at := s.Anchor()
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	snoozes, err := sn.Snoozes(at)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve snoozes: %v", err)
+	}
+
+	s.SetSnoozes(snoozes)
+	return nil
+}
+
+// deploySchedule publishes the schedule to chaosmonkey-api for the day of its
+// anchor and registers the schedule with the local cron
 func deploySchedule(s *schedule.Schedule, ss schedstore.SchedStore, cfg *config.Monkey) error {
 	loc, err := cfg.Location()
 	if err != nil {
 		return fmt.Errorf("deploySchedule: could not retrieve local timezone: %v", err)
 	}
 
-	today := time.Now().In(loc)
+	today := s.Anchor().In(loc)
 
 	err = ss.Publish(today, s)
 
@@ -105,3 +159,17 @@ func registerWithCron(s *schedule.Schedule, cfg *config.Monkey) error {
 	err := ioutil.WriteFile(cfg.CronPath(), crontab, perms)
 	return err
 }
+
+// parseAnchor parses the anchor of a recorded schedule, as logged with its
+// seed in RFC 3339. A blank anchor is the zero time.
+func parseAnchor(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("bad anchor %q, want an RFC 3339 time, e.g. 2026-10-19T07:00:00-07:00", s)
+	}
+	return t, nil
+}
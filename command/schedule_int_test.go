@@ -17,6 +17,8 @@ package command
 import (
 	"bytes"
 	"io/ioutil"
+	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -52,7 +54,7 @@ func TestScheduleCommand(t *testing.T) {
 		t.Fatalf("%v", err)
 	}
 
-	err = do(d, a, a, cfg, constrainer.NullConstrainer{}, appNames)
+	err = do(d, a, a, nil, cfg, constrainer.NullConstrainer{}, appNames, time.Now())
 
 	if err != nil {
 		t.Errorf("%v", err)
@@ -74,6 +76,31 @@ func TestScheduleCommand(t *testing.T) {
 
 }
 
+// Warnings need the daemon, so scheduling with cron refuses them rather than
+// silently not sending them
+func TestScheduleCommandRejectsWarnings(t *testing.T) {
+	cronFile := "/tmp/chaoscron"
+	err := EnsureFileAbsent(cronFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := mock.Dep()
+	a := new(mockAPI)
+	cfg := config.Defaults()
+	cfg.Set(param.CronPath, cronFile)
+	cfg.Set(param.SlackWarningLeadTime, "30m")
+
+	err = do(d, a, a, nil, cfg, constrainer.NullConstrainer{}, nil, time.Now())
+	if err == nil || !strings.Contains(err.Error(), param.SlackWarningLeadTime) {
+		t.Errorf("got %v, want an error about %s", err, param.SlackWarningLeadTime)
+	}
+
+	if _, err := os.Stat(cronFile); !os.IsNotExist(err) {
+		t.Errorf("got %s written, want no cron jobs", cronFile)
+	}
+}
+
 // countEntries counts the number of entries in a cron file's contents
 func countEntries(buf []byte) int {
 	return bytes.Count(buf, []byte("\n"))
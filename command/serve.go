@@ -0,0 +1,77 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/history"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+	"github.com/Netflix/chaosmonkey/v2/server"
+)
+
+// shutdownTimeout is how long in-flight requests have to complete on shutdown
+const shutdownTimeout = 30 * time.Second
+
+// Serve executes the "serve" command. This serves the HTTP API on the
+// configured address until it receives SIGINT or SIGTERM. Terminations
+// requested over the API are made under the leader lease, acquired with
+// leader.
+func Serve(d deps.Deps, ss schedstore.SchedStore, hist history.Store, leader server.Leader) {
+	api, err := server.NewFromConfig(d, ss, hist, leader)
+	if err != nil {
+		log.Fatalf("FATAL: could not initialize HTTP API: %+v", err)
+	}
+
+	addr := d.MonkeyCfg.ServerAddress()
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           api.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sig := <-sigs
+		log.Printf("received %s, shutting down", sig)
+
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		err := srv.Shutdown(ctx)
+		if err != nil {
+			log.Printf("WARNING: shutdown failed: %v", err)
+		}
+	}()
+
+	log.Printf("chaosmonkey serving HTTP API on %s", addr)
+	err = srv.ListenAndServe()
+	if err != http.ErrServerClosed {
+		log.Fatalf("FATAL: %v", err)
+	}
+
+	<-done
+}
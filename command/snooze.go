@@ -0,0 +1,169 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/history"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
+)
+
+// Snooze executes the "snooze" command. This opts app out of terminations
+// until a time, only in account and cluster if they are not blank. until is
+// a duration from now, a date in the configured time zone, which includes the
+// whole day, or RFC 3339. The snooze replaces any earlier snooze of the same
+// app, account and cluster. If by is empty, the app is snoozed by the current
+// user.
+func Snooze(sn snooze.Store, cfg *config.Monkey, app, account, cluster, until, by, reason string) {
+	loc, err := cfg.Location()
+	if err != nil {
+		log.Fatalf("FATAL: could not retrieve location: %v", err)
+	}
+
+	if by == "" {
+		by = currentUser()
+	}
+
+	s, err := newSnooze(app, account, cluster, until, by, reason, time.Now().In(loc))
+	if err != nil {
+		log.Fatalf("FATAL: %v", err)
+	}
+
+	err = sn.Snooze(s)
+	if err != nil {
+		log.Fatalf("FATAL: could not snooze %s: %v", snoozeTarget(s), err)
+	}
+
+	log.Printf("snoozed %s until %s", snoozeTarget(s), s.Until.In(loc).Format(historyTimeFormat))
+}
+
+// Unsnooze executes the "unsnooze" command. This ends the snooze of app,
+// account and cluster before it expires.
+func Unsnooze(sn snooze.Store, app, account, cluster string) {
+	target := snoozeTarget(snooze.Snooze{App: app, Account: account, Cluster: cluster})
+	ok, err := sn.Unsnooze(app, account, cluster, time.Now())
+	if err != nil {
+		log.Fatalf("FATAL: could not unsnooze %s: %v", target, err)
+	}
+
+	if !ok {
+		log.Printf("%s is not snoozed", target)
+		return
+	}
+
+	log.Printf("unsnoozed %s", target)
+}
+
+// ListSnoozes executes the "snooze list" command. This prints the snoozes
+// that have not expired.
+func ListSnoozes(sn snooze.Store, cfg *config.Monkey) {
+	loc, err := cfg.Location()
+	if err != nil {
+		log.Fatalf("FATAL: could not retrieve location: %v", err)
+	}
+
+	snoozes, err := sn.Snoozes(time.Now())
+	if err != nil {
+		log.Fatalf("FATAL: could not retrieve snoozes: %v", err)
+	}
+
+	err = writeSnoozes(os.Stdout, snoozes, loc)
+	if err != nil {
+		log.Fatalf("FATAL: %v", err)
+	}
+}
+
+// newSnooze returns the snooze for the "snooze" command's arguments, made at
+// now. Dates are in the location of now.
+func newSnooze(app, account, cluster, until, by, reason string, now time.Time) (snooze.Snooze, error) {
+	if until == "" {
+		return snooze.Snooze{}, errors.New("--until: required, snoozes must expire")
+	}
+
+	if reason == "" {
+		return snooze.Snooze{}, errors.New("--reason: required")
+	}
+
+	t, err := parseSnoozeUntil(until, now)
+	if err != nil {
+		return snooze.Snooze{}, errors.Wrap(err, "--until")
+	}
+
+	if !t.After(now) {
+		return snooze.Snooze{}, errors.Errorf("--until: %s is in the past", until)
+	}
+
+	s := snooze.Snooze{App: app, Account: account, Cluster: cluster, Until: t, By: by, Reason: reason, At: now}
+	return s, s.Check()
+}
+
+// parseSnoozeUntil parses s as a duration from now, e.g. 72h, or as the
+// upper bound of a time range, see history.ParseUntil
+func parseSnoozeUntil(s string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(d), nil
+	}
+
+	t, err := history.ParseUntil(s, now.Location())
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid time %q, want a duration, YYYY-MM-DD or RFC 3339", s)
+	}
+	return t, nil
+}
+
+// snoozeTarget describes what s snoozes
+func snoozeTarget(s snooze.Snooze) string {
+	target := "app=" + s.App
+	if s.Account != "" {
+		target += " account=" + s.Account
+	}
+	if s.Cluster != "" {
+		target += " cluster=" + s.Cluster
+	}
+	return target
+}
+
+// writeSnoozes writes snoozes to w as a table, with times in loc
+func writeSnoozes(w io.Writer, snoozes []snooze.Snooze, loc *time.Location) error {
+	if len(snoozes) == 0 {
+		_, err := fmt.Fprintln(w, "no apps are snoozed")
+		return err
+	}
+
+	// all shows a blank account or cluster, which covers them all
+	all := func(s string) string {
+		if s == "" {
+			return "*"
+		}
+		return s
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "APP\tACCOUNT\tCLUSTER\tUNTIL\tBY\tSNOOZED AT\tREASON")
+	for _, s := range snoozes {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			s.App, all(s.Account), all(s.Cluster), s.Until.In(loc).Format(historyTimeFormat), s.By, s.At.In(loc).Format(historyTimeFormat), s.Reason)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
+)
+
+func TestNewSnooze(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2026, time.October, 19, 11, 0, 0, 0, la)
+
+	tests := []struct {
+		until string
+		want  time.Time
+	}{
+		{"72h", time.Date(2026, time.October, 22, 11, 0, 0, 0, la)},
+		{"2026-10-23", time.Date(2026, time.October, 24, 0, 0, 0, 0, la)},
+		{"2026-10-19", time.Date(2026, time.October, 20, 0, 0, 0, 0, la)},
+		{"2026-10-20T17:00:00Z", time.Date(2026, time.October, 20, 10, 0, 0, 0, la)},
+	}
+
+	for _, tt := range tests {
+		s, err := newSnooze("foo", "prod", "foo-prod", tt.until, "alice", "migration", now)
+		if err != nil {
+			t.Errorf("--until=%s: %v", tt.until, err)
+			continue
+		}
+
+		if got, want := s.Until, tt.want; !got.Equal(want) {
+			t.Errorf("--until=%s: got snooze until %s, want %s", tt.until, got, want)
+		}
+
+		if s.App != "foo" || s.Account != "prod" || s.Cluster != "foo-prod" || s.By != "alice" || s.Reason != "migration" || !s.At.Equal(now) {
+			t.Errorf("--until=%s: got %+v, want foo in prod, foo-prod snoozed by alice for migration at %s", tt.until, s, now)
+		}
+	}
+
+	bad := []struct {
+		until, reason string
+	}{
+		{"", "migration"},
+		{"72h", ""},
+		{"next week", "migration"},
+		{"-1h", "migration"},
+		{"2026-10-18", "migration"},
+	}
+
+	for _, tt := range bad {
+		if _, err := newSnooze("foo", "", "", tt.until, "alice", tt.reason, now); err == nil {
+			t.Errorf("--until=%s --reason=%s: got no error", tt.until, tt.reason)
+		}
+	}
+}
+
+func TestWriteSnoozes(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snoozes := []snooze.Snooze{
+		{App: "bar", Account: "prod", Cluster: "bar-prod", Until: time.Date(2026, time.October, 20, 7, 0, 0, 0, time.UTC), By: "bob", Reason: "load test", At: time.Date(2026, time.October, 19, 17, 0, 0, 0, time.UTC)},
+		{App: "foo", Until: time.Date(2026, time.October, 24, 7, 0, 0, 0, time.UTC), By: "alice", Reason: "migration", At: time.Date(2026, time.October, 19, 17, 5, 0, 0, time.UTC)},
+	}
+
+	var buf bytes.Buffer
+	err = writeSnoozes(&buf, snoozes, la)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `APP  ACCOUNT  CLUSTER   UNTIL                    BY     SNOOZED AT               REASON
+bar  prod     bar-prod  2026-10-20 00:00:00 PDT  bob    2026-10-19 10:00:00 PDT  load test
+foo  *        *         2026-10-24 00:00:00 PDT  alice  2026-10-19 10:05:00 PDT  migration
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	buf.Reset()
+	err = writeSnoozes(&buf, nil, la)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "no apps are snoozed\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// snoozesAt is a snooze store that records the time snoozes are retrieved at
+type snoozesAt struct {
+	snooze.Store
+	at time.Time
+}
+
+func (s *snoozesAt) Snoozes(now time.Time) ([]snooze.Snooze, error) {
+	s.at = now
+	return nil, nil
+}
+
+// A replayed schedule skips the groups that were snoozed at its anchor
+func TestSetSnoozesAtAnchor(t *testing.T) {
+	anchor := time.Date(2026, time.October, 19, 7, 0, 0, 0, time.UTC)
+	sched := schedule.New()
+	sched.SetAnchor(anchor)
+	sn := new(snoozesAt)
+
+	err := setSnoozes(sched, sn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := sn.at, anchor; !got.Equal(want) {
+		t.Errorf("got snoozes at %s, want %s", got, want)
+	}
+}
@@ -17,7 +17,9 @@ package command
 import (
 	"log"
 
+	"github.com/Netflix/chaosmonkey/v2/config"
 	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
 	"github.com/Netflix/chaosmonkey/v2/term"
 )
 
@@ -32,6 +34,27 @@ func Terminate(d deps.Deps, app string, account string, region string, stack str
 		if cerr != nil {
 			log.Printf("WARNING could not increment error counter: %v", cerr)
 		}
+	}
+
+	pushMetrics(d.MonkeyCfg, "terminate")
+
+	if err != nil {
 		log.Fatalf("FATAL %v\n\nstack trace:\n%+v", err, err)
 	}
 }
+
+// scheduleSeed returns the seed of today's schedule, so that instance picks
+// derive from it, or 0 if it can't be retrieved
+func scheduleSeed(s schedstore.SchedStore, cfg *config.Monkey) int64 {
+	sched, err := s.Retrieve(today(cfg))
+	if err != nil {
+		log.Printf("WARNING: could not retrieve today's schedule seed: %v", err)
+		return 0
+	}
+
+	if !schedstore.Published(sched) {
+		return 0
+	}
+
+	return sched.Seed()
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+	"github.com/Netflix/chaosmonkey/v2/slack"
+)
+
+// appConfigUser is implemented by trackers that look up app configs, e.g.
+// to find out where to send notifications
+type appConfigUser interface {
+	SetAppConfigGetter(getter chaosmonkey.AppConfigGetter)
+}
+
+// newWarner returns the warner that announces terminations and how long
+// ahead it does so. It returns a nil warner if warnings are disabled.
+func newWarner(cfg *config.Monkey, confGetter chaosmonkey.AppConfigGetter) (schedule.Warner, time.Duration, error) {
+	lead := cfg.SlackWarningLeadTime()
+	if lead <= 0 {
+		return nil, 0, nil
+	}
+
+	s, err := slack.NewFromConfig(cfg)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	s.SetAppConfigGetter(confGetter)
+	return s, lead, nil
+}
+
+// checkCronMode returns an error if cfg asks for something that only the
+// daemon does. With cron, no Chaos Monkey process runs ahead of a
+// termination to warn about it.
+func checkCronMode(cfg *config.Monkey) error {
+	if cfg.SlackWarningLeadTime() > 0 {
+		return errors.Errorf("%s needs chaosmonkey daemon, unset it to schedule terminations with cron", param.SlackWarningLeadTime)
+	}
+	return nil
+}
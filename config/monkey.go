@@ -59,8 +59,11 @@ func (m *Monkey) setDefaults() {
 	m.v.SetDefault(param.Trackers, []string{})
 	m.v.SetDefault(param.Decryptor, "")
 	m.v.SetDefault(param.OutageChecker, "")
+	m.v.SetDefault(param.Deployment, "spinnaker")
+	m.v.SetDefault(param.Constrainers, []string{})
 
-	m.v.SetDefault(param.DatabasePort, 3306)
+	m.v.SetDefault(param.DatabaseDriver, "mysql")
+	m.v.SetDefault(param.DatabaseSSLMode, "")
 
 	m.v.SetDefault(param.SpinnakerEndpoint, "")
 	m.v.SetDefault(param.SpinnakerCertificate, "")
@@ -69,6 +72,95 @@ func (m *Monkey) setDefaults() {
 	m.v.SetDefault(param.SpinnakerX509Cert, "")
 	m.v.SetDefault(param.SpinnakerX509Key, "")
 
+	m.v.SetDefault(param.KubernetesEndpoint, "")
+	m.v.SetDefault(param.KubernetesCACert, "")
+	m.v.SetDefault(param.KubernetesTokenFile, "")
+	m.v.SetDefault(param.KubernetesAccount, "kubernetes")
+	m.v.SetDefault(param.KubernetesAppLabel, "app")
+	m.v.SetDefault(param.KubernetesNamespaces, []string{})
+	m.v.SetDefault(param.KubernetesDefaultEnabled, false)
+	m.v.SetDefault(param.KubernetesDefaultMeanTimeBetweenKills, 5)
+	m.v.SetDefault(param.KubernetesDefaultMinTimeBetweenKills, 1)
+	m.v.SetDefault(param.KubernetesDefaultGrouping, "cluster")
+
+	m.v.SetDefault(param.AWSAccount, "")
+	m.v.SetDefault(param.AWSRegions, []string{})
+	m.v.SetDefault(param.AWSEndpoint, "")
+	m.v.SetDefault(param.AWSAccessKeyID, "")
+	m.v.SetDefault(param.AWSEncryptedSecretAccessKey, "")
+	m.v.SetDefault(param.AWSTimeout, "30s")
+
+	m.v.SetDefault(param.InventoryPath, "")
+
+	m.v.SetDefault(param.LeaderElectionEnabled, false)
+	m.v.SetDefault(param.LeaderElectionLeaseDuration, "30s")
+	m.v.SetDefault(param.LeaderElectionHolder, "")
+
+	m.v.SetDefault(param.WebhookURLs, []string{})
+	m.v.SetDefault(param.WebhookEncryptedSecret, "")
+	m.v.SetDefault(param.WebhookTimeout, "10s")
+	m.v.SetDefault(param.WebhookMaxAttempts, 3)
+	m.v.SetDefault(param.WebhookBackoff, "1s")
+	m.v.SetDefault(param.WebhookBlocking, true)
+
+	m.v.SetDefault(param.SlackEndpoint, "https://slack.com/api")
+	m.v.SetDefault(param.SlackEncryptedToken, "")
+	m.v.SetDefault(param.SlackDefaultChannel, "")
+	m.v.SetDefault(param.SlackChannels, map[string]string{})
+	m.v.SetDefault(param.SlackTimeout, "10s")
+	m.v.SetDefault(param.SlackWarningLeadTime, "0s")
+	m.v.SetDefault(param.SlackBlocking, false)
+
+	m.v.SetDefault(param.FileTrackerPath, "/var/log/chaosmonkey-terminations.jsonl")
+	m.v.SetDefault(param.FileTrackerMaxSizeMB, 100)
+	m.v.SetDefault(param.FileTrackerMaxBackups, 5)
+
+	m.v.SetDefault(param.MetricsAddress, "")
+	m.v.SetDefault(param.MetricsPushURL, "")
+	m.v.SetDefault(param.MetricsPushJob, "chaosmonkey")
+
+	m.v.SetDefault(param.StatsdAddress, "")
+	m.v.SetDefault(param.StatsdPrefix, "chaosmonkey")
+	m.v.SetDefault(param.StatsdTags, []string{})
+	m.v.SetDefault(param.StatsdEvents, false)
+
+	m.v.SetDefault(param.HTTPOutageURLs, []string{})
+	m.v.SetDefault(param.HTTPOutageTimeout, "5s")
+	m.v.SetDefault(param.HTTPOutageCacheTTL, "30s")
+	m.v.SetDefault(param.HTTPOutageFailOpen, false)
+	m.v.SetDefault(param.HTTPOutageStatusCodes, []int{})
+	m.v.SetDefault(param.HTTPOutageJSONPath, "")
+	m.v.SetDefault(param.HTTPOutageJSONOutageValues, []string{})
+	m.v.SetDefault(param.HTTPOutageBodyPattern, "")
+
+	m.v.SetDefault(param.AlertmanagerEndpoint, "")
+	m.v.SetDefault(param.AlertmanagerMatchers, []string{"severity=critical"})
+	m.v.SetDefault(param.AlertmanagerTimeout, "10s")
+	m.v.SetDefault(param.AlertmanagerCacheTTL, "1m")
+	m.v.SetDefault(param.AlertmanagerLookback, "15m")
+	m.v.SetDefault(param.AlertmanagerStateFile, "/var/tmp/chaosmonkey-alertmanager.json")
+	m.v.SetDefault(param.AlertmanagerScope, map[string]string{})
+
+	m.v.SetDefault(param.RateLimitMaxPerDay, 0)
+	m.v.SetDefault(param.RateLimitMaxPerAccount, 0)
+	m.v.SetDefault(param.RateLimitMaxPerRegion, 0)
+	m.v.SetDefault(param.RateLimitMaxPerWindow, 0)
+	m.v.SetDefault(param.RateLimitWindow, "60m")
+	m.v.SetDefault(param.RateLimitReschedule, true)
+
+	m.v.SetDefault(param.BlackoutFile, "")
+	m.v.SetDefault(param.BlackoutICSFile, "")
+	m.v.SetDefault(param.BlackoutShift, false)
+
+	m.v.SetDefault(param.CalendarWorkdays, []string{"mon", "tue", "wed", "thu", "fri"})
+	m.v.SetDefault(param.CalendarCountry, "")
+	m.v.SetDefault(param.CalendarHolidays, []string{})
+	m.v.SetDefault(param.CalendarICSFile, "")
+
+	m.v.SetDefault(param.ServerAddress, "localhost:8080")
+	m.v.SetDefault(param.ServerEncryptedToken, "")
+	m.v.SetDefault(param.ServerAllowUnleashed, false)
+
 	m.v.SetDefault(param.DynamicProvider, "")
 	m.v.SetDefault(param.DynamicEndpoint, "")
 	m.v.SetDefault(param.DynamicPath, "")
@@ -258,6 +350,21 @@ func toStrings(values []interface{}) ([]string, error) {
 	return result, nil
 }
 
+func toInts(values []interface{}) ([]int, error) {
+	result := make([]int, len(values))
+	for i, x := range values {
+		switch x := x.(type) {
+		case int:
+			result[i] = x
+		case int64:
+			result[i] = int(x)
+		default:
+			return nil, errors.Errorf("non-integer in %v", values)
+		}
+	}
+	return result, nil
+}
+
 // StartHour (o'clock) is when Chaos
 // Monkey starts terminating this value is in [0,23] This is time-zone
 // dependent, see the Location method
@@ -307,6 +414,12 @@ func (m *Monkey) Trackers() ([]string, error) {
 	return m.getStringSlice(param.Trackers)
 }
 
+// Constrainers returns the names of the constrainers that filter the
+// schedule, in the order they are applied
+func (m *Monkey) Constrainers() ([]string, error) {
+	return m.getStringSlice(param.Constrainers)
+}
+
 // ErrorCounter returns the names of the backend implementions for
 // error counters. Intended for monitoring/alerting.
 func (m *Monkey) ErrorCounter() string {
@@ -318,12 +431,12 @@ func (m *Monkey) getStringSlice(key string) ([]string, error) {
 	// represents a list of strings, so we need to handle both cases
 	t := m.v.Get(key)
 	if t == nil {
-		return nil, fmt.Errorf("%s not specified", param.Accounts)
+		return nil, fmt.Errorf("%s not specified", key)
 	}
 
 	switch t := t.(type) {
 	default:
-		return nil, fmt.Errorf("%s: unexpected type %T", param.Accounts, t)
+		return nil, fmt.Errorf("%s: unexpected type %T", key, t)
 	case []string: // When set explicitly in code
 		return t, nil
 	case []interface{}: // When reading from config file
@@ -336,6 +449,26 @@ func (m *Monkey) getStringSlice(key string) ([]string, error) {
 	}
 }
 
+func (m *Monkey) getIntSlice(key string) ([]int, error) {
+	t := m.v.Get(key)
+	if t == nil {
+		return nil, fmt.Errorf("%s not specified", key)
+	}
+
+	switch t := t.(type) {
+	default:
+		return nil, fmt.Errorf("%s: unexpected type %T", key, t)
+	case []int: // When set explicitly in code
+		return t, nil
+	case []interface{}: // When reading from config file
+		return toInts(t)
+	case string: // When reading from prana, which uses string encoding
+		var result []int
+		err := json.Unmarshal([]byte(t), &result)
+		return result, err
+	}
+}
+
 // SpinnakerEndpoint returns the spinnaker endpoint
 func (m *Monkey) SpinnakerEndpoint() string {
 	return m.v.GetString(param.SpinnakerEndpoint)
@@ -370,6 +503,488 @@ func (m *Monkey) SpinnakerX509Key() string {
 	return m.v.GetString(param.SpinnakerX509Key)
 }
 
+// KubernetesEndpoint returns the URL of the Kubernetes API server. If blank,
+// the in-cluster configuration is used
+func (m *Monkey) KubernetesEndpoint() string {
+	return m.v.GetString(param.KubernetesEndpoint)
+}
+
+// KubernetesCACert returns a path to a PEM file with the CA certificate of
+// the Kubernetes API server
+func (m *Monkey) KubernetesCACert() string {
+	return m.v.GetString(param.KubernetesCACert)
+}
+
+// KubernetesTokenFile returns a path to a file that contains the bearer token
+// used to authenticate against the Kubernetes API server
+func (m *Monkey) KubernetesTokenFile() string {
+	return m.v.GetString(param.KubernetesTokenFile)
+}
+
+// KubernetesAccount returns the name of the account that Kubernetes
+// workloads belong to
+func (m *Monkey) KubernetesAccount() string {
+	return m.v.GetString(param.KubernetesAccount)
+}
+
+// KubernetesAppLabel returns the label that identifies the app of a
+// Kubernetes workload
+func (m *Monkey) KubernetesAppLabel() string {
+	return m.v.GetString(param.KubernetesAppLabel)
+}
+
+// KubernetesNamespaces returns the Kubernetes namespaces to look for
+// workloads in. If empty, all namespaces are searched
+func (m *Monkey) KubernetesNamespaces() ([]string, error) {
+	return m.getStringSlice(param.KubernetesNamespaces)
+}
+
+// KubernetesDefaultEnabled returns true if Chaos Monkey is enabled for apps
+// whose workloads don't have a chaosmonkey.netflix.com/enabled annotation
+func (m *Monkey) KubernetesDefaultEnabled() bool {
+	return m.v.GetBool(param.KubernetesDefaultEnabled)
+}
+
+// KubernetesDefaultMeanTimeBetweenKills returns the mean time between kills,
+// in work days, of apps whose workloads don't annotate it
+func (m *Monkey) KubernetesDefaultMeanTimeBetweenKills() int {
+	return m.v.GetInt(param.KubernetesDefaultMeanTimeBetweenKills)
+}
+
+// KubernetesDefaultMinTimeBetweenKills returns the min time between kills,
+// in work days, of apps whose workloads don't annotate it
+func (m *Monkey) KubernetesDefaultMinTimeBetweenKills() int {
+	return m.v.GetInt(param.KubernetesDefaultMinTimeBetweenKills)
+}
+
+// KubernetesDefaultGrouping returns the grouping ("app", "stack" or
+// "cluster") of apps whose workloads don't annotate it
+func (m *Monkey) KubernetesDefaultGrouping() string {
+	return m.v.GetString(param.KubernetesDefaultGrouping)
+}
+
+// AWSAccount returns the name of the account that the AWS Auto Scaling
+// groups belong to
+func (m *Monkey) AWSAccount() string {
+	return m.v.GetString(param.AWSAccount)
+}
+
+// AWSRegions returns the AWS regions to look for Auto Scaling groups in
+func (m *Monkey) AWSRegions() ([]string, error) {
+	return m.getStringSlice(param.AWSRegions)
+}
+
+// AWSEndpoint returns a URL that replaces the endpoints of the AWS services.
+// It is intended for testing against a local AWS stand-in
+func (m *Monkey) AWSEndpoint() string {
+	return m.v.GetString(param.AWSEndpoint)
+}
+
+// AWSAccessKeyID returns the access key id used to sign AWS requests
+func (m *Monkey) AWSAccessKeyID() string {
+	return m.v.GetString(param.AWSAccessKeyID)
+}
+
+// AWSEncryptedSecretAccessKey returns an encrypted version of the secret
+// access key used to sign AWS requests
+func (m *Monkey) AWSEncryptedSecretAccessKey() string {
+	return m.v.GetString(param.AWSEncryptedSecretAccessKey)
+}
+
+// AWSTimeout returns the timeout of each AWS API call
+func (m *Monkey) AWSTimeout() time.Duration {
+	return m.v.GetDuration(param.AWSTimeout)
+}
+
+// InventoryPath returns the path to a YAML or JSON file that describes the
+// deployed instances and the app configs
+func (m *Monkey) InventoryPath() string {
+	return m.v.GetString(param.InventoryPath)
+}
+
+// LeaderElectionEnabled returns true if replicas elect a leader, so that only
+// one of them schedules and executes terminations
+func (m *Monkey) LeaderElectionEnabled() bool {
+	return m.v.GetBool(param.LeaderElectionEnabled)
+}
+
+// LeaderElectionLeaseDuration returns how long the leader lease is held
+// without being renewed
+func (m *Monkey) LeaderElectionLeaseDuration() time.Duration {
+	return m.v.GetDuration(param.LeaderElectionLeaseDuration)
+}
+
+// LeaderElectionHolder returns the name this replica holds the leader lease
+// under. An empty string means the hostname should be used.
+func (m *Monkey) LeaderElectionHolder() string {
+	return m.v.GetString(param.LeaderElectionHolder)
+}
+
+// WebhookURLs returns the URLs that the webhook tracker posts terminations to
+func (m *Monkey) WebhookURLs() ([]string, error) {
+	return m.getStringSlice(param.WebhookURLs)
+}
+
+// WebhookEncryptedSecret returns an encrypted version of the secret used to
+// sign webhook requests
+func (m *Monkey) WebhookEncryptedSecret() string {
+	return m.v.GetString(param.WebhookEncryptedSecret)
+}
+
+// WebhookTimeout returns the timeout of each webhook request
+func (m *Monkey) WebhookTimeout() time.Duration {
+	return m.v.GetDuration(param.WebhookTimeout)
+}
+
+// WebhookMaxAttempts returns how many times delivery to a webhook URL is
+// attempted
+func (m *Monkey) WebhookMaxAttempts() int {
+	return m.v.GetInt(param.WebhookMaxAttempts)
+}
+
+// WebhookBackoff returns the delay before the first retry of a webhook
+// delivery. It doubles with each retry.
+func (m *Monkey) WebhookBackoff() time.Duration {
+	return m.v.GetDuration(param.WebhookBackoff)
+}
+
+// WebhookBlocking returns true if a failed webhook delivery prevents the
+// termination
+func (m *Monkey) WebhookBlocking() bool {
+	return m.v.GetBool(param.WebhookBlocking)
+}
+
+// SlackEndpoint returns the base URL of the Slack Web API
+func (m *Monkey) SlackEndpoint() string {
+	return m.v.GetString(param.SlackEndpoint)
+}
+
+// SlackEncryptedToken returns an encrypted version of the Slack bot token
+func (m *Monkey) SlackEncryptedToken() string {
+	return m.v.GetString(param.SlackEncryptedToken)
+}
+
+// SlackDefaultChannel returns the channel that is notified about apps that
+// have no channel of their own
+func (m *Monkey) SlackDefaultChannel() string {
+	return m.v.GetString(param.SlackDefaultChannel)
+}
+
+// SlackChannels returns the channel of each app, by app name. It takes
+// precedence over the notification channel in the app config.
+func (m *Monkey) SlackChannels() map[string]string {
+	return m.v.GetStringMapString(param.SlackChannels)
+}
+
+// SlackTimeout returns the timeout of each Slack API call
+func (m *Monkey) SlackTimeout() time.Duration {
+	return m.v.GetDuration(param.SlackTimeout)
+}
+
+// SlackBlocking returns true if a failure to post a termination to Slack
+// prevents the termination
+func (m *Monkey) SlackBlocking() bool {
+	return m.v.GetBool(param.SlackBlocking)
+}
+
+// SlackWarningLeadTime returns how long before a termination it is announced
+// on Slack. Warnings are disabled if it is zero.
+func (m *Monkey) SlackWarningLeadTime() time.Duration {
+	return m.v.GetDuration(param.SlackWarningLeadTime)
+}
+
+// FileTrackerPath returns the path of the file that the file tracker appends
+// terminations to
+func (m *Monkey) FileTrackerPath() string {
+	return m.v.GetString(param.FileTrackerPath)
+}
+
+// FileTrackerMaxSizeMB returns the size in megabytes after which the file
+// tracker's file is rotated
+func (m *Monkey) FileTrackerMaxSizeMB() int {
+	return m.v.GetInt(param.FileTrackerMaxSizeMB)
+}
+
+// FileTrackerMaxBackups returns how many rotated files the file tracker keeps
+func (m *Monkey) FileTrackerMaxBackups() int {
+	return m.v.GetInt(param.FileTrackerMaxBackups)
+}
+
+// MetricsAddress returns the TCP address that the daemon serves /metrics on,
+// blank if it doesn't
+func (m *Monkey) MetricsAddress() string {
+	return m.v.GetString(param.MetricsAddress)
+}
+
+// MetricsPushURL returns the URL of the Pushgateway that short-lived commands
+// push their metrics to, blank if they don't
+func (m *Monkey) MetricsPushURL() string {
+	return m.v.GetString(param.MetricsPushURL)
+}
+
+// MetricsPushJob returns the job name that metrics are pushed under
+func (m *Monkey) MetricsPushJob() string {
+	return m.v.GetString(param.MetricsPushJob)
+}
+
+// StatsdAddress returns the UDP address of the StatsD server that metrics are
+// sent to, blank if they aren't
+func (m *Monkey) StatsdAddress() string {
+	return m.v.GetString(param.StatsdAddress)
+}
+
+// StatsdPrefix returns the prefix of the names of StatsD metrics
+func (m *Monkey) StatsdPrefix() string {
+	return m.v.GetString(param.StatsdPrefix)
+}
+
+// StatsdTags returns the tags added to every StatsD metric, as "name:value"
+// pairs
+func (m *Monkey) StatsdTags() ([]string, error) {
+	return m.getStringSlice(param.StatsdTags)
+}
+
+// StatsdEvents returns true if DogStatsD events are sent about terminations
+// and schedules
+func (m *Monkey) StatsdEvents() bool {
+	return m.v.GetBool(param.StatsdEvents)
+}
+
+// HTTPOutageURLs returns the URLs that the http outage checker polls
+func (m *Monkey) HTTPOutageURLs() ([]string, error) {
+	return m.getStringSlice(param.HTTPOutageURLs)
+}
+
+// HTTPOutageTimeout returns the timeout of each request of the http outage
+// checker
+func (m *Monkey) HTTPOutageTimeout() time.Duration {
+	return m.v.GetDuration(param.HTTPOutageTimeout)
+}
+
+// HTTPOutageCacheTTL returns how long the result of an outage check is reused
+func (m *Monkey) HTTPOutageCacheTTL() time.Duration {
+	return m.v.GetDuration(param.HTTPOutageCacheTTL)
+}
+
+// HTTPOutageFailOpen returns true if a failed outage check is treated as no
+// outage. Otherwise it is an error, which prevents terminations.
+func (m *Monkey) HTTPOutageFailOpen() bool {
+	return m.v.GetBool(param.HTTPOutageFailOpen)
+}
+
+// HTTPOutageStatusCodes returns the response status codes that mean there
+// is an outage
+func (m *Monkey) HTTPOutageStatusCodes() ([]int, error) {
+	return m.getIntSlice(param.HTTPOutageStatusCodes)
+}
+
+// HTTPOutageJSONPath returns the dotted path of the field of a JSON response
+// that tells if there is an outage, e.g. "status.indicator"
+func (m *Monkey) HTTPOutageJSONPath() string {
+	return m.v.GetString(param.HTTPOutageJSONPath)
+}
+
+// HTTPOutageJSONOutageValues returns the values of the field at
+// HTTPOutageJSONPath that mean there is an outage
+func (m *Monkey) HTTPOutageJSONOutageValues() ([]string, error) {
+	return m.getStringSlice(param.HTTPOutageJSONOutageValues)
+}
+
+// HTTPOutageBodyPattern returns a regular expression that matches response
+// bodies that mean there is an outage
+func (m *Monkey) HTTPOutageBodyPattern() string {
+	return m.v.GetString(param.HTTPOutageBodyPattern)
+}
+
+// AlertmanagerEndpoint returns the base URL of the Alertmanager API, e.g.
+// http://alertmanager:9093
+func (m *Monkey) AlertmanagerEndpoint() string {
+	return m.v.GetString(param.AlertmanagerEndpoint)
+}
+
+// AlertmanagerMatchers returns the label matchers of the alerts that mean
+// there is an outage, e.g. severity=critical
+func (m *Monkey) AlertmanagerMatchers() ([]string, error) {
+	return m.getStringSlice(param.AlertmanagerMatchers)
+}
+
+// AlertmanagerTimeout returns the timeout of each Alertmanager API call
+func (m *Monkey) AlertmanagerTimeout() time.Duration {
+	return m.v.GetDuration(param.AlertmanagerTimeout)
+}
+
+// AlertmanagerCacheTTL returns how long the alerts fetched from Alertmanager
+// are reused
+func (m *Monkey) AlertmanagerCacheTTL() time.Duration {
+	return m.v.GetDuration(param.AlertmanagerCacheTTL)
+}
+
+// AlertmanagerLookback returns how long after matching alerts stop firing
+// there is still considered to be an outage
+func (m *Monkey) AlertmanagerLookback() time.Duration {
+	return m.v.GetDuration(param.AlertmanagerLookback)
+}
+
+// AlertmanagerStateFile returns the file that the results of Alertmanager
+// checks are kept in between commands, blank if they aren't kept
+func (m *Monkey) AlertmanagerStateFile() string {
+	return m.v.GetString(param.AlertmanagerStateFile)
+}
+
+// AlertmanagerScope returns the alert labels that hold the app, account and
+// region an alert affects, keyed by "app", "account" and "region". Alerts
+// only stop terminations in the groups they affect.
+func (m *Monkey) AlertmanagerScope() map[string]string {
+	return m.v.GetStringMapString(param.AlertmanagerScope)
+}
+
+// RateLimitMaxPerDay returns the max number of terminations per day, no limit
+// if zero
+func (m *Monkey) RateLimitMaxPerDay() int {
+	return m.v.GetInt(param.RateLimitMaxPerDay)
+}
+
+// RateLimitMaxPerAccount returns the max number of terminations per day in
+// each account, no limit if zero
+func (m *Monkey) RateLimitMaxPerAccount() int {
+	return m.v.GetInt(param.RateLimitMaxPerAccount)
+}
+
+// RateLimitMaxPerRegion returns the max number of terminations per day in
+// each region, no limit if zero
+func (m *Monkey) RateLimitMaxPerRegion() int {
+	return m.v.GetInt(param.RateLimitMaxPerRegion)
+}
+
+// RateLimitMaxPerWindow returns the max number of terminations within any
+// period of RateLimitWindow, no limit if zero
+func (m *Monkey) RateLimitMaxPerWindow() int {
+	return m.v.GetInt(param.RateLimitMaxPerWindow)
+}
+
+// RateLimitWindow returns the length of the sliding window that
+// RateLimitMaxPerWindow applies to
+func (m *Monkey) RateLimitWindow() time.Duration {
+	return m.v.GetDuration(param.RateLimitWindow)
+}
+
+// RateLimitReschedule returns true if terminations that exceed
+// RateLimitMaxPerWindow are moved later in the day, rather than dropped
+func (m *Monkey) RateLimitReschedule() bool {
+	return m.v.GetBool(param.RateLimitReschedule)
+}
+
+// BlackoutWindows returns the blackout windows defined in the config, as
+// [[blackout.windows]] tables
+func (m *Monkey) BlackoutWindows() ([]map[string]interface{}, error) {
+	t := m.v.Get(param.BlackoutWindows)
+
+	switch t := t.(type) {
+	case nil:
+		return nil, nil
+	case []map[string]interface{}: // When reading from config file
+		return t, nil
+	case []interface{}: // When set explicitly in code
+		result := make([]map[string]interface{}, len(t))
+		for i, x := range t {
+			w, ok := x.(map[string]interface{})
+			if !ok {
+				return nil, errors.Errorf("%s: unexpected type %T", param.BlackoutWindows, x)
+			}
+			result[i] = w
+		}
+		return result, nil
+	default:
+		return nil, errors.Errorf("%s: unexpected type %T", param.BlackoutWindows, t)
+	}
+}
+
+// BlackoutFile returns a JSON file that holds a list of blackout windows,
+// blank if there is none
+func (m *Monkey) BlackoutFile() string {
+	return m.v.GetString(param.BlackoutFile)
+}
+
+// BlackoutICSFile returns an iCalendar file whose events are blackout
+// windows, blank if there is none
+func (m *Monkey) BlackoutICSFile() string {
+	return m.v.GetString(param.BlackoutICSFile)
+}
+
+// BlackoutShift returns true if terminations in a blackout window are moved
+// to its end, rather than dropped
+func (m *Monkey) BlackoutShift() bool {
+	return m.v.GetBool(param.BlackoutShift)
+}
+
+// weekdays are the names of the days of the week
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// ParseWeekdays returns the days of the week named by names, e.g. "mon",
+// in any case
+func ParseWeekdays(names []string) ([]time.Weekday, error) {
+	var result []time.Weekday
+	for _, name := range names {
+		d, ok := weekdays[strings.ToLower(name)]
+		if !ok {
+			return nil, errors.Errorf("bad day %q, want one of sun, mon, tue, wed, thu, fri, sat", name)
+		}
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+// CalendarWorkdays returns the days of the week that are work days
+func (m *Monkey) CalendarWorkdays() ([]time.Weekday, error) {
+	result, err := ParseWeekdays(m.v.GetStringSlice(param.CalendarWorkdays))
+	if err != nil {
+		return nil, errors.Wrap(err, param.CalendarWorkdays)
+	}
+
+	if len(result) == 0 {
+		return nil, errors.Errorf("%s: no work days", param.CalendarWorkdays)
+	}
+	return result, nil
+}
+
+// CalendarCountry returns the country whose public holidays are days off,
+// e.g. "US", blank if none
+func (m *Monkey) CalendarCountry() string {
+	return m.v.GetString(param.CalendarCountry)
+}
+
+// CalendarHolidays returns the holidays listed in the config
+func (m *Monkey) CalendarHolidays() []string {
+	return m.v.GetStringSlice(param.CalendarHolidays)
+}
+
+// CalendarICSFile returns an iCalendar file whose events are holidays, blank
+// if there is none
+func (m *Monkey) CalendarICSFile() string {
+	return m.v.GetString(param.CalendarICSFile)
+}
+
+// ServerAddress returns the TCP address that the HTTP API listens on
+func (m *Monkey) ServerAddress() string {
+	return m.v.GetString(param.ServerAddress)
+}
+
+// ServerEncryptedToken returns an encrypted version of the bearer token that
+// clients of the HTTP API must present to trigger terminations. If blank,
+// terminations can't be triggered over the API.
+func (m *Monkey) ServerEncryptedToken() string {
+	return m.v.GetString(param.ServerEncryptedToken)
+}
+
+// ServerAllowUnleashed returns true if clients of the HTTP API may trigger
+// unleashed terminations, rather than only leashed ones
+func (m *Monkey) ServerAllowUnleashed() bool {
+	return m.v.GetBool(param.ServerAllowUnleashed)
+}
+
 // Decryptor returns an interface for decrypting secrets
 func (m *Monkey) Decryptor() string {
 	return m.v.GetString(param.Decryptor)
@@ -381,13 +996,28 @@ func (m *Monkey) OutageChecker() string {
 	return m.v.GetString(param.OutageChecker)
 }
 
+// DatabaseDriver returns the kind of database that stores the Chaos Monkey
+// state (e.g., "mysql", "sqlite")
+func (m *Monkey) DatabaseDriver() string {
+	return m.v.GetString(param.DatabaseDriver)
+}
+
 // DatabaseHost returns the hostname the database is running on
 func (m *Monkey) DatabaseHost() string {
 	return m.v.GetString(param.DatabaseHost)
 }
 
 // DatabasePort returns the port the database is listening on
+// If no port is specified, returns the default port for the database driver
 func (m *Monkey) DatabasePort() int {
+	if m.v.Get(param.DatabasePort) == nil {
+		switch m.DatabaseDriver() {
+		case "postgres":
+			return 5432
+		default:
+			return 3306
+		}
+	}
 	return m.v.GetInt(param.DatabasePort)
 }
 
@@ -397,11 +1027,18 @@ func (m *Monkey) DatabaseUser() string {
 }
 
 // DatabaseName returns the name of the database that stores the Chaos Monkey
-// state
+// state. For sqlite, this is the path to the database file
 func (m *Monkey) DatabaseName() string {
 	return m.v.GetString(param.DatabaseName)
 }
 
+// DatabaseSSLMode returns the ssl mode used when connecting to
+// postgres (e.g., "disable", "require", "verify-full"). If blank, the driver
+// default is used.
+func (m *Monkey) DatabaseSSLMode() string {
+	return m.v.GetString(param.DatabaseSSLMode)
+}
+
 // DatabaseEncryptedPassword returns an encrypted version of the database
 // credentials
 func (m *Monkey) DatabaseEncryptedPassword() string {
@@ -447,17 +1084,21 @@ func SetRemoteProvider(name string, factory RemoteConfigFactory) {
 }
 
 // CronExpression returns the chaosmonkey main run cron expression.
-// It defaults to 2 hour before start_hour on weekdays, if no cron expression
-// is specified in the config
+// It defaults to 2 hour before start_hour on work days of the week, if no
+// cron expression is specified in the config
 func (m *Monkey) CronExpression() (string, error) {
-	defaultCron := "0 %d * * 1-5"
+	defaultCron := "0 %d * * %s"
 	cron := m.v.Get(param.CronExpression)
 	if cron == nil {
 		runAtHour, err := calculateDefaultCronRunHour(m.StartHour())
 		if err != nil {
 			return "", err
 		}
-		return fmt.Sprintf(defaultCron, runAtHour), nil
+		workdays, err := m.CalendarWorkdays()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(defaultCron, runAtHour, cronDays(workdays)), nil
 	}
 	switch cron := cron.(type) {
 	default:
@@ -467,6 +1108,36 @@ func (m *Monkey) CronExpression() (string, error) {
 	}
 }
 
+// cronDays returns the day of week field of a cron expression that matches
+// days, e.g. "1-5" for Monday to Friday
+func cronDays(days []time.Weekday) string {
+	var set [7]bool
+	for _, d := range days {
+		set[d] = true
+	}
+
+	var fields []string
+	for d := 0; d < 7; d++ {
+		if !set[d] {
+			continue
+		}
+
+		last := d
+		for last+1 < 7 && set[last+1] {
+			last++
+		}
+
+		if last == d {
+			fields = append(fields, fmt.Sprint(d))
+		} else {
+			fields = append(fields, fmt.Sprintf("%d-%d", d, last))
+		}
+		d = last
+	}
+
+	return strings.Join(fields, ",")
+}
+
 // calculates the default cron run hour based on startHour.
 // The default cron starts "cronBeforeStartHour" hours
 // before "startHour"
@@ -496,6 +1167,13 @@ func (m *Monkey) SchedulePath() string {
 	return m.v.GetString(param.SchedulePath)
 }
 
+// Deployment returns the name of the provider that Chaos Monkey queries for
+// deployed instances and uses to terminate them (e.g., "spinnaker",
+// "kubernetes", "aws", "inventory")
+func (m *Monkey) Deployment() string {
+	return m.v.GetString(param.Deployment)
+}
+
 // LogPath returns the path to which
 // log files should be written
 func (m *Monkey) LogPath() string {
@@ -17,7 +17,9 @@ package config
 import (
 	"fmt"
 	"github.com/Netflix/chaosmonkey/v2/config/param"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestDefaultCron(t *testing.T) {
@@ -68,6 +70,49 @@ func TestDefaultCronForStartHourOneAM(t *testing.T) {
 	}
 }
 
+func TestDefaultCronForWorkdays(t *testing.T) {
+	tests := []struct {
+		workdays []string
+		dow      string
+	}{
+		{[]string{"sun", "mon", "tue", "wed", "thu"}, "0-4"},
+		{[]string{"Mon", "wed", "fri"}, "1,3,5"},
+		{[]string{"sat", "mon", "tue", "sun"}, "0-2,6"},
+	}
+
+	for _, tt := range tests {
+		monkey := Defaults()
+		monkey.Set(param.StartHour, 9)
+		monkey.Set(param.CalendarWorkdays, tt.workdays)
+
+		actual, err := monkey.CronExpression()
+		if err != nil {
+			t.Error(err.Error())
+			continue
+		}
+
+		expected := fmt.Sprintf("0 %d * * %s", 7, tt.dow)
+		if actual != expected {
+			t.Errorf("\nExpected:\n%s\nActual:\n%s", expected, actual)
+		}
+	}
+}
+
+func TestParseWeekdays(t *testing.T) {
+	got, err := ParseWeekdays([]string{"Sat", "mon"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []time.Weekday{time.Saturday, time.Monday}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	_, err = ParseWeekdays([]string{"mon", "monday"})
+	if err == nil {
+		t.Error("got no error for a bad day")
+	}
+}
+
 func TestDefaultCronForStartHourBeforeClockStart(t *testing.T) {
 	monkey := Defaults()
 	monkey.Set(param.StartHour, -1)
@@ -89,3 +134,26 @@ func TestDefaultCronForStartHourAfterClockEnd(t *testing.T) {
 		return
 	}
 }
+
+func TestDatabasePortDefaultsByDriver(t *testing.T) {
+	tests := []struct {
+		driver string
+		port   int
+	}{
+		{"mysql", 3306},
+		{"postgres", 5432},
+	}
+
+	for _, tt := range tests {
+		cfg := Defaults()
+		cfg.Set(param.DatabaseDriver, tt.driver)
+		if got, want := cfg.DatabasePort(), tt.port; got != want {
+			t.Errorf("driver=%s: got DatabasePort()=%d, want %d", tt.driver, got, want)
+		}
+
+		cfg.Set(param.DatabasePort, 1234)
+		if got, want := cfg.DatabasePort(), 1234; got != want {
+			t.Errorf("driver=%s: got DatabasePort()=%d, want %d", tt.driver, got, want)
+		}
+	}
+}
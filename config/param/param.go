@@ -35,6 +35,8 @@ const (
 	ScheduleCronPath = "chaosmonkey.schedule_cron_path"
 	SchedulePath     = "chaosmonkey.schedule_path"
 	LogPath          = "chaosmonkey.log_path"
+	Deployment       = "chaosmonkey.deployment"
+	Constrainers     = "chaosmonkey.constrainers"
 
 	// spinnaker
 	SpinnakerEndpoint          = "spinnaker.endpoint"
@@ -43,12 +45,121 @@ const (
 	SpinnakerUser              = "spinnaker.user"
 	SpinnakerX509Cert          = "spinnaker.x509_cert"
 	SpinnakerX509Key           = "spinnaker.x509_key"
+
+	// kubernetes
+	KubernetesEndpoint   = "kubernetes.endpoint"
+	KubernetesCACert     = "kubernetes.ca_cert"
+	KubernetesTokenFile  = "kubernetes.token_file"
+	KubernetesAccount    = "kubernetes.account"
+	KubernetesAppLabel   = "kubernetes.app_label"
+	KubernetesNamespaces = "kubernetes.namespaces"
+
+	KubernetesDefaultEnabled              = "kubernetes.default_enabled"
+	KubernetesDefaultMeanTimeBetweenKills = "kubernetes.default_mean_time_between_kills_in_work_days"
+	KubernetesDefaultMinTimeBetweenKills  = "kubernetes.default_min_time_between_kills_in_work_days"
+	KubernetesDefaultGrouping             = "kubernetes.default_grouping"
+
+	// aws
+	AWSAccount                  = "aws.account"
+	AWSRegions                  = "aws.regions"
+	AWSEndpoint                 = "aws.endpoint"
+	AWSAccessKeyID              = "aws.access_key_id"
+	AWSEncryptedSecretAccessKey = "aws.encrypted_secret_access_key"
+	AWSTimeout                  = "aws.timeout"
+
+	// inventory
+	InventoryPath = "inventory.path"
+
+	// leader election
+	LeaderElectionEnabled       = "leader_election.enabled"
+	LeaderElectionLeaseDuration = "leader_election.lease_duration"
+	LeaderElectionHolder        = "leader_election.holder"
+
+	// webhook tracker
+	WebhookURLs            = "webhook.urls"
+	WebhookEncryptedSecret = "webhook.encrypted_secret"
+	WebhookTimeout         = "webhook.timeout"
+	WebhookMaxAttempts     = "webhook.max_attempts"
+	WebhookBackoff         = "webhook.backoff"
+	WebhookBlocking        = "webhook.blocking"
+
+	// slack tracker and pre-termination warnings
+	SlackEndpoint        = "slack.endpoint"
+	SlackEncryptedToken  = "slack.encrypted_token"
+	SlackDefaultChannel  = "slack.default_channel"
+	SlackChannels        = "slack.channels"
+	SlackTimeout         = "slack.timeout"
+	SlackWarningLeadTime = "slack.warning_lead_time"
+	SlackBlocking        = "slack.blocking"
+
+	// file tracker
+	FileTrackerPath       = "file_tracker.path"
+	FileTrackerMaxSizeMB  = "file_tracker.max_size_mb"
+	FileTrackerMaxBackups = "file_tracker.max_backups"
+
+	// prometheus metrics
+	MetricsAddress = "metrics.address"
+	MetricsPushURL = "metrics.push_url"
+	MetricsPushJob = "metrics.push_job"
+
+	// statsd metrics
+	StatsdAddress = "statsd.address"
+	StatsdPrefix  = "statsd.prefix"
+	StatsdTags    = "statsd.tags"
+	StatsdEvents  = "statsd.events"
+
+	// http outage checker
+	HTTPOutageURLs             = "http_outage.urls"
+	HTTPOutageTimeout          = "http_outage.timeout"
+	HTTPOutageCacheTTL         = "http_outage.cache_ttl"
+	HTTPOutageFailOpen         = "http_outage.fail_open"
+	HTTPOutageStatusCodes      = "http_outage.outage_status_codes"
+	HTTPOutageJSONPath         = "http_outage.json_path"
+	HTTPOutageJSONOutageValues = "http_outage.json_outage_values"
+	HTTPOutageBodyPattern      = "http_outage.body_pattern"
+
+	// alertmanager outage checker
+	AlertmanagerEndpoint  = "alertmanager.endpoint"
+	AlertmanagerMatchers  = "alertmanager.matchers"
+	AlertmanagerTimeout   = "alertmanager.timeout"
+	AlertmanagerCacheTTL  = "alertmanager.cache_ttl"
+	AlertmanagerLookback  = "alertmanager.lookback"
+	AlertmanagerStateFile = "alertmanager.state_file"
+	AlertmanagerScope     = "alertmanager.scope"
+
+	// rate limit constrainer
+	RateLimitMaxPerDay     = "rate_limit.max_per_day"
+	RateLimitMaxPerAccount = "rate_limit.max_per_account"
+	RateLimitMaxPerRegion  = "rate_limit.max_per_region"
+	RateLimitMaxPerWindow  = "rate_limit.max_per_window"
+	RateLimitWindow        = "rate_limit.window"
+	RateLimitReschedule    = "rate_limit.reschedule"
+
+	// blackout constrainer
+	BlackoutWindows = "blackout.windows"
+	BlackoutFile    = "blackout.file"
+	BlackoutICSFile = "blackout.ics_file"
+	BlackoutShift   = "blackout.shift"
+
+	// work calendar
+	CalendarWorkdays = "calendar.workdays"
+	CalendarCountry  = "calendar.country"
+	CalendarHolidays = "calendar.holidays"
+	CalendarICSFile  = "calendar.ics_file"
+
+	// http api server
+	ServerAddress        = "server.address"
+	ServerEncryptedToken = "server.encrypted_token"
+	ServerAllowUnleashed = "server.allow_unleashed"
+
 	// database
+	DatabaseDriver            = "database.driver"
 	DatabaseHost              = "database.host"
 	DatabasePort              = "database.port"
 	DatabaseUser              = "database.user"
 	DatabaseEncryptedPassword = "database.encrypted_password"
 	DatabaseName              = "database.name"
+	DatabaseSSLMode           = "database.ssl_mode"
 
 	// dynamic property provider
 	DynamicProvider = "dynamic.provider"
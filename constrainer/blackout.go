@@ -0,0 +1,426 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constrainer
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/cal"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+// Window is a period during which Chaos Monkey doesn't terminate instances,
+// e.g. a change freeze.
+//
+// A window is either a single period from Start to End, or a recurring
+// period from From to To on the matching days. Times are in the Chaos Monkey
+// time zone, unless they have an explicit offset.
+type Window struct {
+	// Name describes the window in logs
+	Name string `json:"name"`
+
+	// Apps are the apps the window applies to, all apps if empty
+	Apps []string `json:"apps"`
+
+	// Start and End bound a single period. They are formatted as
+	// 2006-01-02T15:04, 2006-01-02 or RFC 3339. A date as End includes the
+	// whole day.
+	Start string `json:"start"`
+	End   string `json:"end"`
+
+	// Days are the days of the week a recurring window happens on, e.g.
+	// "fri". A recurring window happens every day if both Days and
+	// DaysOfMonth are empty.
+	Days []string `json:"days"`
+
+	// DaysOfMonth are the days of the month a recurring window happens on.
+	// Negative days count from the end of the month, e.g. -1 is the last
+	// day.
+	DaysOfMonth []int `json:"days_of_month"`
+
+	// From and To are the times of day, as 15:04, that a recurring window
+	// starts and ends. They default to the start and end of the day. If To
+	// isn't after From, the window ends on the next day.
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// period is an occurrence of a window
+type period struct {
+	start, end time.Time
+}
+
+func (p period) contains(t time.Time) bool {
+	return !t.Before(p.start) && t.Before(p.end)
+}
+
+// window is a compiled Window
+type window struct {
+	name string
+	apps map[string]bool
+
+	// occurrence returns the occurrence of the window that contains t
+	occurrence func(t time.Time) (period, bool)
+}
+
+func (w window) appliesTo(app string) bool {
+	return len(w.apps) == 0 || w.apps[app]
+}
+
+// Blackout is a constrainer that keeps terminations out of blackout windows.
+// Terminations in a window are dropped or, if shifting is enabled, moved to
+// the end of the window, or the start of the next kill window of the app
+// after it, as long as that is on the same day.
+type Blackout struct {
+	windows []window
+	shift   bool
+	endHour int
+	loc     *time.Location
+}
+
+// NewBlackoutFromConfig creates a new Blackout taking config parameters from
+// cfg. Windows are read from the config, the JSON file and the iCalendar
+// file, if any.
+func NewBlackoutFromConfig(cfg *config.Monkey) (*Blackout, error) {
+	loc, err := cfg.Location()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve location")
+	}
+
+	raw, err := cfg.BlackoutWindows()
+	if err != nil {
+		return nil, err
+	}
+
+	// Round-trip through JSON to decode the config tables like the file
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode blackout windows")
+	}
+
+	var windows []Window
+	err = json.Unmarshal(data, &windows)
+	if err != nil {
+		return nil, errors.Wrap(err, "bad blackout windows")
+	}
+
+	if path := cfg.BlackoutFile(); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read blackout file")
+		}
+
+		var fromFile []Window
+		err = json.Unmarshal(data, &fromFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "bad blackout file %s", path)
+		}
+		windows = append(windows, fromFile...)
+	}
+
+	b, err := NewBlackout(windows, cfg.BlackoutShift(), cfg.EndHour(), loc)
+	if err != nil {
+		return nil, err
+	}
+
+	if path := cfg.BlackoutICSFile(); path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open blackout calendar")
+		}
+		defer func() { _ = f.Close() }()
+
+		events, err := cal.ParseICS(f, loc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "bad blackout calendar %s", path)
+		}
+		b.addPeriods(events)
+	}
+
+	return b, nil
+}
+
+// NewBlackout returns a constrainer that keeps terminations out of windows.
+// If shift is true, terminations are moved to the end of a window, within the
+// kill windows of their app, rather than dropped. If the schedule doesn't
+// record those, terminations are moved up to endHour o'clock in loc.
+func NewBlackout(windows []Window, shift bool, endHour int, loc *time.Location) (*Blackout, error) {
+	b := &Blackout{shift: shift, endHour: endHour, loc: loc}
+	for i, w := range windows {
+		cw, err := compile(w, loc)
+		if err != nil {
+			if w.Name != "" {
+				return nil, errors.Wrapf(err, "bad blackout window %s", w.Name)
+			}
+			return nil, errors.Wrapf(err, "bad blackout window #%d", i+1)
+		}
+		b.windows = append(b.windows, cw)
+	}
+	return b, nil
+}
+
+// addPeriods adds named periods that apply to all apps, e.g. the events of
+// a calendar
+func (b *Blackout) addPeriods(events []cal.Event) {
+	for _, e := range events {
+		p := period{e.Start, e.End}
+		b.windows = append(b.windows, window{
+			name: e.Summary,
+			occurrence: func(t time.Time) (period, bool) {
+				return p, p.contains(t)
+			},
+		})
+	}
+}
+
+// Filter implements schedule.Constrainer.Filter
+func (b *Blackout) Filter(s schedule.Schedule) schedule.Schedule {
+	result, _ := b.Explain(s)
+	return result
+}
+
+// Explain implements schedule.Explainer.Explain
+func (b *Blackout) Explain(s schedule.Schedule) (schedule.Schedule, []schedule.Change) {
+	result := s.Derive()
+	var changes []schedule.Change
+	for _, e := range s.Entries() {
+		t, name, ok := b.allowed(e.Group.App(), e.Time, hours(&s, e.Group.App(), b.endHour, b.loc))
+		if !ok {
+			changes = append(changes, schedule.Change{Entry: e, Reason: blackoutReason(name)})
+			continue
+		}
+
+		if !t.Equal(e.Time) {
+			log.Printf("blackout: moving termination of %s from %s to %s", grp.String(e.Group), e.Time, t)
+			changes = append(changes, schedule.Change{Entry: e, Moved: t, Reason: blackoutReason(name)})
+		}
+		result.Add(t, e.Group)
+	}
+	return *result, changes
+}
+
+// blackoutReason is the reason a termination was changed for a window
+func blackoutReason(name string) string {
+	if name == "" {
+		return "blackout window"
+	}
+	return "blackout window " + name
+}
+
+// allowed returns the time a termination of app scheduled at t can happen
+// within hours, and false if it can't happen that day. It also returns the
+// name of the last window the termination was moved out of or dropped for.
+func (b *Blackout) allowed(app string, t time.Time, hours schedule.Hours) (time.Time, string, bool) {
+	scheduled := t
+
+	// Shifting out of a window may land in another one. Each shift moves
+	// past a window, so this ends once no window is left.
+	var name string
+	for i := 0; i <= len(b.windows); i++ {
+		w, p, ok := b.find(app, t)
+		if !ok {
+			return t, name, true
+		}
+		name = w.name
+
+		next, ok := hours.Next(scheduled, p.end)
+		if !b.shift || !ok {
+			log.Printf("blackout: dropping termination of %s at %s: in blackout window %s", app, t, w.name)
+			return time.Time{}, name, false
+		}
+
+		t = next
+	}
+
+	return time.Time{}, name, false
+}
+
+// find returns a window that applies to app and contains t
+func (b *Blackout) find(app string, t time.Time) (window, period, bool) {
+	for _, w := range b.windows {
+		if !w.appliesTo(app) {
+			continue
+		}
+		if p, ok := w.occurrence(t); ok {
+			return w, p, true
+		}
+	}
+	return window{}, period{}, false
+}
+
+// compile validates w and returns it as a window
+func compile(w Window, loc *time.Location) (window, error) {
+	result := window{name: w.Name}
+	if len(w.Apps) > 0 {
+		result.apps = make(map[string]bool)
+		for _, app := range w.Apps {
+			result.apps[app] = true
+		}
+	}
+
+	if w.Start != "" || w.End != "" {
+		if len(w.Days) > 0 || len(w.DaysOfMonth) > 0 || w.From != "" || w.To != "" {
+			return window{}, errors.New("a window has either start and end, or recurring days and times")
+		}
+
+		start, _, err := parseTime(w.Start, loc)
+		if err != nil {
+			return window{}, errors.Wrap(err, "bad start")
+		}
+
+		end, isDate, err := parseTime(w.End, loc)
+		if err != nil {
+			return window{}, errors.Wrap(err, "bad end")
+		}
+		if isDate {
+			end = end.AddDate(0, 0, 1)
+		}
+
+		if !end.After(start) {
+			return window{}, errors.New("end is not after start")
+		}
+
+		p := period{start, end}
+		result.occurrence = func(t time.Time) (period, bool) {
+			return p, p.contains(t)
+		}
+		return result, nil
+	}
+
+	days, err := config.ParseWeekdays(w.Days)
+	if err != nil {
+		return window{}, err
+	}
+
+	return compileRecurring(result, w, days, loc)
+}
+
+// compileRecurring sets the occurrences of result from the recurring window
+// w, which happens on weekdays, parsed from w.Days
+func compileRecurring(result window, w Window, weekdays []time.Weekday, loc *time.Location) (window, error) {
+	days := make(map[time.Weekday]bool)
+	for _, d := range weekdays {
+		days[d] = true
+	}
+
+	for _, d := range w.DaysOfMonth {
+		if d == 0 || d < -31 || d > 31 {
+			return window{}, errors.Errorf("bad day of month %d", d)
+		}
+	}
+
+	from, err := parseTimeOfDay(w.From, 0)
+	if err != nil {
+		return window{}, errors.Wrap(err, "bad from")
+	}
+
+	to, err := parseTimeOfDay(w.To, 24*time.Hour)
+	if err != nil {
+		return window{}, errors.Wrap(err, "bad to")
+	}
+
+	if to <= from {
+		to += 24 * time.Hour
+	}
+
+	// matches returns true if the window happens on the date of t
+	matches := func(t time.Time) bool {
+		if len(days) > 0 && !days[t.Weekday()] {
+			return false
+		}
+
+		if len(w.DaysOfMonth) == 0 {
+			return true
+		}
+
+		last := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, loc).Day()
+		for _, d := range w.DaysOfMonth {
+			if d == t.Day() || d < 0 && last+1+d == t.Day() {
+				return true
+			}
+		}
+		return false
+	}
+
+	result.occurrence = func(t time.Time) (period, bool) {
+		local := t.In(loc)
+
+		// An occurrence that started the day before may still be going on
+		for _, offset := range []int{-1, 0} {
+			d := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, loc)
+			if !matches(d) {
+				continue
+			}
+
+			p := period{addClock(d, from), addClock(d, to)}
+			if p.contains(t) {
+				return p, true
+			}
+		}
+		return period{}, false
+	}
+
+	return result, nil
+}
+
+// addClock returns the time on the date of midnight that is offset past
+// midnight on the wall clock, so that days with DST changes work as
+// expected
+func addClock(midnight time.Time, offset time.Duration) time.Time {
+	return time.Date(midnight.Year(), midnight.Month(), midnight.Day(), 0, 0, 0, int(offset), midnight.Location())
+}
+
+// parseTime parses s as RFC 3339, 2006-01-02T15:04 or 2006-01-02 in loc. It
+// returns true if s is a date.
+func parseTime(s string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, false, nil
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02T15:04", s, loc); err == nil {
+		return t, false, nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", s, loc)
+	if err != nil {
+		return time.Time{}, false, errors.Errorf("%q is not a date or time", s)
+	}
+	return t, true, nil
+}
+
+// parseTimeOfDay parses s as 15:04, or returns def if s is blank. 24:00 is
+// allowed as the end of the day.
+func parseTimeOfDay(s string, def time.Duration) (time.Duration, error) {
+	if s == "" {
+		return def, nil
+	}
+
+	if s == "24:00" {
+		return 24 * time.Hour, nil
+	}
+
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, errors.Errorf("%q is not a time of day", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
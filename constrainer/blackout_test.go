@@ -0,0 +1,191 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constrainer
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+func TestBlackout(t *testing.T) {
+	// 2026-10-19 is a Monday
+	apps := []string{"a", "b", "c", "d"}
+	times := []time.Time{at(9, 0), at(10, 30), at(12, 0), at(14, 0)}
+	s := sched("prod", "us-east-1", apps, times)
+
+	tests := []struct {
+		name   string
+		window Window
+		want   []string
+	}{
+		{"global freeze", Window{Start: "2026-10-19T10:00", End: "2026-10-19T13:00"}, []string{"a@09:00", "d@14:00"}},
+		{"whole days", Window{Start: "2026-10-18", End: "2026-10-19"}, nil},
+		{"other day", Window{Start: "2026-10-20", End: "2026-10-21"}, []string{"a@09:00", "b@10:30", "c@12:00", "d@14:00"}},
+		{"per app", Window{Apps: []string{"b", "d"}, Start: "2026-10-19", End: "2026-10-19"}, []string{"a@09:00", "c@12:00"}},
+		{"mondays", Window{Days: []string{"Mon"}, From: "10:00", To: "12:00"}, []string{"a@09:00", "c@12:00", "d@14:00"}},
+		{"fridays", Window{Days: []string{"fri"}, From: "12:00"}, []string{"a@09:00", "b@10:30", "c@12:00", "d@14:00"}},
+		{"every day", Window{To: "11:00"}, []string{"c@12:00", "d@14:00"}},
+		{"overnight", Window{Days: []string{"sun"}, From: "22:00", To: "10:00"}, []string{"b@10:30", "c@12:00", "d@14:00"}},
+		{"month end", Window{DaysOfMonth: []int{-13}, From: "13:00"}, []string{"a@09:00", "b@10:30", "c@12:00"}},
+		{"month start", Window{DaysOfMonth: []int{1, 2}}, []string{"a@09:00", "b@10:30", "c@12:00", "d@14:00"}},
+	}
+
+	for _, tt := range tests {
+		b, err := NewBlackout([]Window{tt.window}, false, 15, time.UTC)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		got := summary(b.Filter(s))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// Terminations are moved to the end of the window, unless that is past the
+// end hour
+func TestBlackoutShift(t *testing.T) {
+	apps := []string{"a", "b", "c"}
+	times := []time.Time{at(9, 0), at(10, 30), at(13, 30)}
+	s := sched("prod", "us-east-1", apps, times)
+
+	windows := []Window{
+		{Name: "standup", From: "10:00", To: "11:00"},
+		{Name: "deploys", From: "11:00", To: "11:30"},
+		{Name: "afternoon", From: "13:00", To: "15:00"},
+	}
+	b, err := NewBlackout(windows, true, 15, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := summary(b.Filter(s))
+	want := []string{"a@09:00", "b@11:30"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// Terminations are shifted within the kill windows of their app, in its time
+// zone, rather than up to the end hour
+func TestBlackoutShiftAppHours(t *testing.T) {
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := schedule.New()
+	for i, app := range []string{"a", "b", "c"} {
+		s.Add(time.Date(2026, time.October, 19, []int{10, 11, 15}[i], 30, 0, 0, sydney), grp.New(app, "prod", "", "", ""))
+		s.SetHours(app, schedule.Hours{Windows: []chaosmonkey.KillWindow{{StartHour: 10, EndHour: 12}, {StartHour: 14, EndHour: 16}}, Location: sydney})
+	}
+
+	// b moves to the next kill window. c would end up past its last one,
+	// although well before 15:00 UTC.
+	windows := []Window{
+		{Name: "lunch", Start: "2026-10-19T11:00:00+11:00", End: "2026-10-19T12:30:00+11:00"},
+		{Name: "release", Start: "2026-10-19T15:00:00+11:00", End: "2026-10-19T16:30:00+11:00"},
+	}
+	b, err := NewBlackout(windows, true, 15, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := summary(b.Filter(*s))
+	want := []string{"a@10:30", "b@14:00"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestBlackoutBadWindow(t *testing.T) {
+	tests := []Window{
+		{Start: "2026-10-19"},
+		{Start: "2026-10-20", End: "2026-10-19"},
+		{Start: "2026-10-19", End: "2026-10-20", Days: []string{"mon"}},
+		{Days: []string{"someday"}},
+		{DaysOfMonth: []int{0}},
+		{From: "25:00"},
+	}
+
+	for _, w := range tests {
+		if _, err := NewBlackout([]Window{w}, false, 15, time.UTC); err == nil {
+			t.Errorf("%+v: got no error", w)
+		}
+	}
+}
+
+func TestBlackoutFromConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "windows.json")
+	err := os.WriteFile(file, []byte(`[{"name": "lunch", "apps": ["c"], "from": "11:30", "to": "12:30"}]`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ics := filepath.Join(dir, "freeze.ics")
+	err = os.WriteFile(ics, []byte("BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:Launch\nDTSTART:20261019T133000Z\nDTEND:20261019T143000Z\nEND:VEVENT\nEND:VCALENDAR\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := config.NewFromReader(strings.NewReader(`
+[chaosmonkey]
+time_zone = "UTC"
+end_hour = 15
+
+[blackout]
+file = "` + file + `"
+ics_file = "` + ics + `"
+
+[[blackout.windows]]
+name = "friday afternoons"
+days = ["fri"]
+from = "12:00"
+
+[[blackout.windows]]
+name = "morning deploys"
+apps = ["a"]
+from = "08:00"
+to = "10:00"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := NewBlackoutFromConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apps := []string{"a", "b", "c", "d"}
+	times := []time.Time{at(9, 0), at(10, 30), at(12, 0), at(14, 0)}
+	got := summary(b.Filter(sched("prod", "us-east-1", apps, times)))
+	want := []string{"b@10:30"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -15,6 +15,11 @@
 package constrainer
 
 import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
 	"github.com/Netflix/chaosmonkey/v2/config"
 	"github.com/Netflix/chaosmonkey/v2/deps"
 	"github.com/Netflix/chaosmonkey/v2/schedule"
@@ -24,7 +29,7 @@ import (
 type NullConstrainer struct{}
 
 func init() {
-	deps.GetConstrainer = getNullConstrainer
+	deps.GetConstrainer = getConstrainer
 }
 
 // Filter implements schedule.Constrainer.Filter
@@ -33,6 +38,76 @@ func (n NullConstrainer) Filter(s schedule.Schedule) schedule.Schedule {
 	return s
 }
 
-func getNullConstrainer(cfg *config.Monkey) (schedule.Constrainer, error) {
-	return NullConstrainer{}, nil
+// Chain is a constrainer that applies a list of constrainers in order
+type Chain []schedule.Constrainer
+
+// Filter implements schedule.Constrainer.Filter
+func (c Chain) Filter(s schedule.Schedule) schedule.Schedule {
+	for _, cons := range c {
+		s = cons.Filter(s)
+	}
+	return s
+}
+
+// Explain implements schedule.Explainer.Explain
+func (c Chain) Explain(s schedule.Schedule) (schedule.Schedule, []schedule.Change) {
+	var result []schedule.Change
+	for _, cons := range c {
+		var changes []schedule.Change
+		s, changes = schedule.Explain(cons, s)
+		result = append(result, changes...)
+	}
+	return s, result
+}
+
+// getConstrainer returns the constrainers specified in the configuration,
+// chained
+func getConstrainer(cfg *config.Monkey) (schedule.Constrainer, error) {
+	kinds, err := cfg.Constrainers()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(kinds) == 0 {
+		return NullConstrainer{}, nil
+	}
+
+	var result Chain
+	for _, kind := range kinds {
+		cons, err := getOne(kind, cfg)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, cons)
+	}
+	return result, nil
+}
+
+// getOne returns a constrainer by name
+func getOne(kind string, cfg *config.Monkey) (schedule.Constrainer, error) {
+	switch kind {
+	case "rate_limit":
+		r, err := NewRateLimitFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	case "blackout":
+		b, err := NewBlackoutFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	default:
+		return nil, errors.Errorf("unsupported constrainer: %s", kind)
+	}
+}
+
+// hours returns the hours that app may be terminated at in s. If s doesn't
+// record them, they default to the day up to endHour o'clock in loc.
+func hours(s *schedule.Schedule, app string, endHour int, loc *time.Location) schedule.Hours {
+	if h, ok := s.Hours(app); ok {
+		return h
+	}
+	return schedule.Hours{Windows: []chaosmonkey.KillWindow{{StartHour: 0, EndHour: endHour}}, Location: loc}
 }
@@ -0,0 +1,80 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constrainer
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+// dropApp is a constrainer that removes the terminations of an app, and
+// can't explain itself
+type dropApp string
+
+func (d dropApp) Filter(s schedule.Schedule) schedule.Schedule {
+	result := schedule.New()
+	for _, e := range s.Entries() {
+		if e.Group.App() != string(d) {
+			result.Add(e.Time, e.Group)
+		}
+	}
+	return *result
+}
+
+// changes returns the app, time and outcome of each change
+func changes(cs []schedule.Change) []string {
+	var result []string
+	for _, c := range cs {
+		s := c.Entry.Group.App() + "@" + c.Entry.Time.Format("15:04")
+		if !c.Removed() {
+			s += "->" + c.Moved.Format("15:04")
+		}
+		result = append(result, s+": "+c.Reason)
+	}
+	return result
+}
+
+func TestChainExplain(t *testing.T) {
+	apps := []string{"a", "b", "c", "d"}
+	times := []time.Time{at(9, 0), at(10, 30), at(12, 0), at(14, 0)}
+	s := sched("prod", "us-east-1", apps, times)
+
+	b, err := NewBlackout([]Window{{Name: "lunch", From: "12:00", To: "13:00"}}, true, 15, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Chain{
+		dropApp("a"),
+		b,
+		NewRateLimit(Limits{PerDay: 2}, false, 15, time.UTC),
+	}
+
+	result, got := c.Explain(s)
+	want := []string{
+		"a@09:00: removed by constrainer.dropApp",
+		"c@12:00->13:00: blackout window lunch",
+		"d@14:00: rate limit: 2 terminations per day",
+	}
+	if !reflect.DeepEqual(changes(got), want) {
+		t.Errorf("got changes %v, want %v", changes(got), want)
+	}
+
+	if got, want := summary(result), summary(c.Filter(s)); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v as filtered", got, want)
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constrainer
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+// Limits are the caps on the number of terminations in a schedule. A zero
+// value means no limit.
+type Limits struct {
+	// PerDay caps the terminations of the day
+	PerDay int
+
+	// PerAccount caps the terminations of the day in each account
+	PerAccount int
+
+	// PerRegion caps the terminations of the day in each region. Groups that
+	// span all regions don't count against it.
+	PerRegion int
+
+	// PerWindow caps the terminations within any period of Window
+	PerWindow int
+	Window    time.Duration
+}
+
+// RateLimit is a constrainer that caps the number of terminations per day,
+// account, region and sliding window. Terminations are kept in order of
+// time, so the earliest ones win.
+//
+// Terminations that exceed a daily cap are dropped. Those that exceed the
+// sliding window cap are moved to the earliest time that fits, if
+// rescheduling is enabled and that time is within the kill windows of the
+// app on the same day, and dropped otherwise.
+type RateLimit struct {
+	limits     Limits
+	reschedule bool
+	endHour    int
+	loc        *time.Location
+}
+
+// NewRateLimitFromConfig creates a new RateLimit taking config parameters
+// from cfg
+func NewRateLimitFromConfig(cfg *config.Monkey) (*RateLimit, error) {
+	limits := Limits{
+		PerDay:     cfg.RateLimitMaxPerDay(),
+		PerAccount: cfg.RateLimitMaxPerAccount(),
+		PerRegion:  cfg.RateLimitMaxPerRegion(),
+		PerWindow:  cfg.RateLimitMaxPerWindow(),
+		Window:     cfg.RateLimitWindow(),
+	}
+
+	if limits.PerWindow > 0 && limits.Window <= 0 {
+		return nil, errors.New("rate_limit.window must be positive")
+	}
+
+	loc, err := cfg.Location()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve location")
+	}
+
+	return NewRateLimit(limits, cfg.RateLimitReschedule(), cfg.EndHour(), loc), nil
+}
+
+// NewRateLimit returns a constrainer that enforces limits. If reschedule is
+// true, terminations that exceed the sliding window cap may be moved within
+// the kill windows of their app, or up to endHour o'clock in loc if the
+// schedule doesn't record those.
+func NewRateLimit(limits Limits, reschedule bool, endHour int, loc *time.Location) *RateLimit {
+	return &RateLimit{limits: limits, reschedule: reschedule, endHour: endHour, loc: loc}
+}
+
+// Filter implements schedule.Constrainer.Filter
+func (r *RateLimit) Filter(s schedule.Schedule) schedule.Schedule {
+	result, _ := r.Explain(s)
+	return result
+}
+
+// Explain implements schedule.Explainer.Explain
+func (r *RateLimit) Explain(s schedule.Schedule) (schedule.Schedule, []schedule.Change) {
+	entries := append([]schedule.Entry(nil), s.Entries()...)
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Time.Before(entries[j].Time) })
+
+	var accepted []time.Time
+	var kept []schedule.Entry
+	var changes []schedule.Change
+	perAccount := make(map[string]int)
+	perRegion := make(map[string]int)
+
+	for _, e := range entries {
+		account := e.Group.Account()
+		region, hasRegion := e.Group.Region()
+
+		var reason string
+		switch {
+		case r.limits.PerDay > 0 && len(accepted) >= r.limits.PerDay:
+			reason = fmt.Sprintf("rate limit: %d terminations per day", r.limits.PerDay)
+		case r.limits.PerAccount > 0 && perAccount[account] >= r.limits.PerAccount:
+			reason = fmt.Sprintf("rate limit: %d terminations per day in account %s", r.limits.PerAccount, account)
+		case r.limits.PerRegion > 0 && hasRegion && perRegion[region] >= r.limits.PerRegion:
+			reason = fmt.Sprintf("rate limit: %d terminations per day in region %s", r.limits.PerRegion, region)
+		}
+		if reason != "" {
+			log.Printf("%s: dropping termination of %s", reason, grp.String(e.Group))
+			changes = append(changes, schedule.Change{Entry: e, Reason: reason})
+			continue
+		}
+
+		t, ok := r.slot(accepted, e.Time, hours(&s, e.Group.App(), r.endHour, r.loc))
+		if !ok {
+			reason = fmt.Sprintf("rate limit: %d terminations per %s", r.limits.PerWindow, r.limits.Window)
+			log.Printf("%s: dropping termination of %s at %s", reason, grp.String(e.Group), e.Time)
+			changes = append(changes, schedule.Change{Entry: e, Reason: reason})
+			continue
+		}
+
+		if !t.Equal(e.Time) {
+			reason = fmt.Sprintf("rate limit: %d terminations per %s", r.limits.PerWindow, r.limits.Window)
+			log.Printf("%s: moving termination of %s from %s to %s", reason, grp.String(e.Group), e.Time, t)
+			changes = append(changes, schedule.Change{Entry: e, Moved: t, Reason: reason})
+		}
+
+		accepted = append(accepted, t)
+		perAccount[account]++
+		if hasRegion {
+			perRegion[region]++
+		}
+		kept = append(kept, schedule.Entry{Group: e.Group, Time: t})
+	}
+
+	// Rescheduled terminations may be out of order
+	sort.SliceStable(kept, func(i, j int) bool { return kept[i].Time.Before(kept[j].Time) })
+
+	result := s.Derive()
+	for _, e := range kept {
+		result.Add(e.Time, e.Group)
+	}
+	return *result, changes
+}
+
+// slot returns the earliest time at or after t, within hours, that a
+// termination fits in the sliding window cap, given the accepted
+// terminations. It returns false if there is none, or only one that needs
+// rescheduling when that is disabled.
+func (r *RateLimit) slot(accepted []time.Time, t time.Time, hours schedule.Hours) (time.Time, bool) {
+	if r.fits(accepted, t) {
+		return t, true
+	}
+
+	if !r.reschedule {
+		return time.Time{}, false
+	}
+
+	// A window frees up right after one of its terminations leaves it
+	var candidates []time.Time
+	for _, a := range accepted {
+		if c := a.Add(r.limits.Window); c.After(t) {
+			candidates = append(candidates, c)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+
+	for _, c := range candidates {
+		// Candidates outside of the app's hours move to the next window
+		c, ok := hours.Next(t, c)
+		if !ok {
+			break
+		}
+		if r.fits(accepted, c) {
+			return c, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// fits returns true if a termination at t doesn't put more than PerWindow
+// of the accepted terminations in any period of Window
+func (r *RateLimit) fits(accepted []time.Time, t time.Time) bool {
+	if r.limits.PerWindow <= 0 {
+		return true
+	}
+
+	// The periods that contain t start after t-Window and at or before t.
+	// The fullest of them starts at one of the terminations.
+	var nearby []time.Time
+	for _, a := range accepted {
+		if a.After(t.Add(-r.limits.Window)) && a.Before(t.Add(r.limits.Window)) {
+			nearby = append(nearby, a)
+		}
+	}
+	nearby = append(nearby, t)
+	sort.Slice(nearby, func(i, j int) bool { return nearby[i].Before(nearby[j]) })
+
+	for i, start := range nearby {
+		if start.After(t) {
+			break
+		}
+
+		n := 0
+		for _, a := range nearby[i:] {
+			if a.Sub(start) < r.limits.Window {
+				n++
+			}
+		}
+
+		if n > r.limits.PerWindow {
+			return false
+		}
+	}
+
+	return true
+}
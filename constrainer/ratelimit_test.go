@@ -0,0 +1,178 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constrainer
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+// at returns 2026-10-19 at hh:mm UTC
+func at(hh, mm int) time.Time {
+	return time.Date(2026, time.October, 19, hh, mm, 0, 0, time.UTC)
+}
+
+// sched returns a schedule that terminates an instance of each app at the
+// matching time, in the given account and region
+func sched(account, region string, apps []string, times []time.Time) schedule.Schedule {
+	s := schedule.New()
+	for i, app := range apps {
+		s.Add(times[i], grp.New(app, account, region, "", ""))
+	}
+	return *s
+}
+
+// summary returns the app and time of each entry of s
+func summary(s schedule.Schedule) []string {
+	var result []string
+	for _, e := range s.Entries() {
+		result = append(result, e.Group.App()+"@"+e.Time.Format("15:04"))
+	}
+	return result
+}
+
+func TestRateLimitDailyCaps(t *testing.T) {
+	s := schedule.New()
+	s.Add(at(9, 0), grp.New("a", "prod", "us-east-1", "", ""))
+	s.Add(at(9, 10), grp.New("b", "prod", "us-east-1", "", ""))
+	s.Add(at(9, 20), grp.New("c", "prod", "us-west-2", "", ""))
+	s.Add(at(9, 30), grp.New("d", "test", "us-east-1", "", ""))
+	s.Add(at(9, 40), grp.New("e", "test", "", "", ""))
+	s.Add(at(9, 50), grp.New("f", "test", "", "", ""))
+
+	tests := []struct {
+		limits Limits
+		want   []string
+	}{
+		{Limits{}, []string{"a@09:00", "b@09:10", "c@09:20", "d@09:30", "e@09:40", "f@09:50"}},
+		{Limits{PerDay: 4}, []string{"a@09:00", "b@09:10", "c@09:20", "d@09:30"}},
+		{Limits{PerAccount: 2}, []string{"a@09:00", "b@09:10", "d@09:30", "e@09:40"}},
+		// Groups in all regions don't count against the region cap
+		{Limits{PerRegion: 1}, []string{"a@09:00", "c@09:20", "e@09:40", "f@09:50"}},
+	}
+
+	for _, tt := range tests {
+		got := summary(NewRateLimit(tt.limits, true, 15, time.UTC).Filter(*s))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%+v: got %v, want %v", tt.limits, got, tt.want)
+		}
+	}
+}
+
+func TestRateLimitWindow(t *testing.T) {
+	apps := []string{"a", "b", "c", "d", "e"}
+	times := []time.Time{at(10, 0), at(10, 5), at(10, 10), at(10, 15), at(14, 40)}
+	s := sched("prod", "us-east-1", apps, times)
+	limits := Limits{PerWindow: 2, Window: time.Hour}
+
+	// c and d are moved to when a and b leave the window. e fits as is.
+	got := summary(NewRateLimit(limits, true, 15, time.UTC).Filter(s))
+	want := []string{"a@10:00", "b@10:05", "c@11:00", "d@11:05", "e@14:40"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rescheduling: got %v, want %v", got, want)
+	}
+
+	got = summary(NewRateLimit(limits, false, 15, time.UTC).Filter(s))
+	want = []string{"a@10:00", "b@10:05", "e@14:40"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("dropping: got %v, want %v", got, want)
+	}
+}
+
+// Terminations aren't moved past the end hour
+func TestRateLimitWindowEndHour(t *testing.T) {
+	apps := []string{"a", "b", "c"}
+	times := []time.Time{at(14, 0), at(14, 10), at(14, 20)}
+	s := sched("prod", "us-east-1", apps, times)
+
+	got := summary(NewRateLimit(Limits{PerWindow: 1, Window: 30 * time.Minute}, true, 15, time.UTC).Filter(s))
+	want := []string{"a@14:00", "b@14:30"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// Terminations are moved within the kill windows of their app, in its time
+// zone, rather than up to the end hour
+func TestRateLimitWindowAppHours(t *testing.T) {
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := schedule.New()
+	for i, app := range []string{"a", "b", "c", "d"} {
+		s.Add(time.Date(2026, time.October, 19, 11, 10*i, 0, 0, sydney), grp.New(app, "prod", "", "", ""))
+		s.SetHours(app, schedule.Hours{Windows: []chaosmonkey.KillWindow{{StartHour: 10, EndHour: 12}, {StartHour: 14, EndHour: 15}}, Location: sydney})
+	}
+
+	// Out of the first window, c and d move to the second one. The end hour
+	// in UTC would let them run between the windows.
+	got := summary(NewRateLimit(Limits{PerWindow: 1, Window: 30 * time.Minute}, true, 15, time.UTC).Filter(*s))
+	want := []string{"a@11:00", "b@11:30", "c@14:00", "d@14:30"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// No period of the window holds more than the cap, even across rescheduled
+// terminations
+func TestRateLimitWindowIsSliding(t *testing.T) {
+	var apps []string
+	var times []time.Time
+	for i := 0; i < 20; i++ {
+		apps = append(apps, string(rune('a'+i)))
+		times = append(times, at(9, 0).Add(time.Duration(i*7)*time.Minute))
+	}
+	s := sched("prod", "us-east-1", apps, times)
+
+	limits := Limits{PerWindow: 3, Window: time.Hour}
+	filtered := NewRateLimit(limits, true, 15, time.UTC).Filter(s)
+	entries := filtered.Entries()
+
+	for _, start := range entries {
+		n := 0
+		for _, e := range entries {
+			if !e.Time.Before(start.Time) && e.Time.Sub(start.Time) < limits.Window {
+				n++
+			}
+		}
+		if n > limits.PerWindow {
+			t.Errorf("got %d terminations in the hour from %s, want at most %d", n, start.Time.Format("15:04"), limits.PerWindow)
+		}
+	}
+
+	// 6 hours of room between 9:00 and 15:00
+	if got, want := len(entries), 18; got != want {
+		t.Errorf("got %d terminations, want %d", got, want)
+	}
+}
+
+func TestChain(t *testing.T) {
+	apps := []string{"a", "b", "c"}
+	times := []time.Time{at(10, 0), at(10, 5), at(10, 10)}
+	s := sched("prod", "us-east-1", apps, times)
+
+	c := Chain{NullConstrainer{}, NewRateLimit(Limits{PerDay: 2}, true, 15, time.UTC), NewRateLimit(Limits{PerDay: 1}, true, 15, time.UTC)}
+	got := summary(c.Filter(s))
+	if want := []string{"a@10:00"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
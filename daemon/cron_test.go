@@ -0,0 +1,88 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronNext(t *testing.T) {
+	// Friday
+	from := time.Date(2026, time.October, 16, 7, 0, 0, 0, la)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"0 7 * * 1-5", time.Date(2026, time.October, 19, 7, 0, 0, 0, la)},
+		{"30 7 * * 1-5", time.Date(2026, time.October, 16, 7, 30, 0, 0, la)},
+		{"* * * * *", time.Date(2026, time.October, 16, 7, 1, 0, 0, la)},
+		{"*/15 9-17 * * *", time.Date(2026, time.October, 16, 9, 0, 0, 0, la)},
+		{"0 0 1 1 *", time.Date(2027, time.January, 1, 0, 0, 0, 0, la)},
+		{"0 12 * * 0", time.Date(2026, time.October, 18, 12, 0, 0, 0, la)},
+		{"0 12 * * 7", time.Date(2026, time.October, 18, 12, 0, 0, 0, la)},
+		{"0 6,8 * * *", time.Date(2026, time.October, 16, 8, 0, 0, 0, la)},
+		// Either day field matches when both are restricted
+		{"0 5 20 * 6", time.Date(2026, time.October, 17, 5, 0, 0, 0, la)},
+		{"0 5 20 * *", time.Date(2026, time.October, 20, 5, 0, 0, 0, la)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, la)},
+	}
+
+	for _, tt := range tests {
+		s, err := parseCron(tt.expr)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.expr, err)
+			continue
+		}
+
+		if got := s.next(from); !got.Equal(tt.want) {
+			t.Errorf("%s: got next=%s, want %s", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestCronNeverMatches(t *testing.T) {
+	s, err := parseCron("0 0 30 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := s.next(time.Now()); !got.IsZero() {
+		t.Errorf("got next=%s, want zero time", got)
+	}
+}
+
+func TestParseCronInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"0 7 * *",
+		"0 7 * * 1-5 root",
+		"60 7 * * *",
+		"0 24 * * *",
+		"0 7 0 * *",
+		"0 7 * 13 *",
+		"0 7 * * 8",
+		"0 7 * * 5-1",
+		"*/0 7 * * *",
+		"a 7 * * *",
+		"0 7 * * mon",
+	} {
+		_, err := parseCron(expr)
+		if err == nil {
+			t.Errorf("%q: expected error", expr)
+		}
+	}
+}
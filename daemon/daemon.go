@@ -0,0 +1,524 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package daemon runs Chaos Monkey as a single long-lived process. Instead of
+// registering cron jobs, it generates the daily schedule at the time given by
+// the cron expression and runs each termination at its scheduled time. The
+// schedule is reloaded before each termination, so that amendments are
+// honoured.
+//
+// When several replicas run, an optional elector makes sure only the one that
+// holds the leader lease does so. The others wait to take over. An optional
+// warner announces each termination some time before it happens.
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/cal"
+	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/lease"
+	"github.com/Netflix/chaosmonkey/v2/metrics"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+	"github.com/Netflix/chaosmonkey/v2/term"
+)
+
+// retryInterval is how long the daemon waits before retrying to retrieve
+// today's schedule when it starts
+const retryInterval = time.Minute
+
+// releaseTimeout bounds how long the daemon waits for its lease to be
+// released when it stops
+const releaseTimeout = 10 * time.Second
+
+// Daemon generates the daily schedule of terminations and executes it
+type Daemon struct {
+	deps  deps.Deps
+	store schedstore.SchedStore
+	cons  schedule.Constrainer
+
+	// elector is nil if leader election is disabled
+	elector *lease.Elector
+
+	// warner is nil if terminations aren't announced, otherwise they are
+	// announced warnBefore ahead
+	warner     schedule.Warner
+	warnBefore time.Duration
+
+	// after and terminate are replaced in tests
+	after     func(time.Duration) <-chan time.Time
+	terminate func(deps.Deps, grp.InstanceGroup) error
+}
+
+// New returns a Daemon. Schedules are published to and recovered from store.
+// If elector is not nil, the daemon only runs while it holds the leader lease.
+// If warner is not nil, each termination is announced warnBefore ahead.
+func New(d deps.Deps, store schedstore.SchedStore, cons schedule.Constrainer, elector *lease.Elector, warner schedule.Warner, warnBefore time.Duration) *Daemon {
+	return &Daemon{
+		deps:       d,
+		store:      store,
+		cons:       cons,
+		elector:    elector,
+		warner:     warner,
+		warnBefore: warnBefore,
+		after:      time.After,
+		terminate:  terminate,
+	}
+}
+
+// terminate terminates an instance in group
+func terminate(d deps.Deps, group grp.InstanceGroup) error {
+	region, _ := group.Region()
+	stack, _ := group.Stack()
+	cluster, _ := group.Cluster()
+	return term.Terminate(d, group.App(), group.Account(), region, stack, cluster)
+}
+
+// Run runs the daemon until ctx is canceled. A termination that is in
+// progress when ctx is canceled is allowed to finish.
+//
+// On start, today's schedule is recovered from the schedule store, if one
+// was already published, and terminations scheduled in the past are skipped.
+// If none was and today's run of cron was missed, it is generated right away.
+// With leader election, the same happens every time this replica becomes the
+// leader, and terminations carry the fencing token of its lease. When ctx is
+// canceled, Run waits up to releaseTimeout for the lease to be released.
+func (d *Daemon) Run(ctx context.Context) error {
+	cfg := d.deps.MonkeyCfg
+
+	expr, err := cfg.CronExpression()
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve cron expression")
+	}
+
+	cron, err := parseCron(expr)
+	if err != nil {
+		return err
+	}
+
+	loc, err := cfg.Location()
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve location")
+	}
+
+	log.Printf("chaosmonkey daemon started, schedule runs at %q (%s)", expr, loc)
+
+	defer log.Println("chaosmonkey daemon stopping")
+
+	if d.elector == nil {
+		return d.lead(ctx, cron, expr, loc)
+	}
+
+	for {
+		log.Printf("campaigning for leadership as %s", d.elector.Holder())
+		leaderCtx, token, err := d.elector.Campaign(ctx)
+		if err != nil {
+			// ctx is done
+			return nil
+		}
+
+		d.deps.FencingToken = token
+		err = d.lead(leaderCtx, cron, expr, loc)
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			// Let another replica take over right away, rather than
+			// after the lease expires
+			if !d.elector.Wait(releaseTimeout) {
+				log.Printf("WARNING: gave up waiting for lease %s to be released after %s", lease.Name, releaseTimeout)
+			}
+			return nil
+		}
+
+		log.Println("no longer the leader")
+	}
+}
+
+// event is what the daemon does next
+type event int
+
+const (
+	runSchedule event = iota
+	runTermination
+	runWarning
+)
+
+// lead schedules and executes terminations until ctx is done
+func (d *Daemon) lead(ctx context.Context, cron cronSchedule, expr string, loc *time.Location) error {
+	day := d.recoverSchedule(ctx, cron, loc)
+	if day == nil {
+		// ctx is done
+		return nil
+	}
+
+	for {
+		now := d.deps.Cl.Now().In(loc)
+
+		nextRun := cron.next(now)
+		if nextRun.IsZero() {
+			return errors.Errorf("cron expression %q never matches", expr)
+		}
+
+		next, ev := nextRun, runSchedule
+		if len(day.pending) > 0 && !day.pending[0].Time.After(next) {
+			next, ev = day.pending[0].Time, runTermination
+		}
+		if len(day.unwarned) > 0 {
+			warnAt := day.unwarned[0].Time.Add(-d.warnBefore)
+			if !warnAt.After(next) {
+				next, ev = warnAt, runWarning
+			}
+		}
+
+		// Warnings that are due already are sent right away
+		wait := next.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-d.after(wait):
+		}
+
+		switch ev {
+		case runSchedule:
+			sched, err := d.generate(nextRun)
+			if err != nil {
+				log.Printf("ERROR: could not generate schedule: %v", err)
+				d.countError()
+				continue
+			}
+			d.deps.Seed = sched.Seed()
+			day = d.newDay(nextRun, sched, d.deps.Cl.Now())
+			log.Printf("%d terminations scheduled", len(day.pending))
+		case runTermination:
+			// The schedule may have been amended while waiting. If the
+			// entry was cancelled or moved, plan again.
+			d.refresh(day)
+			if len(day.pending) == 0 || day.pending[0].Time.After(d.deps.Cl.Now()) {
+				continue
+			}
+			entry := day.pending[0]
+			day.terminated(entry)
+			d.execute(entry)
+		case runWarning:
+			d.refresh(day)
+			if len(day.unwarned) == 0 || day.unwarned[0].Time.Add(-d.warnBefore).After(d.deps.Cl.Now()) {
+				continue
+			}
+			entry := day.unwarned[0]
+			day.announced(entry)
+			d.warn(entry)
+		}
+	}
+}
+
+// day is the schedule the daemon is running. Since the schedule may be
+// amended after it is published, it is reloaded from the store before each
+// termination and warning.
+type day struct {
+	date  time.Time          // the schedule was published for date
+	since time.Time          // entries before since are skipped
+	sched *schedule.Schedule // nil if there is no schedule
+
+	// warn is true if terminations are announced
+	warn bool
+
+	// done and warned are the keys of the entries that were terminated and
+	// announced
+	done   map[string]bool
+	warned map[string]bool
+
+	// pending are the entries that are still to be terminated, and
+	// unwarned those of them that haven't been announced, in time order
+	pending  []schedule.Entry
+	unwarned []schedule.Entry
+}
+
+// newDay returns the day running sched, published for date, from since on
+func (d *Daemon) newDay(date time.Time, sched *schedule.Schedule, since time.Time) *day {
+	result := &day{
+		date:   date,
+		since:  since,
+		warn:   d.warner != nil,
+		done:   make(map[string]bool),
+		warned: make(map[string]bool),
+	}
+
+	if sched != nil {
+		for _, e := range sched.Entries() {
+			if e.Time.Before(since) {
+				log.Printf("skipping past termination at %s: %s", e.Time, grp.String(e.Group))
+			}
+		}
+	}
+
+	result.set(sched)
+	return result
+}
+
+// refresh reloads the schedule of day from the store. On failure, the
+// schedule that was loaded last is kept.
+func (d *Daemon) refresh(day *day) {
+	if day.sched == nil {
+		return
+	}
+
+	sched, err := d.store.Retrieve(day.date)
+	if err != nil {
+		log.Printf("ERROR: could not reload schedule, using the one loaded last: %v", err)
+		d.countError()
+		return
+	}
+
+	// The schedule was published, so an empty one had all of its entries
+	// cancelled
+	day.set(sched)
+}
+
+// set replaces the schedule of day, keeping track of the entries that were
+// terminated and announced already
+func (day *day) set(sched *schedule.Schedule) {
+	day.sched = sched
+	day.pending, day.unwarned = nil, nil
+	if sched == nil {
+		return
+	}
+
+	for _, e := range sched.Entries() {
+		k := key(e)
+		if e.Time.Before(day.since) || day.done[k] {
+			continue
+		}
+
+		day.pending = append(day.pending, e)
+		if day.warn && !day.warned[k] {
+			day.unwarned = append(day.unwarned, e)
+		}
+	}
+
+	sort.Sort(schedule.ByTime(day.pending))
+	sort.Sort(schedule.ByTime(day.unwarned))
+}
+
+// terminated records that the termination of entry was run
+func (day *day) terminated(entry schedule.Entry) {
+	day.done[key(entry)] = true
+	day.set(day.sched)
+}
+
+// announced records that the termination of entry was announced
+func (day *day) announced(entry schedule.Entry) {
+	day.warned[key(entry)] = true
+	day.set(day.sched)
+}
+
+// key identifies an entry of a schedule
+func key(e schedule.Entry) string {
+	return fmt.Sprintf("%s@%d", grp.String(e.Group), e.Time.UnixNano())
+}
+
+// recoverSchedule returns today's schedule, if it has already been published.
+// If it hasn't although cron already ran today, it is generated right away.
+// Failures to retrieve the schedule are retried until ctx is done, in which
+// case it returns nil.
+func (d *Daemon) recoverSchedule(ctx context.Context, cron cronSchedule, loc *time.Location) *day {
+	var now time.Time
+	var sched *schedule.Schedule
+	for {
+		now = d.deps.Cl.Now().In(loc)
+
+		var err error
+		sched, err = d.store.Retrieve(now)
+		if err == nil {
+			break
+		}
+
+		log.Printf("ERROR: could not retrieve today's schedule, retrying in %s: %v", retryInterval, err)
+		d.countError()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-d.after(retryInterval):
+		}
+	}
+
+	if schedstore.Published(sched) {
+		d.deps.Seed = sched.Seed()
+		day := d.newDay(now, sched, now)
+		log.Printf("recovered today's schedule, %d of %d terminations are upcoming", len(day.pending), len(sched.Entries()))
+		return day
+	}
+
+	if !d.missedRun(cron, now) {
+		log.Println("no schedule for today yet")
+		return d.newDay(now, nil, now)
+	}
+
+	// The daemon started after today's run, e.g., after a restart or a
+	// failover to a replica that was not leading yet. Don't wait for
+	// tomorrow's run.
+	log.Println("no schedule for today and today's run was missed, generating it now")
+	sched, err := d.generate(now)
+	if err != nil {
+		log.Printf("ERROR: could not generate schedule: %v", err)
+		d.countError()
+		return d.newDay(now, nil, now)
+	}
+
+	d.deps.Seed = sched.Seed()
+	day := d.newDay(now, sched, now)
+	log.Printf("%d terminations scheduled", len(day.pending))
+	return day
+}
+
+// missedRun returns true if cron ran earlier on the day of now, which is a
+// work day whose termination window hasn't ended yet
+func (d *Daemon) missedRun(cron cronSchedule, now time.Time) bool {
+	if !cal.IsWorkday(now) || now.Hour() >= d.deps.MonkeyCfg.EndHour() {
+		return false
+	}
+
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	run := cron.next(midnight.Add(-time.Minute))
+	return !run.IsZero() && !run.After(now)
+}
+
+// generate creates and publishes the schedule for date. If a schedule was
+// already published for date, that one is used instead.
+func (d *Daemon) generate(date time.Time) (*schedule.Schedule, error) {
+	cfg := d.deps.MonkeyCfg
+
+	enabled, err := cfg.ScheduleEnabled()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot determine if schedule is enabled")
+	}
+
+	if !enabled {
+		log.Println("schedule disabled, not running")
+		return schedule.New(), nil
+	}
+
+	if !cal.IsWorkday(date) {
+		log.Printf("%s is not a work day, not scheduling terminations", date.Format("2006-01-02"))
+		return schedule.New(), nil
+	}
+
+	s := schedule.New()
+	s.SetAnchor(d.deps.Cl.Now())
+	if d.deps.Snoozes != nil {
+		snoozes, err := d.deps.Snoozes.Snoozes(d.deps.Cl.Now())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve snoozes")
+		}
+		s.SetSnoozes(snoozes)
+	}
+
+	err = s.Populate(d.deps.Dep, d.deps.ConfGetter, cfg, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to populate schedule")
+	}
+
+	// Filter out terminations that violate constrains. Constrainers don't
+	// necessarily keep the seed and anchor.
+	sched := d.cons.Filter(*s)
+	sched.SetSeed(s.Seed())
+	sched.SetAnchor(s.Anchor())
+	metrics.RecordSchedule(len(sched.Entries()), d.deps.Cl.Now())
+
+	err = d.store.Publish(date, &sched)
+	if err == schedstore.ErrAlreadyExists {
+		log.Println("schedule already published for today, using it")
+		return d.store.Retrieve(date)
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "could not publish schedule")
+	}
+
+	return &sched, nil
+}
+
+// execute runs a termination, logging any failure so that the daemon keeps
+// running
+func (d *Daemon) execute(entry schedule.Entry) {
+	defer func() {
+		if e := recover(); e != nil {
+			log.Printf("ERROR: panic: %s: %s", e, debug.Stack())
+			d.countError()
+		}
+	}()
+
+	log.Printf("terminating: %s", grp.String(entry.Group))
+
+	err := d.terminate(d.deps, entry.Group)
+	if err != nil {
+		log.Printf("ERROR: termination failed for %s: %+v", grp.String(entry.Group), err)
+		d.countError()
+	}
+}
+
+// warn announces an upcoming termination, logging any failure
+func (d *Daemon) warn(entry schedule.Entry) {
+	cfg := d.deps.MonkeyCfg
+
+	// Don't announce terminations that won't happen
+	enabled, err := cfg.Enabled()
+	if err != nil {
+		log.Printf("ERROR: not warning: could not determine if chaos monkey is enabled: %v", err)
+		d.countError()
+		return
+	}
+
+	if !enabled {
+		return
+	}
+
+	leashed, err := cfg.Leashed()
+	if err != nil {
+		log.Printf("ERROR: not warning: could not determine leashed status: %v", err)
+		d.countError()
+		return
+	}
+
+	log.Printf("warning about termination at %s: %s", entry.Time, grp.String(entry.Group))
+
+	err = d.warner.Warn(entry, leashed || d.deps.Leashed)
+	if err != nil {
+		log.Printf("ERROR: warning failed for %s: %v", grp.String(entry.Group), err)
+		d.countError()
+	}
+}
+
+// countError increments the error counter
+func (d *Daemon) countError() {
+	err := d.deps.ErrCounter.Increment()
+	if err != nil {
+		log.Printf("WARNING could not increment error counter: %v", err)
+	}
+}
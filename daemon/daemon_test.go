@@ -0,0 +1,518 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/lease"
+	"github.com/Netflix/chaosmonkey/v2/mock"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+// fakeStore is an in-memory schedstore.SchedStore
+type fakeStore struct {
+	scheds    map[string]*schedule.Schedule
+	published []time.Time
+}
+
+// Retrieve returns an empty schedule for dates without one, like the real
+// stores
+func (s *fakeStore) Retrieve(date time.Time) (*schedule.Schedule, error) {
+	sched, ok := s.scheds[date.Format("2006-01-02")]
+	if !ok {
+		return schedule.New(), nil
+	}
+	return sched, nil
+}
+
+func (s *fakeStore) Publish(date time.Time, sched *schedule.Schedule) error {
+	s.published = append(s.published, date)
+	if _, ok := s.scheds[date.Format("2006-01-02")]; ok {
+		return schedstore.ErrAlreadyExists
+	}
+	s.scheds[date.Format("2006-01-02")] = sched
+	return nil
+}
+
+// fixedConstrainer replaces every schedule with its own
+type fixedConstrainer struct {
+	sched *schedule.Schedule
+}
+
+func (c fixedConstrainer) Filter(s schedule.Schedule) schedule.Schedule {
+	return *c.sched
+}
+
+// harness runs a daemon against a fake clock that advances whenever the
+// daemon waits, and records the terminations
+type harness struct {
+	t      *testing.T
+	clock  *mock.Clock
+	store  *fakeStore
+	daemon *Daemon
+
+	// terminations are "hh:mm app" strings, in the order they happened
+	terminations []string
+
+	// tokens are the fencing tokens of the terminations
+	tokens []int64
+
+	// stopAfter is the number of terminations after which the daemon is
+	// stopped
+	stopAfter int
+	fail      bool
+}
+
+var la, _ = time.LoadLocation("America/Los_Angeles")
+
+func newHarness(t *testing.T, now time.Time, sched *schedule.Schedule) *harness {
+	h := &harness{
+		t:     t,
+		clock: &mock.Clock{Time: now},
+		store: &fakeStore{scheds: make(map[string]*schedule.Schedule)},
+	}
+
+	d := mock.Deps()
+	d.MonkeyCfg.Set(param.ScheduleEnabled, true)
+	d.MonkeyCfg.Set(param.CronExpression, "0 7 * * 1-5")
+	d.Cl = h.clock
+
+	h.daemon = New(d, h.store, fixedConstrainer{sched}, nil, nil, 0)
+	return h
+}
+
+func (h *harness) run() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h.daemon.after = func(d time.Duration) <-chan time.Time {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if d < 0 {
+			h.t.Fatalf("negative wait: %s", d)
+		}
+		if d > 7*24*time.Hour {
+			h.t.Fatalf("daemon waited too long: %s", d)
+		}
+		h.clock.Time = h.clock.Time.Add(d)
+		c := make(chan time.Time, 1)
+		c <- h.clock.Time
+		return c
+	}
+
+	h.daemon.terminate = func(d deps.Deps, group grp.InstanceGroup) error {
+		h.terminations = append(h.terminations, d.Cl.Now().In(la).Format("15:04")+" "+group.App())
+		h.tokens = append(h.tokens, d.FencingToken)
+		if len(h.terminations) >= h.stopAfter {
+			cancel()
+		}
+		if h.fail {
+			return errors.New("termination failed")
+		}
+		return nil
+	}
+
+	err := h.daemon.Run(ctx)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+}
+
+func at(day int, hour int, min int) time.Time {
+	return time.Date(2026, time.October, day, hour, min, 0, 0, la)
+}
+
+func newSchedule(entries ...schedule.Entry) *schedule.Schedule {
+	s := schedule.New()
+	for _, e := range entries {
+		s.Add(e.Time, e.Group)
+	}
+	return s
+}
+
+func entry(tm time.Time, app string) schedule.Entry {
+	return schedule.Entry{Time: tm, Group: grp.New(app, "prod", "", "", "")}
+}
+
+// On restart, today's schedule is reloaded and past entries are skipped
+func TestRecover(t *testing.T) {
+	h := newHarness(t, at(19, 10, 30), newSchedule())
+	h.store.scheds["2026-10-19"] = newSchedule(
+		entry(at(19, 12, 0), "baz"),
+		entry(at(19, 10, 0), "foo"),
+		entry(at(19, 11, 0), "bar"),
+	)
+	h.stopAfter = 2
+
+	h.run()
+
+	if got, want := h.terminations, []string{"11:00 bar", "12:00 baz"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got terminations=%v, want %v", got, want)
+	}
+
+	if len(h.store.published) != 0 {
+		t.Errorf("got published=%v, want none", h.store.published)
+	}
+}
+
+// The schedule is generated and published at the cron time, then its
+// entries are run
+func TestGenerate(t *testing.T) {
+	// Saturday: the next cron run is on Monday
+	sched := newSchedule(entry(at(19, 13, 0), "bar"), entry(at(19, 9, 15), "foo"))
+	h := newHarness(t, at(17, 8, 0), sched)
+	h.stopAfter = 2
+
+	h.run()
+
+	if got, want := h.terminations, []string{"09:15 foo", "13:00 bar"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got terminations=%v, want %v", got, want)
+	}
+
+	if got, want := h.store.published, []time.Time{at(19, 7, 0)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got published=%v, want %v", got, want)
+	}
+}
+
+// If the daemon starts after today's cron time and no schedule was
+// published, it is generated right away rather than on the next day
+func TestGenerateMissedRun(t *testing.T) {
+	sched := newSchedule(entry(at(19, 10, 0), "foo"), entry(at(19, 11, 0), "bar"))
+	h := newHarness(t, at(19, 10, 30), sched)
+	h.stopAfter = 1
+
+	h.run()
+
+	if got, want := h.terminations, []string{"11:00 bar"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got terminations=%v, want %v", got, want)
+	}
+
+	if got, want := h.store.published, []time.Time{at(19, 10, 30)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got published=%v, want %v", got, want)
+	}
+}
+
+// Once today's termination window has ended, the schedule waits for the
+// next cron time
+func TestMissedRunAfterWindow(t *testing.T) {
+	sched := newSchedule(entry(at(20, 10, 0), "foo"))
+	h := newHarness(t, at(19, 16, 0), sched)
+	h.stopAfter = 1
+
+	h.run()
+
+	if got, want := h.store.published, []time.Time{at(20, 7, 0)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got published=%v, want %v", got, want)
+	}
+}
+
+// If another process already published the schedule, that one is used
+func TestGenerateAlreadyPublished(t *testing.T) {
+	h := newHarness(t, at(19, 6, 0), newSchedule(entry(at(19, 10, 0), "foo")))
+	h.stopAfter = 1
+
+	// Published by someone else after the daemon started
+	retrieved := newSchedule(entry(at(19, 8, 0), "bar"))
+	store := &publishedLater{fakeStore: h.store, sched: retrieved}
+	h.daemon.store = store
+
+	h.run()
+
+	if got, want := h.terminations, []string{"08:00 bar"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got terminations=%v, want %v", got, want)
+	}
+}
+
+// publishedLater is a store whose schedule appears after the first Retrieve
+type publishedLater struct {
+	*fakeStore
+	sched *schedule.Schedule
+	calls int
+}
+
+func (s *publishedLater) Retrieve(date time.Time) (*schedule.Schedule, error) {
+	s.calls++
+	if s.calls == 1 {
+		return schedule.New(), nil
+	}
+	return s.sched, nil
+}
+
+func (s *publishedLater) Publish(date time.Time, sched *schedule.Schedule) error {
+	return schedstore.ErrAlreadyExists
+}
+
+// Amendments made after the schedule was loaded are honoured: cancelled
+// entries are not terminated, moved and added ones are
+func TestAmendments(t *testing.T) {
+	h := newHarness(t, at(19, 10, 30), newSchedule())
+	h.store.scheds["2026-10-19"] = newSchedule(entry(at(19, 11, 0), "foo"), entry(at(19, 12, 0), "bar"))
+	h.stopAfter = 5
+
+	h.daemon.warner = &recordingWarner{h: h}
+	h.daemon.warnBefore = 15 * time.Minute
+
+	// foo is cancelled after it was announced, bar is moved and baz added
+	amended := newSchedule(entry(at(19, 12, 30), "bar"), entry(at(19, 13, 0), "baz"))
+	h.daemon.store = &amendedLater{fakeStore: h.store, clock: h.clock, sched: amended, after: at(19, 10, 50)}
+
+	h.run()
+
+	want := []string{
+		"10:45 warn foo",
+		"12:15 warn bar",
+		"12:30 bar",
+		"12:45 warn baz",
+		"13:00 baz",
+	}
+	if got := h.terminations; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// amendedLater is a store whose schedule is replaced by sched once the
+// clock is past after
+type amendedLater struct {
+	*fakeStore
+	clock *mock.Clock
+	sched *schedule.Schedule
+	after time.Time
+}
+
+func (s *amendedLater) Retrieve(date time.Time) (*schedule.Schedule, error) {
+	if s.clock.Now().After(s.after) {
+		return s.sched, nil
+	}
+	return s.fakeStore.Retrieve(date)
+}
+
+// failingOnce is a store whose first retrieval fails
+type failingOnce struct {
+	*fakeStore
+	calls int
+}
+
+func (s *failingOnce) Retrieve(date time.Time) (*schedule.Schedule, error) {
+	s.calls++
+	if s.calls == 1 {
+		return nil, errors.New("connection refused")
+	}
+	return s.fakeStore.Retrieve(date)
+}
+
+// A failure to retrieve today's schedule on start is retried
+func TestRecoverRetry(t *testing.T) {
+	h := newHarness(t, at(19, 10, 30), newSchedule())
+	h.store.scheds["2026-10-19"] = newSchedule(entry(at(19, 10, 0), "foo"), entry(at(19, 11, 0), "bar"))
+	store := &failingOnce{fakeStore: h.store}
+	h.daemon.store = store
+	h.stopAfter = 1
+
+	h.run()
+
+	if got, want := h.terminations, []string{"11:00 bar"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got terminations=%v, want %v", got, want)
+	}
+
+	if store.calls < 2 {
+		t.Errorf("got %d retrievals, want at least 2", store.calls)
+	}
+}
+
+// A failed termination does not stop the daemon
+func TestTerminationFailure(t *testing.T) {
+	h := newHarness(t, at(19, 10, 30), newSchedule())
+	h.store.scheds["2026-10-19"] = newSchedule(entry(at(19, 11, 0), "foo"), entry(at(19, 12, 0), "bar"))
+	h.stopAfter = 2
+	h.fail = true
+
+	h.run()
+
+	if got, want := len(h.terminations), 2; got != want {
+		t.Errorf("got %d terminations, want %d", got, want)
+	}
+}
+
+// Scheduling disabled means no terminations are scheduled or published
+func TestScheduleDisabled(t *testing.T) {
+	h := newHarness(t, at(19, 6, 0), newSchedule(entry(at(19, 10, 0), "foo")))
+	h.daemon.deps.MonkeyCfg.Set(param.ScheduleEnabled, false)
+	h.stopAfter = 1
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runs := 0
+	h.daemon.after = func(d time.Duration) <-chan time.Time {
+		// Stop after the cron has fired on two days
+		runs++
+		if runs > 2 {
+			cancel()
+			return nil
+		}
+		h.clock.Time = h.clock.Time.Add(d)
+		c := make(chan time.Time, 1)
+		c <- h.clock.Time
+		return c
+	}
+	h.daemon.terminate = func(deps.Deps, grp.InstanceGroup) error {
+		t.Error("unexpected termination")
+		return nil
+	}
+
+	err := h.daemon.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(h.store.published) != 0 {
+		t.Errorf("got published=%v, want none", h.store.published)
+	}
+}
+
+// leaseStore is a lease.Store where the lease is held by another replica for
+// the first busy attempts to acquire it
+type leaseStore struct {
+	mu       sync.Mutex
+	busy     int
+	released int
+}
+
+func (s *leaseStore) Acquire(name string, holder string, now time.Time, ttl time.Duration) (lease.Lease, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.busy > 0 {
+		s.busy--
+		return lease.Lease{Name: name, Holder: "other", Token: 6, Expires: now.Add(ttl)}, nil
+	}
+
+	return lease.Lease{Name: name, Holder: holder, Token: 7, Expires: now.Add(ttl)}, nil
+}
+
+func (s *leaseStore) Release(name string, holder string, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.released++
+	return nil
+}
+
+// With leader election, terminations only run once the lease is acquired, and
+// carry its fencing token. The lease is released by the time the daemon stops.
+func TestLeaderElection(t *testing.T) {
+	h := newHarness(t, at(19, 10, 30), newSchedule())
+	h.store.scheds["2026-10-19"] = newSchedule(entry(at(19, 11, 0), "foo"), entry(at(19, 12, 0), "bar"))
+	h.stopAfter = 2
+
+	// The elector gets its own clock, since it renews the lease in the
+	// background while the harness advances h.clock
+	leases := &leaseStore{busy: 2}
+	h.daemon.elector = lease.NewElector(leases, mock.Clock{Time: at(19, 10, 30)}, lease.Name, "me", 30*time.Millisecond)
+
+	h.run()
+
+	if got, want := h.terminations, []string{"11:00 foo", "12:00 bar"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got terminations=%v, want %v", got, want)
+	}
+
+	if got, want := h.tokens, []int64{7, 7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got tokens=%v, want %v", got, want)
+	}
+
+	leases.mu.Lock()
+	defer leases.mu.Unlock()
+
+	if leases.busy != 0 {
+		t.Errorf("got %d acquisitions left, want 0", leases.busy)
+	}
+
+	if got, want := leases.released, 1; got != want {
+		t.Errorf("got %d releases, want %d", got, want)
+	}
+}
+
+// recordingWarner records warnings in the harness' terminations, as
+// "hh:mm warn app" strings
+type recordingWarner struct {
+	h       *harness
+	leashed []bool
+}
+
+func (w *recordingWarner) Warn(e schedule.Entry, leashed bool) error {
+	w.h.terminations = append(w.h.terminations, w.h.clock.Now().In(la).Format("15:04")+" warn "+e.Group.App())
+	w.leashed = append(w.leashed, leashed)
+	return nil
+}
+
+// Terminations are announced ahead of time. Announcements that are already
+// late are sent right away.
+func TestWarnings(t *testing.T) {
+	h := newHarness(t, at(19, 10, 30), newSchedule())
+	h.store.scheds["2026-10-19"] = newSchedule(
+		entry(at(19, 10, 40), "foo"),
+		entry(at(19, 12, 0), "baz"),
+		entry(at(19, 11, 0), "bar"),
+	)
+	h.stopAfter = 6
+
+	w := &recordingWarner{h: h}
+	h.daemon.warner = w
+	h.daemon.warnBefore = 30 * time.Minute
+
+	h.run()
+
+	want := []string{
+		"10:30 warn foo",
+		"10:30 warn bar",
+		"10:40 foo",
+		"11:00 bar",
+		"11:30 warn baz",
+		"12:00 baz",
+	}
+	if got := h.terminations; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got, want := w.leashed, []bool{false, false, false}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got leashed=%v, want %v", got, want)
+	}
+}
+
+// Terminations aren't announced while Chaos Monkey is disabled
+func TestNoWarningsWhenDisabled(t *testing.T) {
+	h := newHarness(t, at(19, 10, 30), newSchedule())
+	h.store.scheds["2026-10-19"] = newSchedule(entry(at(19, 11, 0), "foo"))
+	h.daemon.deps.MonkeyCfg.Set(param.Enabled, false)
+	h.stopAfter = 1
+
+	h.daemon.warner = &recordingWarner{h: h}
+	h.daemon.warnBefore = 30 * time.Minute
+
+	h.run()
+
+	if got, want := h.terminations, []string{"11:00 foo"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
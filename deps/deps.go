@@ -21,6 +21,7 @@ import (
 	"github.com/Netflix/chaosmonkey/v2/config"
 	"github.com/Netflix/chaosmonkey/v2/deploy"
 	"github.com/Netflix/chaosmonkey/v2/schedule"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
 )
 
 var (
@@ -57,4 +58,20 @@ type Deps struct {
 	Ou         chaosmonkey.Outage
 	ErrCounter chaosmonkey.ErrorCounter
 	Env        chaosmonkey.Env
+
+	// Snoozes are the apps and clusters that owners opted out of
+	// terminations for a while, none if nil
+	Snoozes snooze.Store
+
+	// FencingToken is the token of the leader lease that terminations are
+	// made under, 0 if leader election is disabled
+	FencingToken int64
+
+	// Leashed forces terminations to be leashed, even if the config is
+	// unleashed
+	Leashed bool
+
+	// Seed is the seed of the day's schedule, that instance picks derive
+	// from. If 0, each pick draws a new seed.
+	Seed int64
 }
@@ -18,12 +18,14 @@ import (
 	"github.com/Netflix/chaosmonkey/v2"
 	"github.com/Netflix/chaosmonkey/v2/config"
 	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/metrics"
+	"github.com/Netflix/chaosmonkey/v2/statsd"
 	"github.com/pkg/errors"
 )
 
 // Netflix uses Atlas for tracking error events.
-// In the open-source build, we currently only support a null (no-op) error
-// counter
+// In the open-source build, errors can be counted in the Prometheus metrics,
+// sent to StatsD, or not counted at all
 
 type nullErrorCounter struct{}
 
@@ -32,14 +34,22 @@ func (n nullErrorCounter) Increment() error {
 }
 
 func init() {
-	deps.GetErrorCounter = getNullErrorCounter
+	deps.GetErrorCounter = getErrorCounter
 }
 
-func getNullErrorCounter(cfg *config.Monkey) (chaosmonkey.ErrorCounter, error) {
-	kind := cfg.ErrorCounter()
-	if kind != "" {
+func getErrorCounter(cfg *config.Monkey) (chaosmonkey.ErrorCounter, error) {
+	switch kind := cfg.ErrorCounter(); kind {
+	case "":
+		return nullErrorCounter{}, nil
+	case "prometheus":
+		return metrics.ErrorCounter{}, nil
+	case "statsd":
+		c, err := statsd.NewFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return c, nil
+	default:
 		return nil, errors.Errorf("unsupported error counter: %s", kind)
 	}
-
-	return nullErrorCounter{}, nil
 }
@@ -7,20 +7,21 @@ require (
 	github.com/davecgh/go-spew v1.1.1
 	github.com/go-sql-driver/mysql v1.2.1-0.20160802113842-0b58b37b664c
 	github.com/kardianos/osext v0.0.0-20160811001526-c2c54e542fb7
+	github.com/lib/pq v1.10.7
+	github.com/mattn/go-sqlite3 v1.14.16
 	github.com/pkg/errors v0.7.2-0.20160916110212-a887431f7f6e
 	github.com/rubenv/sql-migrate v0.0.0-20160620083229-6f4757563362
 	github.com/spf13/pflag v0.0.0-20160915153101-c7e63cf4530b
 	github.com/spf13/viper v0.0.0-20160926150402-382f87b929b8
 	golang.org/x/crypto v0.0.0-20160922170629-8e06e8ddd962
+	gopkg.in/yaml.v2 v2.0.0-20160912165603-31c299268d30
 )
 
 require (
 	github.com/fsnotify/fsnotify v1.3.2-0.20160816051541-f12c6236fe7b // indirect
 	github.com/hashicorp/hcl v0.0.0-20160916130100-ef8133da8cda // indirect
 	github.com/kr/fs v0.0.0-20131111012553-2788f0dbd169 // indirect
-	github.com/lib/pq v1.10.7 // indirect
 	github.com/magiconair/properties v1.7.1-0.20160908093658-0723e352fa35 // indirect
-	github.com/mattn/go-sqlite3 v1.14.16 // indirect
 	github.com/mitchellh/mapstructure v0.0.0-20160808181253-ca63d7c062ee // indirect
 	github.com/pelletier/go-buffruneio v0.1.0 // indirect
 	github.com/pelletier/go-toml v0.3.6-0.20160920070715-45932ad32dfd // indirect
@@ -30,9 +31,8 @@ require (
 	github.com/spf13/jwalterweatherman v0.0.0-20160311093646-33c24e77fb80 // indirect
 	github.com/stretchr/testify v1.8.1 // indirect
 	github.com/ziutek/mymysql v1.5.4 // indirect
-	golang.org/x/sys v0.0.0-20160916181909-8f0908ab3b24 // indirect
+	golang.org/x/sys v0.5.0 // indirect
 	golang.org/x/text v0.9.0 // indirect
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
 	gopkg.in/gorp.v1 v1.7.1 // indirect
-	gopkg.in/yaml.v2 v2.0.0-20160912165603-31c299268d30 // indirect
 )
@@ -0,0 +1,106 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package history provides access to the terminations recorded in the
+// database
+package history
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultLimit is the number of terminations returned when a query does not
+// specify a limit
+const DefaultLimit = 100
+
+// Record is a recorded termination
+type Record struct {
+	App        string    `json:"app"`
+	Account    string    `json:"account"`
+	Stack      string    `json:"stack"`
+	Cluster    string    `json:"cluster"`
+	Region     string    `json:"region"`
+	ASG        string    `json:"asg"`
+	InstanceID string    `json:"instanceId"`
+	KilledAt   time.Time `json:"killedAt"`
+	Leashed    bool      `json:"leashed"`
+}
+
+// dateFormat is the format of dates accepted by ParseSince and ParseUntil
+const dateFormat = "2006-01-02"
+
+// Query selects recorded terminations. Blank fields match everything.
+type Query struct {
+	App     string
+	Account string
+	Region  string
+	Stack   string
+	Cluster string
+
+	// Since and Until bound the termination time. Since is inclusive and
+	// Until exclusive. A zero time is unbounded.
+	Since time.Time
+	Until time.Time
+
+	// Leashed selects only leashed or only unleashed terminations, both if nil
+	Leashed *bool
+
+	// Limit is the maximum number of terminations returned, DefaultLimit if 0
+	Limit int
+}
+
+// Store retrieves recorded terminations
+type Store interface {
+	// Terminations returns the terminations that match q, most recent first
+	Terminations(q Query) ([]Record, error)
+}
+
+// ParseSince parses the lower bound of a time range. It accepts an RFC 3339
+// time, or a YYYY-MM-DD date in loc, which starts at midnight.
+func ParseSince(s string, loc *time.Location) (time.Time, error) {
+	t, _, err := parse(s, loc)
+	return t, err
+}
+
+// ParseUntil parses the upper bound of a time range. It accepts an RFC 3339
+// time, or a YYYY-MM-DD date in loc, which includes the whole day.
+func ParseUntil(s string, loc *time.Location) (time.Time, error) {
+	t, isDate, err := parse(s, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if isDate {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t, nil
+}
+
+// parse parses an RFC 3339 time or a date, returning true if it is a date
+func parse(s string, loc *time.Location) (time.Time, bool, error) {
+	t, err := time.ParseInLocation(dateFormat, s, loc)
+	if err == nil {
+		return t, true, nil
+	}
+
+	t, err = time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false, errors.Errorf("invalid time %q, want YYYY-MM-DD or RFC 3339", s)
+	}
+
+	return t, false, nil
+}
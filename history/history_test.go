@@ -0,0 +1,68 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSinceUntil(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		s     string
+		since time.Time
+		until time.Time
+	}{
+		{"2026-10-19", time.Date(2026, time.October, 19, 0, 0, 0, 0, la), time.Date(2026, time.October, 20, 0, 0, 0, 0, la)},
+		{"2026-10-19T10:30:00Z", time.Date(2026, time.October, 19, 10, 30, 0, 0, time.UTC), time.Date(2026, time.October, 19, 10, 30, 0, 0, time.UTC)},
+		{"2026-10-19T10:30:00-07:00", time.Date(2026, time.October, 19, 17, 30, 0, 0, time.UTC), time.Date(2026, time.October, 19, 17, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		since, err := ParseSince(tt.s, la)
+		if err != nil {
+			t.Fatalf("ParseSince(%q): %v", tt.s, err)
+		}
+		if !since.Equal(tt.since) {
+			t.Errorf("got ParseSince(%q)=%s, want %s", tt.s, since, tt.since)
+		}
+
+		until, err := ParseUntil(tt.s, la)
+		if err != nil {
+			t.Fatalf("ParseUntil(%q): %v", tt.s, err)
+		}
+		if !until.Equal(tt.until) {
+			t.Errorf("got ParseUntil(%q)=%s, want %s", tt.s, until, tt.until)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, s := range []string{"", "yesterday", "2026-13-01", "10/19/2026"} {
+		_, err := ParseSince(s, time.UTC)
+		if err == nil {
+			t.Errorf("ParseSince(%q) succeeded, want error", s)
+		}
+		_, err = ParseUntil(s, time.UTC)
+		if err == nil {
+			t.Errorf("ParseUntil(%q) succeeded, want error", s)
+		}
+	}
+}
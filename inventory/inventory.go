@@ -0,0 +1,344 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package inventory provides a deploy.Deployment and a
+// chaosmonkey.AppConfigGetter backed by a static YAML or JSON file. It lets
+// Chaos Monkey schedule and simulate terminations without Spinnaker, e.g., on
+// a laptop or in integration tests.
+//
+// Example inventory:
+//
+//	apps:
+//	  foo:
+//	    chaosMonkey:
+//	      enabled: true
+//	      meanTimeBetweenKillsInWorkDays: 5
+//	      minTimeBetweenKillsInWorkDays: 1
+//	      grouping: cluster
+//	      regionsAreIndependent: true
+//	      exceptions:
+//	        - {account: test, stack: "*", detail: "*", region: "*"}
+//	    accounts:
+//	      prod:
+//	        cloudProvider: aws
+//	        clusters:
+//	          foo-prod:
+//	            us-east-1:
+//	              foo-prod-v001: [i-0a1b2c3d, i-0e1f2a3b]
+//	            us-west-2:
+//	              foo-prod-v001: [i-4c5d6e7f]
+//
+// The chaosMonkey field has the same schema as the chaosMonkey attribute of
+// a Spinnaker application.
+package inventory
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	D "github.com/Netflix/chaosmonkey/v2/deploy"
+	"github.com/Netflix/chaosmonkey/v2/spinnaker"
+)
+
+// defaultCloudProvider is the cloud provider of accounts that don't specify
+// one
+const defaultCloudProvider = "aws"
+
+type (
+	// Inventory implements the deploy.Deployment and
+	// chaosmonkey.AppConfigGetter interfaces with the contents of a file
+	Inventory struct {
+		apps map[string]app
+	}
+
+	// inventoryFile is the format of the inventory file
+	inventoryFile struct {
+		Apps map[string]app `yaml:"apps" json:"apps"`
+	}
+
+	// app is an app in the inventory file
+	app struct {
+		ChaosMonkey interface{}        `yaml:"chaosMonkey" json:"chaosMonkey"`
+		Accounts    map[string]account `yaml:"accounts" json:"accounts"`
+	}
+
+	// account is an account in the inventory file. Clusters maps cluster
+	// name to region name to server group name to instance ids
+	account struct {
+		CloudProvider string                                    `yaml:"cloudProvider" json:"cloudProvider"`
+		Clusters      map[string]map[string]map[string][]string `yaml:"clusters" json:"clusters"`
+	}
+)
+
+// NewFromConfig returns an Inventory that reads the file at inventory.path
+func NewFromConfig(cfg *config.Monkey) (Inventory, error) {
+	path := cfg.InventoryPath()
+	if path == "" {
+		return Inventory{}, errors.Errorf("%s not specified", param.InventoryPath)
+	}
+
+	return New(path)
+}
+
+// New returns an Inventory with the contents of a file. Files with a .json
+// extension are parsed as JSON, all others as YAML.
+func New(path string) (Inventory, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Inventory{}, errors.Wrapf(err, "failed to read inventory %s", path)
+	}
+
+	var f inventoryFile
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &f)
+	} else {
+		err = yaml.Unmarshal(data, &f)
+	}
+
+	if err != nil {
+		return Inventory{}, errors.Wrapf(err, "failed to parse inventory %s", path)
+	}
+
+	if len(f.Apps) == 0 {
+		log.Printf("WARNING: inventory %s has no apps", path)
+	}
+
+	return Inventory{apps: f.Apps}, nil
+}
+
+// lookup returns an app from the inventory
+func (inv Inventory) lookup(appName string) (app, error) {
+	a, ok := inv.apps[appName]
+	if !ok {
+		return app{}, errors.Errorf("app %s not in inventory", appName)
+	}
+	return a, nil
+}
+
+// cloudProvider returns the cloud provider of an account
+func (a account) cloudProvider() string {
+	if a.CloudProvider == "" {
+		return defaultCloudProvider
+	}
+	return a.CloudProvider
+}
+
+// Apps implements deploy.Deployment.Apps
+func (inv Inventory) Apps(c chan<- *D.App, appNames []string) {
+	// Close the channel we're done
+	defer close(c)
+
+	for _, appName := range appNames {
+		app, err := inv.GetApp(appName)
+		if err != nil {
+			// If we have a problem with one app, we go to the next one
+			log.Printf("WARNING: GetApp failed for %s: %v", appName, err)
+			continue
+		}
+
+		c <- app
+	}
+}
+
+// GetApp implements deploy.Deployment.GetApp
+func (inv Inventory) GetApp(appName string) (*D.App, error) {
+	a, err := inv.lookup(appName)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(D.AppMap)
+	for accountName, acc := range a.Accounts {
+		clusters := make(D.ClusterMap)
+		for clusterName, regions := range acc.Clusters {
+			clusters[D.ClusterName(clusterName)] = make(map[D.RegionName]map[D.ASGName][]D.InstanceID)
+			for regionName, asgs := range regions {
+				m := make(map[D.ASGName][]D.InstanceID)
+				for asgName, ids := range asgs {
+					m[D.ASGName(asgName)] = instanceIDs(ids)
+				}
+				clusters[D.ClusterName(clusterName)][D.RegionName(regionName)] = m
+			}
+		}
+
+		data[D.AccountName(accountName)] = D.AccountInfo{
+			CloudProvider: acc.cloudProvider(),
+			Clusters:      clusters,
+		}
+	}
+
+	return D.NewApp(appName, data), nil
+}
+
+// instanceIDs converts instance ids from the inventory file
+func instanceIDs(ids []string) []D.InstanceID {
+	result := make([]D.InstanceID, len(ids))
+	for i, id := range ids {
+		result[i] = D.InstanceID(id)
+	}
+	return result
+}
+
+// AppNames implements deploy.Deployment.AppNames
+func (inv Inventory) AppNames() ([]string, error) {
+	result := make([]string, 0, len(inv.apps))
+	for name := range inv.apps {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// GetInstanceIDs implements deploy.Deployment.GetInstanceIDs
+// If a cluster has several server groups in the region, the last one in name
+// order is used, which is the newest one for app-stack-detail-vNNN names
+func (inv Inventory) GetInstanceIDs(app string, account D.AccountName, cloudProvider string, region D.RegionName, cluster D.ClusterName) (D.ASGName, []D.InstanceID, error) {
+	a, err := inv.lookup(app)
+	if err != nil {
+		return "", nil, err
+	}
+
+	asgs := a.Accounts[string(account)].Clusters[string(cluster)][string(region)]
+	if len(asgs) == 0 {
+		return "", nil, errors.Errorf("no server groups for app=%s account=%s cluster=%s region=%s", app, account, cluster, region)
+	}
+
+	var current string
+	for name := range asgs {
+		if name > current {
+			current = name
+		}
+	}
+
+	return D.ASGName(current), instanceIDs(asgs[current]), nil
+}
+
+// GetClusterNames implements deploy.Deployment.GetClusterNames
+func (inv Inventory) GetClusterNames(app string, account D.AccountName) ([]D.ClusterName, error) {
+	a, err := inv.lookup(app)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []D.ClusterName{}
+	for name := range a.Accounts[string(account)].Clusters {
+		result = append(result, D.ClusterName(name))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+
+	return result, nil
+}
+
+// GetRegionNames implements deploy.Deployment.GetRegionNames
+func (inv Inventory) GetRegionNames(app string, account D.AccountName, cluster D.ClusterName) ([]D.RegionName, error) {
+	a, err := inv.lookup(app)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []D.RegionName{}
+	for name := range a.Accounts[string(account)].Clusters[string(cluster)] {
+		result = append(result, D.RegionName(name))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+
+	return result, nil
+}
+
+// CloudProvider implements deploy.Deployment.CloudProvider
+func (inv Inventory) CloudProvider(accountName string) (string, error) {
+	for _, a := range inv.apps {
+		if acc, ok := a.Accounts[accountName]; ok {
+			return acc.cloudProvider(), nil
+		}
+	}
+
+	return "", errors.Errorf("account %s not in inventory", accountName)
+}
+
+// Execute implements chaosmonkey.Terminator.Execute
+// Instances in an inventory file can't be terminated, so Chaos Monkey must
+// run leashed when using an inventory
+func (inv Inventory) Execute(trm chaosmonkey.Termination) error {
+	return errors.Errorf("cannot terminate %s: instances from an inventory can only be terminated leashed", trm.Instance.ID())
+}
+
+// Get implements chaosmonkey.AppConfigGetter.Get
+func (inv Inventory) Get(appName string) (*chaosmonkey.AppConfig, error) {
+	a, err := inv.lookup(appName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reuse the Spinnaker parser so that the inventory accepts exactly what
+	// Spinnaker does
+	cm, err := jsonCompatible(a.ChaosMonkey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid chaosMonkey config for app %s", appName)
+	}
+
+	js, err := json.Marshal(map[string]interface{}{
+		"name":       appName,
+		"attributes": map[string]interface{}{"chaosMonkey": cm},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid chaosMonkey config for app %s", appName)
+	}
+
+	return spinnaker.FromJSON(js)
+}
+
+// jsonCompatible converts the maps produced by the YAML parser, whose keys
+// are interface{}, into maps that can be encoded as JSON
+func jsonCompatible(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			s, ok := key.(string)
+			if !ok {
+				return nil, errors.Errorf("non-string key: %v", key)
+			}
+
+			converted, err := jsonCompatible(val)
+			if err != nil {
+				return nil, err
+			}
+			result[s] = converted
+		}
+		return result, nil
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, val := range v {
+			converted, err := jsonCompatible(val)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = converted
+		}
+		return result, nil
+	default:
+		return v, nil
+	}
+}
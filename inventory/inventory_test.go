@@ -0,0 +1,361 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	D "github.com/Netflix/chaosmonkey/v2/deploy"
+	"github.com/Netflix/chaosmonkey/v2/eligible"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/mock"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+	"github.com/Netflix/chaosmonkey/v2/term"
+)
+
+const inventoryYAML = `
+apps:
+  foo:
+    chaosMonkey:
+      enabled: true
+      meanTimeBetweenKillsInWorkDays: 1
+      minTimeBetweenKillsInWorkDays: 1
+      grouping: cluster
+      regionsAreIndependent: true
+      exceptions:
+        - {account: test, stack: "*", detail: "*", region: "*"}
+    accounts:
+      prod:
+        cloudProvider: aws
+        clusters:
+          foo-prod:
+            us-east-1:
+              foo-prod-v001: [i-00000001]
+              foo-prod-v002: [i-00000002, i-00000003]
+            us-west-2:
+              foo-prod-v002: [i-00000004]
+          foo-staging:
+            us-east-1:
+              foo-staging-v010: [i-00000005]
+      test:
+        clusters:
+          foo-test:
+            us-east-1:
+              foo-test-v001: [i-00000006]
+  bar:
+    chaosMonkey:
+      enabled: false
+    accounts:
+      prod:
+        clusters:
+          bar:
+            us-east-1:
+              bar-v001: [i-0000000b]
+  baz:
+    accounts:
+      prod:
+        clusters:
+          baz:
+            us-east-1:
+              baz-v001: [i-0000000c]
+`
+
+const inventoryJSON = `{
+  "apps": {
+    "foo": {
+      "chaosMonkey": {
+        "enabled": true,
+        "meanTimeBetweenKillsInWorkDays": 5,
+        "minTimeBetweenKillsInWorkDays": 1,
+        "grouping": "app"
+      },
+      "accounts": {
+        "prod": {
+          "cloudProvider": "titus",
+          "clusters": {"foo": {"us-east-1": {"foo-v001": ["i-00000001"]}}}
+        }
+      }
+    }
+  }
+}`
+
+// writeInventory writes an inventory to a temporary file and returns it
+func writeInventory(t *testing.T, name string, contents string) Inventory {
+	dir, err := ioutil.TempDir("", "cm-inventory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	inv, err := New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return inv
+}
+
+func TestAppNames(t *testing.T) {
+	inv := writeInventory(t, "inventory.yaml", inventoryYAML)
+
+	names, err := inv.AppNames()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := names, []string{"bar", "baz", "foo"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got AppNames()=%v, want %v", got, want)
+	}
+}
+
+func TestGetApp(t *testing.T) {
+	inv := writeInventory(t, "inventory.yaml", inventoryYAML)
+
+	app, err := inv.GetApp("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, account := range app.Accounts() {
+		for _, cluster := range account.Clusters() {
+			for _, asg := range cluster.ASGs() {
+				for _, ins := range asg.Instances() {
+					got = append(got, account.Name()+"/"+account.CloudProvider()+"/"+asg.RegionName()+"/"+asg.Name()+"/"+ins.ID())
+				}
+			}
+		}
+	}
+	sort.Strings(got)
+
+	want := []string{
+		"prod/aws/us-east-1/foo-prod-v001/i-00000001",
+		"prod/aws/us-east-1/foo-prod-v002/i-00000002",
+		"prod/aws/us-east-1/foo-prod-v002/i-00000003",
+		"prod/aws/us-east-1/foo-staging-v010/i-00000005",
+		"prod/aws/us-west-2/foo-prod-v002/i-00000004",
+		"test/aws/us-east-1/foo-test-v001/i-00000006",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	_, err = inv.GetApp("missing")
+	if err == nil {
+		t.Error("expected error for app not in inventory")
+	}
+}
+
+func TestGetInstanceIDs(t *testing.T) {
+	inv := writeInventory(t, "inventory.yaml", inventoryYAML)
+
+	asg, ids, err := inv.GetInstanceIDs("foo", "prod", "aws", "us-east-1", "foo-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := asg, D.ASGName("foo-prod-v002"); got != want {
+		t.Errorf("got asg=%s, want %s", got, want)
+	}
+
+	if got, want := ids, []D.InstanceID{"i-00000002", "i-00000003"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got ids=%v, want %v", got, want)
+	}
+
+	_, _, err = inv.GetInstanceIDs("foo", "prod", "aws", "eu-west-1", "foo-prod")
+	if err == nil {
+		t.Error("expected error for region without server groups")
+	}
+}
+
+func TestRegionsAndClusters(t *testing.T) {
+	inv := writeInventory(t, "inventory.yaml", inventoryYAML)
+
+	clusters, err := inv.GetClusterNames("foo", "prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := clusters, []D.ClusterName{"foo-prod", "foo-staging"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got GetClusterNames()=%v, want %v", got, want)
+	}
+
+	regions, err := inv.GetRegionNames("foo", "prod", "foo-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := regions, []D.RegionName{"us-east-1", "us-west-2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got GetRegionNames()=%v, want %v", got, want)
+	}
+
+	provider, err := inv.CloudProvider("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := provider, "aws"; got != want {
+		t.Errorf("got CloudProvider(test)=%s, want %s", got, want)
+	}
+
+	_, err = inv.CloudProvider("missing")
+	if err == nil {
+		t.Error("expected error for account not in inventory")
+	}
+}
+
+func TestGet(t *testing.T) {
+	inv := writeInventory(t, "inventory.yaml", inventoryYAML)
+
+	cfg, err := inv.Get("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := chaosmonkey.AppConfig{
+		Enabled:                        true,
+		RegionsAreIndependent:          true,
+		MeanTimeBetweenKillsInWorkDays: 1,
+		MinTimeBetweenKillsInWorkDays:  1,
+		Grouping:                       chaosmonkey.Cluster,
+		Exceptions:                     []chaosmonkey.Exception{{Account: "test", Stack: "*", Detail: "*", Region: "*"}},
+	}
+
+	if got := *cfg; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	cfg, err = inv.Get("bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Enabled {
+		t.Error("got enabled config for disabled app")
+	}
+
+	// Same as Spinnaker: the chaosMonkey config is required
+	_, err = inv.Get("baz")
+	if err == nil {
+		t.Error("expected error for app without chaosMonkey config")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	inv := writeInventory(t, "inventory.json", inventoryJSON)
+
+	cfg, err := inv.Get("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := cfg.Grouping, chaosmonkey.App; got != want {
+		t.Errorf("got grouping=%s, want %s", got, want)
+	}
+
+	provider, err := inv.CloudProvider("prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := provider, "titus"; got != want {
+		t.Errorf("got CloudProvider(prod)=%s, want %s", got, want)
+	}
+}
+
+func TestEligibleInstances(t *testing.T) {
+	inv := writeInventory(t, "inventory.yaml", inventoryYAML)
+
+	instances, err := eligible.Instances(grp.New("foo", "prod", "us-east-1", "", "foo-prod"), nil, inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, ins := range instances {
+		ids = append(ids, ins.ID())
+	}
+	sort.Strings(ids)
+
+	if got, want := ids, []string{"i-00000002", "i-00000003"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	inv := writeInventory(t, "inventory.yaml", inventoryYAML)
+	cfg := mock.Deps().MonkeyCfg
+
+	s := schedule.New()
+	err := s.Populate(inv, inv, cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// foo has a mean time between kills of 1 day, so every eligible cluster
+	// is scheduled. bar is disabled and baz has no config.
+	var got []string
+	for _, e := range s.Entries() {
+		got = append(got, grp.String(e.Group))
+	}
+	sort.Strings(got)
+
+	want := []string{
+		"app=foo account=prod region=us-east-1 cluster=foo-prod",
+		"app=foo account=prod region=us-east-1 cluster=foo-staging",
+		"app=foo account=prod region=us-west-2 cluster=foo-prod",
+		"app=foo account=test region=us-east-1 cluster=foo-test",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestTerminate(t *testing.T) {
+	inv := writeInventory(t, "inventory.yaml", inventoryYAML)
+
+	d := mock.Deps()
+	d.Dep = inv
+	d.T = inv
+	d.ConfGetter = inv
+
+	// Leashed terminations work end to end
+	d.MonkeyCfg.Set(param.Leashed, true)
+	err := term.Terminate(d, "foo", "prod", "us-east-1", "", "foo-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Inventory instances can't actually be terminated
+	d.MonkeyCfg.Set(param.Leashed, false)
+	err = term.Terminate(d, "foo", "prod", "us-east-1", "", "foo-prod")
+	if err == nil {
+		t.Error("expected error when terminating unleashed")
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+)
+
+// Workload annotations that hold the Chaos Monkey configuration of an app.
+// They mirror the fields of the Spinnaker chaosMonkey app attribute. Fields
+// that aren't annotated take the configured defaults.
+//
+// Example:
+//
+//	chaosmonkey.netflix.com/enabled                              "true"
+//	chaosmonkey.netflix.com/mean_time_between_kills_in_work_days "5"
+//	chaosmonkey.netflix.com/min_time_between_kills_in_work_days  "1"
+//	chaosmonkey.netflix.com/grouping                             "cluster"
+//	chaosmonkey.netflix.com/regions_are_independent              "true"
+//	chaosmonkey.netflix.com/exceptions                           '[{"account": "kubernetes", "stack": "*", "detail": "*", "region": "staging"}]'
+//	chaosmonkey.netflix.com/notification_channel                 "#foo-team"
+//	chaosmonkey.netflix.com/kill_windows                         '[{"startHour": 10, "endHour": 12}]'
+//	chaosmonkey.netflix.com/time_zone                            "Europe/Dublin"
+const (
+	annotationPrefix                = "chaosmonkey.netflix.com/"
+	annotationEnabled               = annotationPrefix + "enabled"
+	annotationMeanTimeBetweenKills  = annotationPrefix + "mean_time_between_kills_in_work_days"
+	annotationMinTimeBetweenKills   = annotationPrefix + "min_time_between_kills_in_work_days"
+	annotationGrouping              = annotationPrefix + "grouping"
+	annotationRegionsAreIndependent = annotationPrefix + "regions_are_independent"
+	annotationExceptions            = annotationPrefix + "exceptions"
+	annotationNotificationChannel   = annotationPrefix + "notification_channel"
+	annotationKillWindows           = annotationPrefix + "kill_windows"
+	annotationTimeZone              = annotationPrefix + "time_zone"
+)
+
+// defaultAppConfig returns the configuration used when none is configured:
+// disabled, with the defaults of Spinnaker otherwise
+func defaultAppConfig() chaosmonkey.AppConfig {
+	cfg := chaosmonkey.NewAppConfig(nil)
+	cfg.Enabled = false
+	cfg.MinTimeBetweenKillsInWorkDays = 1
+	return cfg
+}
+
+// parseGrouping returns the grouping named s: "app", "stack" or "cluster"
+func parseGrouping(s string) (chaosmonkey.Group, error) {
+	switch s {
+	case "app":
+		return chaosmonkey.App, nil
+	case "stack":
+		return chaosmonkey.Stack, nil
+	case "cluster":
+		return chaosmonkey.Cluster, nil
+	default:
+		return 0, errors.Errorf("unknown grouping: %s", s)
+	}
+}
+
+// Get implements chaosmonkey.AppConfigGetter.Get
+//
+// The configuration is read from the annotations of the app's workloads.
+// Every workload that has Chaos Monkey annotations must have the same
+// configuration. If none of them do, the app gets the default configuration.
+func (k Kubernetes) Get(app string) (*chaosmonkey.AppConfig, error) {
+	wls, err := k.workloads(app)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wls) == 0 {
+		return nil, errors.Errorf("no workloads found for app %s", app)
+	}
+
+	var result *chaosmonkey.AppConfig
+	var source string
+
+	for _, wl := range wls {
+		if !hasConfigAnnotations(wl) {
+			continue
+		}
+
+		cfg, err := fromAnnotations(wl, k.defaults)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid chaos monkey annotations on %s %s/%s", wl.Kind, wl.Metadata.Namespace, wl.Metadata.Name)
+		}
+
+		if result == nil {
+			result = cfg
+			source = wl.Metadata.Namespace + "/" + wl.Metadata.Name
+			continue
+		}
+
+		if !reflect.DeepEqual(cfg, result) {
+			return nil, errors.Errorf("chaos monkey annotations on %s/%s conflict with annotations on %s", wl.Metadata.Namespace, wl.Metadata.Name, source)
+		}
+	}
+
+	if result == nil {
+		cfg := k.defaults
+		return &cfg, nil
+	}
+
+	return result, nil
+}
+
+// hasConfigAnnotations returns true if a workload has any Chaos Monkey
+// annotations
+func hasConfigAnnotations(wl workload) bool {
+	for key := range wl.Metadata.Annotations {
+		if strings.HasPrefix(key, annotationPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// fromAnnotations returns the app config described by the annotations of a
+// workload, taking the fields that aren't annotated from defaults
+func fromAnnotations(wl workload, defaults chaosmonkey.AppConfig) (*chaosmonkey.AppConfig, error) {
+	cfg := defaults
+	annotations := wl.Metadata.Annotations
+	var err error
+
+	if val, ok := annotations[annotationEnabled]; ok {
+		cfg.Enabled, err = strconv.ParseBool(val)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", annotationEnabled)
+		}
+	}
+
+	if val, ok := annotations[annotationRegionsAreIndependent]; ok {
+		cfg.RegionsAreIndependent, err = strconv.ParseBool(val)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", annotationRegionsAreIndependent)
+		}
+	}
+
+	if val, ok := annotations[annotationNotificationChannel]; ok {
+		cfg.NotificationChannel = val
+	}
+
+	if val, ok := annotations[annotationMeanTimeBetweenKills]; ok {
+		cfg.MeanTimeBetweenKillsInWorkDays, err = strconv.Atoi(val)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", annotationMeanTimeBetweenKills)
+		}
+	}
+
+	if cfg.MeanTimeBetweenKillsInWorkDays <= 0 {
+		return nil, errors.Errorf("invalid %s: %d", annotationMeanTimeBetweenKills, cfg.MeanTimeBetweenKillsInWorkDays)
+	}
+
+	if val, ok := annotations[annotationMinTimeBetweenKills]; ok {
+		cfg.MinTimeBetweenKillsInWorkDays, err = strconv.Atoi(val)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", annotationMinTimeBetweenKills)
+		}
+	}
+
+	if val, ok := annotations[annotationGrouping]; ok {
+		cfg.Grouping, err = parseGrouping(val)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if val, ok := annotations[annotationExceptions]; ok {
+		cfg.Exceptions = nil
+		err = json.Unmarshal([]byte(val), &cfg.Exceptions)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", annotationExceptions)
+		}
+
+		// Exceptions must have a non-blank account and region field
+		for _, exception := range cfg.Exceptions {
+			if exception.Account == "" {
+				return nil, errors.New("missing account field in exception")
+			}
+
+			if exception.Region == "" {
+				return nil, errors.New("missing region field in exception")
+			}
+		}
+	}
+
+	if val, ok := annotations[annotationKillWindows]; ok {
+		cfg.KillWindows = nil
+		err = json.Unmarshal([]byte(val), &cfg.KillWindows)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", annotationKillWindows)
+		}
+
+		err = chaosmonkey.CheckKillWindows(cfg.KillWindows)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", annotationKillWindows)
+		}
+	}
+
+	if val, ok := annotations[annotationTimeZone]; ok {
+		cfg.TimeZone = val
+		_, err = cfg.Location(time.UTC)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", annotationTimeZone)
+		}
+	}
+
+	return &cfg, nil
+}
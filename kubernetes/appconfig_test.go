@@ -0,0 +1,150 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Netflix/chaosmonkey/v2"
+)
+
+func workloadWithAnnotations(annotations map[string]string) workload {
+	wl := newWorkload("Deployment", "default", "foo", map[string]string{"app": "foo"}, map[string]string{"app": "foo"})
+	wl.Metadata.Annotations = annotations
+	return wl
+}
+
+func TestFromAnnotations(t *testing.T) {
+	defaults := defaultAppConfig()
+
+	tests := []struct {
+		annotations map[string]string
+		want        chaosmonkey.AppConfig
+	}{
+		{
+			map[string]string{annotationEnabled: "false"},
+			defaults,
+		},
+		{
+			map[string]string{annotationEnabled: "true"},
+			chaosmonkey.AppConfig{
+				Enabled:                        true,
+				RegionsAreIndependent:          true,
+				MeanTimeBetweenKillsInWorkDays: 5,
+				MinTimeBetweenKillsInWorkDays:  1,
+				Grouping:                       chaosmonkey.Cluster,
+			},
+		},
+		{
+			map[string]string{
+				annotationEnabled:               "true",
+				annotationMeanTimeBetweenKills:  "3",
+				annotationMinTimeBetweenKills:   "2",
+				annotationRegionsAreIndependent: "false",
+				annotationGrouping:              "app",
+				annotationExceptions:            `[{"account": "kubernetes", "stack": "*", "detail": "*", "region": "staging"}]`,
+				annotationNotificationChannel:   "#foo-team",
+				annotationKillWindows:           `[{"startHour": 10, "endHour": 12}]`,
+				annotationTimeZone:              "Europe/Dublin",
+			},
+			chaosmonkey.AppConfig{
+				Enabled:                        true,
+				RegionsAreIndependent:          false,
+				MeanTimeBetweenKillsInWorkDays: 3,
+				MinTimeBetweenKillsInWorkDays:  2,
+				Grouping:                       chaosmonkey.App,
+				Exceptions:                     []chaosmonkey.Exception{{Account: "kubernetes", Stack: "*", Detail: "*", Region: "staging"}},
+				NotificationChannel:            "#foo-team",
+				KillWindows:                    []chaosmonkey.KillWindow{{StartHour: 10, EndHour: 12}},
+				TimeZone:                       "Europe/Dublin",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		cfg, err := fromAnnotations(workloadWithAnnotations(tt.annotations), defaults)
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", tt.annotations, err)
+			continue
+		}
+
+		if got, want := *cfg, tt.want; !reflect.DeepEqual(got, want) {
+			t.Errorf("%v: got %+v, want %+v", tt.annotations, got, want)
+		}
+	}
+}
+
+func TestFromAnnotationsInvalid(t *testing.T) {
+	tests := []map[string]string{
+		{annotationEnabled: "yes please"},
+		{annotationMeanTimeBetweenKills: "0"},
+		{annotationMinTimeBetweenKills: "one"},
+		{annotationGrouping: "region"},
+		{annotationExceptions: `[{"account": "kubernetes"}]`},
+		{annotationKillWindows: `[{"startHour": 12, "endHour": 10}]`},
+		{annotationTimeZone: "Mars/Olympus_Mons"},
+	}
+
+	for _, annotations := range tests {
+		_, err := fromAnnotations(workloadWithAnnotations(annotations), defaultAppConfig())
+		if err == nil {
+			t.Errorf("%v: expected error", annotations)
+		}
+	}
+}
+
+// Apps without annotations get the defaults, and the workloads of an app
+// must agree on its configuration
+func TestGetAppConfig(t *testing.T) {
+	cluster := fakeCluster()
+	k := newKubernetes(cluster, "k8s", "app", nil)
+	k.defaults.Enabled = true
+	k.defaults.Grouping = chaosmonkey.App
+
+	cfg, err := k.Get("bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := *cfg, k.defaults; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want the defaults %+v", got, want)
+	}
+
+	// Annotate foo in default, but not in staging
+	cluster.workloads[0].Metadata.Annotations = map[string]string{annotationMeanTimeBetweenKills: "2"}
+
+	cfg, err = k.Get("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := cfg.MeanTimeBetweenKillsInWorkDays, 2; got != want {
+		t.Errorf("got mean time between kills %d, want %d", got, want)
+	}
+	if got, want := cfg.Grouping, chaosmonkey.App; got != want {
+		t.Errorf("got grouping %s, want the default %s", got, want)
+	}
+
+	cluster.workloads[3].Metadata.Annotations = map[string]string{annotationMeanTimeBetweenKills: "3"}
+
+	_, err = k.Get("foo")
+	if err == nil {
+		t.Error("got no error for conflicting annotations")
+	}
+
+	_, err = k.Get("unknown")
+	if err == nil {
+		t.Error("got no error for an app without workloads")
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// workloadKinds are the kinds of workloads whose pods Chaos Monkey terminates,
+// mapped to their resource name in the apps/v1 API group
+var workloadKinds = []struct {
+	kind     string
+	resource string
+}{
+	{"Deployment", "deployments"},
+	{"StatefulSet", "statefulsets"},
+}
+
+type (
+	// client is the subset of the Kubernetes API used by Chaos Monkey
+	client interface {
+		// listWorkloads returns the Deployments and StatefulSets that match
+		// sel. If namespace is blank, all namespaces are searched.
+		listWorkloads(namespace string, sel labelSelector) ([]workload, error)
+
+		// listPods returns the pods in namespace that match sel
+		listPods(namespace string, sel labelSelector) ([]pod, error)
+
+		// deletePod deletes a pod
+		deletePod(namespace string, name string) error
+	}
+
+	// objectMeta is the metadata common to all Kubernetes objects
+	objectMeta struct {
+		Name              string            `json:"name"`
+		Namespace         string            `json:"namespace"`
+		Labels            map[string]string `json:"labels"`
+		Annotations       map[string]string `json:"annotations"`
+		DeletionTimestamp *string           `json:"deletionTimestamp"`
+	}
+
+	// labelSelector is a Kubernetes label selector, as used in the spec of
+	// workloads
+	labelSelector struct {
+		MatchLabels      map[string]string `json:"matchLabels"`
+		MatchExpressions []requirement     `json:"matchExpressions"`
+	}
+
+	// requirement is a set-based label selector requirement
+	requirement struct {
+		Key      string   `json:"key"`
+		Operator string   `json:"operator"`
+		Values   []string `json:"values"`
+	}
+
+	// workload is a Deployment or a StatefulSet
+	workload struct {
+		Kind     string     `json:"-"`
+		Metadata objectMeta `json:"metadata"`
+		Spec     struct {
+			Selector labelSelector `json:"selector"`
+		} `json:"spec"`
+	}
+
+	// pod is a Kubernetes pod
+	pod struct {
+		Metadata objectMeta `json:"metadata"`
+		Status   struct {
+			Phase string `json:"phase"`
+		} `json:"status"`
+	}
+
+	// restClient implements client using the Kubernetes REST API
+	restClient struct {
+		endpoint  string
+		tokenFile string
+		http      *http.Client
+	}
+)
+
+// Label selector operators
+// See: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#set-based-requirement
+const (
+	opIn           = "In"
+	opNotIn        = "NotIn"
+	opExists       = "Exists"
+	opDoesNotExist = "DoesNotExist"
+)
+
+// empty returns true if the selector has no requirements. An empty selector
+// matches everything
+func (s labelSelector) empty() bool {
+	return len(s.MatchLabels) == 0 && len(s.MatchExpressions) == 0
+}
+
+// String returns the selector in the format accepted by the labelSelector
+// query parameter, e.g.: "app=foo,tier in (web,api),!canary"
+func (s labelSelector) String() string {
+	var terms []string
+
+	keys := make([]string, 0, len(s.MatchLabels))
+	for key := range s.MatchLabels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		terms = append(terms, key+"="+s.MatchLabels[key])
+	}
+
+	for _, r := range s.MatchExpressions {
+		switch r.Operator {
+		case opIn:
+			terms = append(terms, fmt.Sprintf("%s in (%s)", r.Key, strings.Join(r.Values, ",")))
+		case opNotIn:
+			terms = append(terms, fmt.Sprintf("%s notin (%s)", r.Key, strings.Join(r.Values, ",")))
+		case opExists:
+			terms = append(terms, r.Key)
+		case opDoesNotExist:
+			terms = append(terms, "!"+r.Key)
+		}
+	}
+
+	return strings.Join(terms, ",")
+}
+
+// matches returns true if a set of labels satisfies the selector
+func (s labelSelector) matches(labels map[string]string) bool {
+	for key, val := range s.MatchLabels {
+		if labels[key] != val {
+			return false
+		}
+	}
+
+	for _, r := range s.MatchExpressions {
+		val, ok := labels[r.Key]
+		switch r.Operator {
+		case opIn:
+			if !ok || !contains(r.Values, val) {
+				return false
+			}
+		case opNotIn:
+			if ok && contains(r.Values, val) {
+				return false
+			}
+		case opExists:
+			if !ok {
+				return false
+			}
+		case opDoesNotExist:
+			if ok {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func contains(vals []string, s string) bool {
+	for _, val := range vals {
+		if val == s {
+			return true
+		}
+	}
+	return false
+}
+
+// newRESTClient returns a client for the API server at endpoint. If caCert is
+// not blank, it is the path to a PEM file used to verify the server
+// certificate. If tokenFile is not blank, it is the path to a file containing
+// a bearer token. The file is read on every request, since service account
+// tokens are rotated
+func newRESTClient(endpoint string, caCert string, tokenFile string) (*restClient, error) {
+	httpClient := new(http.Client)
+
+	if caCert != "" {
+		pem, err := ioutil.ReadFile(caCert)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read file %s", caCert)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates found in %s", caCert)
+		}
+
+		httpClient.Transport = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}
+	}
+
+	return &restClient{endpoint: strings.TrimSuffix(endpoint, "/"), tokenFile: tokenFile, http: httpClient}, nil
+}
+
+// do sends a request to the API server and decodes the response body into
+// result, if it is not nil
+func (c *restClient) do(method string, path string, query url.Values, result interface{}) (err error) {
+	u := c.endpoint + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(method, u, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create request for %s", u)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if c.tokenFile != "" {
+		token, err := ioutil.ReadFile(c.tokenFile)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read file %s", c.tokenFile)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "%s failed at %s", method, u)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = errors.Wrapf(cerr, "body close failed at %s", u)
+		}
+	}()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "body read failed at %s", u)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		// The API server returns a Status object on errors
+		var status struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &status) == nil && status.Message != "" {
+			return errors.Errorf("%s %s returned unexpected status code: %d, message: %s", method, u, resp.StatusCode, status.Message)
+		}
+		return errors.Errorf("%s %s returned unexpected status code: %d, body: %s", method, u, resp.StatusCode, body)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse json at %s", u)
+	}
+
+	return nil
+}
+
+// list retrieves all of the items of a collection, following continue tokens
+// if the API server paginates the response. The add function is called with
+// each page of the response.
+func (c *restClient) list(path string, sel labelSelector, add func(body []byte) error) error {
+	query := url.Values{}
+	if !sel.empty() {
+		query.Set("labelSelector", sel.String())
+	}
+
+	for {
+		var page json.RawMessage
+		err := c.do(http.MethodGet, path, query, &page)
+		if err != nil {
+			return err
+		}
+
+		err = add(page)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse list at %s", path)
+		}
+
+		var meta struct {
+			Metadata struct {
+				Continue string `json:"continue"`
+			} `json:"metadata"`
+		}
+
+		err = json.Unmarshal(page, &meta)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse list metadata at %s", path)
+		}
+
+		if meta.Metadata.Continue == "" {
+			return nil
+		}
+
+		query.Set("continue", meta.Metadata.Continue)
+	}
+}
+
+// collectionPath returns the API path for a collection of resources, which
+// spans all namespaces if namespace is blank
+func collectionPath(prefix string, namespace string, resource string) string {
+	if namespace == "" {
+		return fmt.Sprintf("%s/%s", prefix, resource)
+	}
+	return fmt.Sprintf("%s/namespaces/%s/%s", prefix, url.PathEscape(namespace), resource)
+}
+
+func (c *restClient) listWorkloads(namespace string, sel labelSelector) ([]workload, error) {
+	var result []workload
+
+	for _, wk := range workloadKinds {
+		kind := wk.kind
+		err := c.list(collectionPath("/apis/apps/v1", namespace, wk.resource), sel, func(body []byte) error {
+			var list struct {
+				Items []workload `json:"items"`
+			}
+
+			err := json.Unmarshal(body, &list)
+			if err != nil {
+				return err
+			}
+
+			for _, item := range list.Items {
+				item.Kind = kind
+				result = append(result, item)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (c *restClient) listPods(namespace string, sel labelSelector) ([]pod, error) {
+	var result []pod
+
+	err := c.list(collectionPath("/api/v1", namespace, "pods"), sel, func(body []byte) error {
+		var list struct {
+			Items []pod `json:"items"`
+		}
+
+		err := json.Unmarshal(body, &list)
+		if err != nil {
+			return err
+		}
+
+		result = append(result, list.Items...)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *restClient) deletePod(namespace string, name string) error {
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s", url.PathEscape(namespace), url.PathEscape(name))
+	return c.do(http.MethodDelete, path, nil, nil)
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSelectorString(t *testing.T) {
+	sel := labelSelector{
+		MatchLabels: map[string]string{"tier": "web", "app": "foo"},
+		MatchExpressions: []requirement{
+			{Key: "env", Operator: opIn, Values: []string{"prod", "test"}},
+			{Key: "track", Operator: opNotIn, Values: []string{"canary"}},
+			{Key: "owner", Operator: opExists},
+			{Key: "legacy", Operator: opDoesNotExist},
+		},
+	}
+
+	if got, want := sel.String(), "app=foo,tier=web,env in (prod,test),track notin (canary),owner,!legacy"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestRESTClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chaosmonkey-kubernetes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tokenFile := filepath.Join(dir, "token")
+	err = ioutil.WriteFile(tokenFile, []byte("s3cr3t\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var deleted string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("Authorization"), "Bearer s3cr3t"; got != want {
+			t.Errorf("got Authorization=%q, want %q", got, want)
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/apis/apps/v1/namespaces/default/deployments":
+			if got, want := r.URL.Query().Get("labelSelector"), "app=foo"; got != want {
+				t.Errorf("got labelSelector=%q, want %q", got, want)
+			}
+			// The response is split into two pages
+			if r.URL.Query().Get("continue") == "" {
+				fmt.Fprint(w, `{"metadata": {"continue": "next"}, "items": [{"metadata": {"name": "foo", "namespace": "default", "labels": {"app": "foo"}}, "spec": {"selector": {"matchLabels": {"app": "foo"}}}}]}`)
+			} else {
+				fmt.Fprint(w, `{"metadata": {}, "items": [{"metadata": {"name": "foo-canary", "namespace": "default", "labels": {"app": "foo"}}, "spec": {"selector": {"matchLabels": {"app": "foo", "track": "canary"}}}}]}`)
+			}
+		case r.Method == http.MethodGet && r.URL.Path == "/apis/apps/v1/namespaces/default/statefulsets":
+			fmt.Fprint(w, `{"metadata": {}, "items": [{"metadata": {"name": "foo-db", "namespace": "default", "labels": {"app": "foo"}}, "spec": {"selector": {"matchLabels": {"app": "foo", "tier": "db"}}}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/namespaces/default/pods":
+			fmt.Fprint(w, `{"metadata": {}, "items": [{"metadata": {"name": "foo-db-0", "namespace": "default"}, "status": {"phase": "Running"}}]}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/namespaces/default/pods/foo-db-0":
+			deleted = r.URL.Path
+			fmt.Fprint(w, `{"kind": "Pod"}`)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"kind": "Status", "message": "pods \"missing\" not found"}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := newRESTClient(ts.URL, "", tokenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wls, err := c.listWorkloads("default", labelSelector{MatchLabels: map[string]string{"app": "foo"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(wls), 3; got != want {
+		t.Fatalf("got len(wls)=%d, want %d", got, want)
+	}
+
+	for i, want := range []struct{ kind, name string }{{"Deployment", "foo"}, {"Deployment", "foo-canary"}, {"StatefulSet", "foo-db"}} {
+		if wls[i].Kind != want.kind || wls[i].Metadata.Name != want.name {
+			t.Errorf("got wls[%d]=%s %s, want %s %s", i, wls[i].Kind, wls[i].Metadata.Name, want.kind, want.name)
+		}
+	}
+
+	pods, err := c.listPods("default", wls[2].Spec.Selector)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(pods), 1; got != want {
+		t.Fatalf("got len(pods)=%d, want %d", got, want)
+	}
+
+	if got, want := pods[0].Status.Phase, podRunning; got != want {
+		t.Errorf("got phase=%s, want %s", got, want)
+	}
+
+	err = c.deletePod("default", "foo-db-0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := deleted, "/api/v1/namespaces/default/pods/foo-db-0"; got != want {
+		t.Errorf("got deleted=%s, want %s", got, want)
+	}
+
+	err = c.deletePod("default", "missing")
+	if err == nil {
+		t.Fatal("expected error deleting missing pod")
+	}
+}
@@ -0,0 +1,406 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubernetes provides a deploy.Deployment, a chaosmonkey.Terminator
+// and a chaosmonkey.AppConfigGetter backed by the Kubernetes API.
+//
+// Apps are identified by a label on Deployments and StatefulSets. Each of
+// these workloads is a cluster (and also the single ASG of that cluster), each
+// namespace is a region, and pods are instances. All of the workloads belong
+// to a single account.
+//
+// Workload names must follow the app-stack-detail naming convention, with the
+// app part equal to the value of the app label. Workloads that don't are
+// ignored.
+//
+// App configs are read from annotations on the workloads, see Get.
+package kubernetes
+
+import (
+	"log"
+	"os"
+	"sort"
+
+	"github.com/SmartThingsOSS/frigga-go"
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	D "github.com/Netflix/chaosmonkey/v2/deploy"
+)
+
+// CloudProvider is the cloud provider of the Kubernetes account
+const CloudProvider = "kubernetes"
+
+// podRunning is the phase of a pod that is running
+const podRunning = "Running"
+
+// In-cluster configuration, used when no endpoint is specified
+// See: https://kubernetes.io/docs/tasks/run-application/access-api-from-pod/
+const (
+	inClusterTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	inClusterCACert    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+)
+
+// Kubernetes implements the deploy.Deployment interface by querying the
+// Kubernetes API, and the chaosmonkey.Terminator interface by deleting pods
+type Kubernetes struct {
+	client     client
+	account    string
+	appLabel   string
+	namespaces []string
+
+	// defaults is the configuration of apps whose workloads don't have
+	// Chaos Monkey annotations
+	defaults chaosmonkey.AppConfig
+}
+
+// NewFromConfig returns a Kubernetes based on config. If no endpoint is
+// configured, the in-cluster configuration of the pod Chaos Monkey runs in
+// is used
+func NewFromConfig(cfg *config.Monkey) (Kubernetes, error) {
+	endpoint := cfg.KubernetesEndpoint()
+	caCert := cfg.KubernetesCACert()
+	tokenFile := cfg.KubernetesTokenFile()
+
+	if endpoint == "" {
+		host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+		if host == "" || port == "" {
+			return Kubernetes{}, errors.New("no kubernetes endpoint specified in config, and not running in a kubernetes cluster")
+		}
+
+		endpoint = "https://" + host + ":" + port
+
+		if caCert == "" {
+			caCert = inClusterCACert
+		}
+
+		if tokenFile == "" {
+			tokenFile = inClusterTokenFile
+		}
+	}
+
+	namespaces, err := cfg.KubernetesNamespaces()
+	if err != nil {
+		return Kubernetes{}, err
+	}
+
+	k, err := New(endpoint, caCert, tokenFile, cfg.KubernetesAccount(), cfg.KubernetesAppLabel(), namespaces)
+	if err != nil {
+		return Kubernetes{}, err
+	}
+
+	k.defaults.Enabled = cfg.KubernetesDefaultEnabled()
+	k.defaults.MeanTimeBetweenKillsInWorkDays = cfg.KubernetesDefaultMeanTimeBetweenKills()
+	k.defaults.MinTimeBetweenKillsInWorkDays = cfg.KubernetesDefaultMinTimeBetweenKills()
+	k.defaults.Grouping, err = parseGrouping(cfg.KubernetesDefaultGrouping())
+	if err != nil {
+		return Kubernetes{}, errors.Wrapf(err, "invalid %s", param.KubernetesDefaultGrouping)
+	}
+
+	return k, nil
+}
+
+// New returns a Kubernetes that talks to the API server at endpoint.
+//
+// caCert and tokenFile are optional paths to the CA certificate of the
+// API server and to a file that contains a bearer token.
+// All workloads are reported as belonging to account. Apps are identified by
+// the value of the appLabel label. If namespaces is empty, all namespaces
+// are searched.
+func New(endpoint string, caCert string, tokenFile string, account string, appLabel string, namespaces []string) (Kubernetes, error) {
+	c, err := newRESTClient(endpoint, caCert, tokenFile)
+	if err != nil {
+		return Kubernetes{}, err
+	}
+
+	return newKubernetes(c, account, appLabel, namespaces), nil
+}
+
+func newKubernetes(c client, account string, appLabel string, namespaces []string) Kubernetes {
+	if len(namespaces) == 0 {
+		// A blank namespace searches all of them
+		namespaces = []string{""}
+	}
+
+	return Kubernetes{client: c, account: account, appLabel: appLabel, namespaces: namespaces, defaults: defaultAppConfig()}
+}
+
+// appSelector returns a selector that matches the workloads of an app, or the
+// workloads of all apps if app is blank
+func (k Kubernetes) appSelector(app string) labelSelector {
+	if app == "" {
+		return labelSelector{MatchExpressions: []requirement{{Key: k.appLabel, Operator: opExists}}}
+	}
+
+	return labelSelector{MatchLabels: map[string]string{k.appLabel: app}}
+}
+
+// workloads returns the workloads associated with an app, or with all apps
+// if app is blank. Workloads that don't follow the naming convention are left
+// out.
+func (k Kubernetes) workloads(app string) ([]workload, error) {
+	var result []workload
+
+	for _, ns := range k.namespaces {
+		wls, err := k.client.listWorkloads(ns, k.appSelector(app))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list workloads for app %s", app)
+		}
+
+		for _, wl := range wls {
+			if !k.followsNamingConvention(wl) {
+				continue
+			}
+
+			result = append(result, wl)
+		}
+	}
+
+	return result, nil
+}
+
+// followsNamingConvention returns true if the app part of the name of the
+// workload is the app it is labelled with. Chaos Monkey derives the app from
+// the cluster name, so we can't handle workloads that don't.
+func (k Kubernetes) followsNamingConvention(wl workload) bool {
+	app := wl.Metadata.Labels[k.appLabel]
+	names, err := frigga.Parse(wl.Metadata.Name)
+	if err != nil || names.App != app {
+		log.Printf("WARNING: ignoring %s %s/%s: name does not start with the value of the %s label: %s", wl.Kind, wl.Metadata.Namespace, wl.Metadata.Name, k.appLabel, app)
+		return false
+	}
+
+	return true
+}
+
+// podNames returns the names of the running pods of a workload
+func (k Kubernetes) podNames(wl workload) ([]D.InstanceID, error) {
+	// A workload with an empty selector would match every pod in the namespace
+	if wl.Spec.Selector.empty() {
+		return nil, errors.Errorf("%s %s/%s has an empty selector", wl.Kind, wl.Metadata.Namespace, wl.Metadata.Name)
+	}
+
+	pods, err := k.client.listPods(wl.Metadata.Namespace, wl.Spec.Selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list pods for %s %s/%s", wl.Kind, wl.Metadata.Namespace, wl.Metadata.Name)
+	}
+
+	result := []D.InstanceID{}
+	for _, p := range pods {
+		// Pods that are starting up or already being deleted aren't
+		// candidates for termination
+		if p.Status.Phase != podRunning || p.Metadata.DeletionTimestamp != nil {
+			continue
+		}
+
+		result = append(result, D.InstanceID(p.Metadata.Name))
+	}
+
+	return result, nil
+}
+
+// Apps implements deploy.Deployment.Apps
+func (k Kubernetes) Apps(c chan<- *D.App, appNames []string) {
+	// Close the channel we're done
+	defer close(c)
+
+	for _, appName := range appNames {
+		app, err := k.GetApp(appName)
+		if err != nil {
+			// If we have a problem with one app, we go to the next one
+			log.Printf("WARNING: GetApp failed for %s: %v", appName, err)
+			continue
+		}
+
+		c <- app
+	}
+}
+
+// GetApp implements deploy.Deployment.GetApp
+func (k Kubernetes) GetApp(appName string) (*D.App, error) {
+	wls, err := k.workloads(appName)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make(D.ClusterMap)
+
+	for _, wl := range wls {
+		ids, err := k.podNames(wl)
+		if err != nil {
+			log.Printf("WARNING: could not retrieve pods for app:%s: %v", appName, err)
+			continue
+		}
+
+		cluster := D.ClusterName(wl.Metadata.Name)
+		region := D.RegionName(wl.Metadata.Namespace)
+		asg := D.ASGName(wl.Metadata.Name)
+
+		if _, ok := clusters[cluster]; !ok {
+			clusters[cluster] = make(map[D.RegionName]map[D.ASGName][]D.InstanceID)
+		}
+
+		if _, ok := clusters[cluster][region]; !ok {
+			clusters[cluster][region] = make(map[D.ASGName][]D.InstanceID)
+		}
+
+		clusters[cluster][region][asg] = append(clusters[cluster][region][asg], ids...)
+	}
+
+	data := D.AppMap{
+		D.AccountName(k.account): D.AccountInfo{
+			CloudProvider: CloudProvider,
+			Clusters:      clusters,
+		},
+	}
+
+	return D.NewApp(appName, data), nil
+}
+
+// AppNames implements deploy.Deployment.AppNames
+func (k Kubernetes) AppNames() ([]string, error) {
+	wls, err := k.workloads("")
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]bool)
+	for _, wl := range wls {
+		m[wl.Metadata.Labels[k.appLabel]] = true
+	}
+
+	result := make([]string, 0, len(m))
+	for name := range m {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// GetInstanceIDs implements deploy.Deployment.GetInstanceIDs
+// The ASG name of a cluster is always the name of the workload
+func (k Kubernetes) GetInstanceIDs(app string, account D.AccountName, cloudProvider string, region D.RegionName, cluster D.ClusterName) (D.ASGName, []D.InstanceID, error) {
+	if string(account) != k.account {
+		return "", nil, errors.Errorf("unknown kubernetes account: %s", account)
+	}
+
+	wls, err := k.workloads(app)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var found bool
+	result := []D.InstanceID{}
+	for _, wl := range wls {
+		if wl.Metadata.Namespace != string(region) || wl.Metadata.Name != string(cluster) {
+			continue
+		}
+
+		ids, err := k.podNames(wl)
+		if err != nil {
+			return "", nil, err
+		}
+
+		found = true
+		result = append(result, ids...)
+	}
+
+	if !found {
+		return "", nil, errors.Errorf("no workload named %s in namespace %s for app %s", cluster, region, app)
+	}
+
+	return D.ASGName(cluster), result, nil
+}
+
+// GetClusterNames implements deploy.Deployment.GetClusterNames
+func (k Kubernetes) GetClusterNames(app string, account D.AccountName) ([]D.ClusterName, error) {
+	if string(account) != k.account {
+		return nil, nil
+	}
+
+	wls, err := k.workloads(app)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[D.ClusterName]bool)
+	result := []D.ClusterName{}
+	for _, wl := range wls {
+		name := D.ClusterName(wl.Metadata.Name)
+		if !m[name] {
+			m[name] = true
+			result = append(result, name)
+		}
+	}
+
+	return result, nil
+}
+
+// GetRegionNames implements deploy.Deployment.GetRegionNames
+// The regions of a cluster are the namespaces its workloads are in
+func (k Kubernetes) GetRegionNames(app string, account D.AccountName, cluster D.ClusterName) ([]D.RegionName, error) {
+	if string(account) != k.account {
+		return nil, nil
+	}
+
+	wls, err := k.workloads(app)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[D.RegionName]bool)
+	result := []D.RegionName{}
+	for _, wl := range wls {
+		if wl.Metadata.Name != string(cluster) {
+			continue
+		}
+
+		region := D.RegionName(wl.Metadata.Namespace)
+		if !m[region] {
+			m[region] = true
+			result = append(result, region)
+		}
+	}
+
+	return result, nil
+}
+
+// CloudProvider implements deploy.Deployment.CloudProvider
+func (k Kubernetes) CloudProvider(account string) (string, error) {
+	if account != k.account {
+		return "", errors.Errorf("unknown kubernetes account: %s", account)
+	}
+
+	return CloudProvider, nil
+}
+
+// Execute implements chaosmonkey.Terminator.Execute by deleting the pod.
+// The pod's workload is responsible for replacing it.
+func (k Kubernetes) Execute(trm chaosmonkey.Termination) error {
+	ins := trm.Instance
+
+	if ins.AccountName() != k.account {
+		return errors.Errorf("unknown kubernetes account: %s", ins.AccountName())
+	}
+
+	err := k.client.deletePod(ins.RegionName(), ins.ID())
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete pod %s/%s", ins.RegionName(), ins.ID())
+	}
+
+	return nil
+}
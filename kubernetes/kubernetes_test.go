@@ -0,0 +1,322 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	D "github.com/Netflix/chaosmonkey/v2/deploy"
+	"github.com/Netflix/chaosmonkey/v2/eligible"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/mock"
+	"github.com/Netflix/chaosmonkey/v2/term"
+)
+
+// fakeClient is an in-memory Kubernetes API
+type fakeClient struct {
+	workloads []workload
+	pods      []pod
+	deleted   []string
+}
+
+func (f *fakeClient) listWorkloads(namespace string, sel labelSelector) ([]workload, error) {
+	var result []workload
+	for _, wl := range f.workloads {
+		if (namespace == "" || wl.Metadata.Namespace == namespace) && sel.matches(wl.Metadata.Labels) {
+			result = append(result, wl)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeClient) listPods(namespace string, sel labelSelector) ([]pod, error) {
+	var result []pod
+	for _, p := range f.pods {
+		if p.Metadata.Namespace == namespace && sel.matches(p.Metadata.Labels) {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeClient) deletePod(namespace string, name string) error {
+	f.deleted = append(f.deleted, namespace+"/"+name)
+	return nil
+}
+
+func newWorkload(kind, namespace, name string, labels map[string]string, selector map[string]string) workload {
+	wl := workload{Kind: kind, Metadata: objectMeta{Name: name, Namespace: namespace, Labels: labels}}
+	wl.Spec.Selector.MatchLabels = selector
+	return wl
+}
+
+func newPod(namespace, name, phase string, labels map[string]string) pod {
+	p := pod{Metadata: objectMeta{Name: name, Namespace: namespace, Labels: labels}}
+	p.Status.Phase = phase
+	return p
+}
+
+// fakeCluster returns a fake API with:
+//
+//	namespace "default": Deployment foo (2 running pods, 1 pending),
+//	                     Deployment foo-staging (1 pod),
+//	                     StatefulSet bar (1 pod)
+//	namespace "staging": Deployment foo (1 running pod, 1 being deleted)
+//	namespace "other":   Deployment unlabelled (1 pod),
+//	                     Deployment misnamed, labelled app=foo (1 pod)
+func fakeCluster() *fakeClient {
+	deleting := "2026-01-01T00:00:00Z"
+	beingDeleted := newPod("staging", "foo-7c9d-zzzzz", podRunning, map[string]string{"app": "foo", "track": "stable"})
+	beingDeleted.Metadata.DeletionTimestamp = &deleting
+
+	return &fakeClient{
+		workloads: []workload{
+			newWorkload("Deployment", "default", "foo", map[string]string{"app": "foo"}, map[string]string{"app": "foo", "track": "stable"}),
+			newWorkload("Deployment", "default", "foo-staging", map[string]string{"app": "foo"}, map[string]string{"app": "foo", "track": "staging"}),
+			newWorkload("StatefulSet", "default", "bar", map[string]string{"app": "bar"}, map[string]string{"app": "bar"}),
+			newWorkload("Deployment", "staging", "foo", map[string]string{"app": "foo"}, map[string]string{"app": "foo", "track": "stable"}),
+			newWorkload("Deployment", "other", "unlabelled", nil, map[string]string{"run": "unlabelled"}),
+			newWorkload("Deployment", "other", "misnamed", map[string]string{"app": "foo"}, map[string]string{"run": "misnamed"}),
+		},
+		pods: []pod{
+			newPod("default", "foo-5f6d-aaaaa", podRunning, map[string]string{"app": "foo", "track": "stable"}),
+			newPod("default", "foo-5f6d-bbbbb", podRunning, map[string]string{"app": "foo", "track": "stable"}),
+			newPod("default", "foo-5f6d-ccccc", "Pending", map[string]string{"app": "foo", "track": "stable"}),
+			newPod("default", "foo-staging-8b7f-ddddd", podRunning, map[string]string{"app": "foo", "track": "staging"}),
+			newPod("default", "bar-0", podRunning, map[string]string{"app": "bar"}),
+			newPod("staging", "foo-7c9d-eeeee", podRunning, map[string]string{"app": "foo", "track": "stable"}),
+			beingDeleted,
+			newPod("other", "unlabelled-1234-fffff", podRunning, map[string]string{"run": "unlabelled"}),
+			newPod("other", "misnamed-1234-ggggg", podRunning, map[string]string{"run": "misnamed"}),
+		},
+	}
+}
+
+// Apps whose workloads don't follow the naming convention are left out
+func TestAppNames(t *testing.T) {
+	c := fakeCluster()
+	c.workloads = append(c.workloads, newWorkload("Deployment", "other", "misnamed", map[string]string{"app": "qux"}, map[string]string{"run": "misnamed"}))
+	k := newKubernetes(c, "k8s", "app", nil)
+
+	names, err := k.AppNames()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := names, []string{"bar", "foo"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got AppNames()=%v, want %v", got, want)
+	}
+}
+
+func TestGetApp(t *testing.T) {
+	k := newKubernetes(fakeCluster(), "k8s", "app", nil)
+
+	app, err := k.GetApp("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(app.Accounts()), 1; got != want {
+		t.Fatalf("got len(app.Accounts())=%d, want %d", got, want)
+	}
+
+	account := app.Accounts()[0]
+	if got, want := account.Name(), "k8s"; got != want {
+		t.Errorf("got account.Name()=%s, want %s", got, want)
+	}
+
+	if got, want := account.CloudProvider(), CloudProvider; got != want {
+		t.Errorf("got account.CloudProvider()=%s, want %s", got, want)
+	}
+
+	// cluster -> region -> instance ids
+	got := make(map[string]map[string][]string)
+	for _, cluster := range account.Clusters() {
+		got[cluster.Name()] = make(map[string][]string)
+		for _, asg := range cluster.ASGs() {
+			if asg.Name() != cluster.Name() {
+				t.Errorf("got asg.Name()=%s, want %s", asg.Name(), cluster.Name())
+			}
+			var ids []string
+			for _, ins := range asg.Instances() {
+				ids = append(ids, ins.ID())
+			}
+			sort.Strings(ids)
+			got[cluster.Name()][asg.RegionName()] = ids
+		}
+	}
+
+	want := map[string]map[string][]string{
+		"foo": {
+			"default": {"foo-5f6d-aaaaa", "foo-5f6d-bbbbb"},
+			"staging": {"foo-7c9d-eeeee"},
+		},
+		"foo-staging": {
+			"default": {"foo-staging-8b7f-ddddd"},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got clusters %v, want %v", got, want)
+	}
+}
+
+func TestNamespaces(t *testing.T) {
+	k := newKubernetes(fakeCluster(), "k8s", "app", []string{"staging"})
+
+	regions, err := k.GetRegionNames("foo", "k8s", "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := regions, []D.RegionName{"staging"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got GetRegionNames()=%v, want %v", got, want)
+	}
+}
+
+func TestGetClusterNames(t *testing.T) {
+	k := newKubernetes(fakeCluster(), "k8s", "app", nil)
+
+	clusters, err := k.GetClusterNames("foo", "k8s")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := clusters, []D.ClusterName{"foo", "foo-staging"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got GetClusterNames()=%v, want %v", got, want)
+	}
+
+	clusters, err = k.GetClusterNames("foo", "prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(clusters), 0; got != want {
+		t.Errorf("got len(GetClusterNames()) for another account=%d, want %d", got, want)
+	}
+}
+
+func TestGetInstanceIDs(t *testing.T) {
+	k := newKubernetes(fakeCluster(), "k8s", "app", nil)
+
+	asg, ids, err := k.GetInstanceIDs("bar", "k8s", CloudProvider, "default", "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := asg, D.ASGName("bar"); got != want {
+		t.Errorf("got asg=%s, want %s", got, want)
+	}
+
+	if got, want := ids, []D.InstanceID{"bar-0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got ids=%v, want %v", got, want)
+	}
+
+	_, _, err = k.GetInstanceIDs("bar", "k8s", CloudProvider, "staging", "bar")
+	if err == nil {
+		t.Error("expected error for workload in wrong namespace")
+	}
+}
+
+func TestCloudProvider(t *testing.T) {
+	k := newKubernetes(fakeCluster(), "k8s", "app", nil)
+
+	provider, err := k.CloudProvider("k8s")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := provider, CloudProvider; got != want {
+		t.Errorf("got CloudProvider()=%s, want %s", got, want)
+	}
+
+	_, err = k.CloudProvider("prod")
+	if err == nil {
+		t.Error("expected error for unknown account")
+	}
+}
+
+// eligible.Instances and term.PickRandomInstance must work on top of the
+// Kubernetes deployment
+func TestEligibleInstances(t *testing.T) {
+	k := newKubernetes(fakeCluster(), "k8s", "app", nil)
+
+	tests := []struct {
+		group grp.InstanceGroup
+		want  []string
+	}{
+		{grp.New("foo", "k8s", "", "", ""), []string{"foo-5f6d-aaaaa", "foo-5f6d-bbbbb", "foo-7c9d-eeeee", "foo-staging-8b7f-ddddd"}},
+		{grp.New("foo", "k8s", "staging", "", ""), []string{"foo-7c9d-eeeee"}},
+		{grp.New("foo", "k8s", "", "staging", ""), []string{"foo-staging-8b7f-ddddd"}},
+		{grp.New("foo", "k8s", "default", "", "foo"), []string{"foo-5f6d-aaaaa", "foo-5f6d-bbbbb"}},
+	}
+
+	for _, tt := range tests {
+		instances, err := eligible.Instances(tt.group, nil, k)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var ids []string
+		for _, ins := range instances {
+			ids = append(ids, ins.ID())
+		}
+		sort.Strings(ids)
+
+		if got, want := ids, tt.want; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %v, want %v", tt.group, got, want)
+		}
+	}
+
+	cfg := chaosmonkey.AppConfig{Enabled: true, Grouping: chaosmonkey.Cluster, MeanTimeBetweenKillsInWorkDays: 5}
+	ins, ok := term.PickRandomInstance(grp.New("bar", "k8s", "default", "", "bar"), cfg, k)
+	if !ok {
+		t.Fatal("PickRandomInstance found no instance")
+	}
+
+	if got, want := ins.ID(), "bar-0"; got != want {
+		t.Errorf("got ins.ID()=%s, want %s", got, want)
+	}
+
+	if got, want := ins.RegionName(), "default"; got != want {
+		t.Errorf("got ins.RegionName()=%s, want %s", got, want)
+	}
+}
+
+func TestExecute(t *testing.T) {
+	f := fakeCluster()
+	k := newKubernetes(f, "k8s", "app", nil)
+
+	ins := mock.Instance{App: "bar", Account: "k8s", Region: "default", Cluster: "bar", ASG: "bar", InstanceID: "bar-0"}
+
+	err := k.Execute(chaosmonkey.Termination{Instance: ins, Time: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := f.deleted, []string{"default/bar-0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got deleted=%v, want %v", got, want)
+	}
+
+	ins.Account = "prod"
+	err = k.Execute(chaosmonkey.Termination{Instance: ins, Time: time.Now()})
+	if err == nil {
+		t.Error("expected error when terminating an instance in another account")
+	}
+}
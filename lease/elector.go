@@ -0,0 +1,153 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/clock"
+)
+
+// Elector campaigns for a lease and keeps renewing it while it is held
+type Elector struct {
+	store  Store
+	cl     clock.Clock
+	name   string
+	holder string
+	ttl    time.Duration
+
+	// stopped is closed once the lease of the last campaign is released or
+	// lost, nil before the first campaign
+	stopped chan struct{}
+
+	// after is replaced in tests
+	after func(time.Duration) <-chan time.Time
+}
+
+// NewElector returns an Elector that campaigns for the named lease on behalf
+// of holder. Leases are taken for ttl and renewed every third of ttl.
+func NewElector(store Store, cl clock.Clock, name string, holder string, ttl time.Duration) *Elector {
+	return &Elector{
+		store:  store,
+		cl:     cl,
+		name:   name,
+		holder: holder,
+		ttl:    ttl,
+		after:  time.After,
+	}
+}
+
+// Holder returns the name the elector campaigns under
+func (e *Elector) Holder() string {
+	return e.holder
+}
+
+// Campaign blocks until the lease is acquired or ctx is done, in which case
+// it returns ctx's error.
+//
+// Once acquired, the lease is renewed in the background. The returned
+// context is canceled as soon as the lease is lost, or may be about to
+// expire because it could not be renewed. When ctx is done, the lease is
+// released.
+func (e *Elector) Campaign(ctx context.Context) (context.Context, int64, error) {
+	for {
+		now := e.cl.Now()
+		l, err := e.store.Acquire(e.name, e.holder, now, e.ttl)
+		switch {
+		case err != nil:
+			log.Printf("WARNING: could not acquire lease %s: %v", e.name, err)
+		case l.Holder == e.holder:
+			log.Printf("acquired lease %s as %s, fencing token %d", e.name, e.holder, l.Token)
+			leaderCtx, cancel := context.WithCancel(ctx)
+			stopped := make(chan struct{})
+			e.stopped = stopped
+			go func() {
+				defer close(stopped)
+				e.renew(ctx, leaderCtx, cancel, l.Token, now)
+			}()
+			return leaderCtx, l.Token, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, 0, ctx.Err()
+		case <-e.after(e.ttl / 3):
+		}
+	}
+}
+
+// Wait waits up to timeout for the lease of the last campaign to be released
+// or lost. It returns false if it timed out.
+//
+// The returned context of Campaign is canceled as soon as ctx is, before the
+// lease is released, so callers that stop should wait for it.
+func (e *Elector) Wait(timeout time.Duration) bool {
+	if e.stopped == nil {
+		return true
+	}
+
+	select {
+	case <-e.stopped:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// renew renews the lease until ctx is done or the lease is lost
+func (e *Elector) renew(ctx context.Context, leaderCtx context.Context, cancel context.CancelFunc, token int64, renewed time.Time) {
+	defer cancel()
+
+	for {
+		select {
+		case <-leaderCtx.Done():
+			if ctx.Err() != nil {
+				e.release()
+			}
+			return
+		case <-e.after(e.ttl / 3):
+		}
+
+		now := e.cl.Now()
+		l, err := e.store.Acquire(e.name, e.holder, now, e.ttl)
+		switch {
+		case err != nil:
+			// Step down before the lease may expire, since another replica
+			// may take it over after that
+			if now.Sub(renewed) >= e.ttl-e.ttl/3 {
+				log.Printf("ERROR: could not renew lease %s, stepping down: %v", e.name, err)
+				return
+			}
+			log.Printf("WARNING: could not renew lease %s: %v", e.name, err)
+		case l.Holder != e.holder || l.Token != token:
+			log.Printf("lost lease %s to %s, fencing token %d", e.name, l.Holder, l.Token)
+			return
+		default:
+			renewed = now
+		}
+	}
+}
+
+// release releases the lease
+func (e *Elector) release() {
+	err := e.store.Release(e.name, e.holder, e.cl.Now())
+	if err != nil {
+		log.Printf("WARNING: could not release lease %s: %v", e.name, err)
+		return
+	}
+	log.Printf("released lease %s", e.name)
+}
@@ -0,0 +1,289 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// memStore is an in-memory Store
+type memStore struct {
+	mu       sync.Mutex
+	leases   map[string]Lease
+	fail     bool
+	released []string
+}
+
+func newMemStore() *memStore {
+	return &memStore{leases: make(map[string]Lease)}
+}
+
+func (s *memStore) Acquire(name string, holder string, now time.Time, ttl time.Duration) (Lease, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fail {
+		return Lease{}, errors.New("database unavailable")
+	}
+
+	var cur *Lease
+	if l, ok := s.leases[name]; ok {
+		cur = &l
+	}
+
+	l, _ := Claim(cur, name, holder, now, ttl)
+	s.leases[name] = l
+	return l, nil
+}
+
+func (s *memStore) Release(name string, holder string, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if l, ok := s.leases[name]; ok && l.Holder == holder {
+		l.Expires = now
+		s.leases[name] = l
+	}
+	s.released = append(s.released, holder)
+	return nil
+}
+
+func (s *memStore) set(l Lease) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.leases[l.Name] = l
+}
+
+func (s *memStore) setFail(fail bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fail = fail
+}
+
+// testClock is a clock that is safe to advance from the test goroutine
+type testClock struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = c.t.Add(d)
+}
+
+const ttl = 30 * time.Second
+
+// stepper drives the waits of an elector: each step lets the current wait
+// end, advancing the clock by its duration
+type stepper struct {
+	cl    *testClock
+	waits chan time.Duration
+	ticks chan time.Time
+}
+
+func newTestElector(store Store, cl *testClock, holder string) (*Elector, *stepper) {
+	s := &stepper{cl: cl, waits: make(chan time.Duration, 1), ticks: make(chan time.Time)}
+	e := NewElector(store, cl, "leader", holder, ttl)
+	e.after = func(d time.Duration) <-chan time.Time {
+		s.waits <- d
+		return s.ticks
+	}
+	return e, s
+}
+
+// wait blocks until the elector waits, which means it is done with whatever
+// it was doing
+func (s *stepper) wait() time.Duration {
+	select {
+	case d := <-s.waits:
+		return d
+	case <-time.After(5 * time.Second):
+		panic("elector did not wait")
+	}
+}
+
+// step lets the elector's next wait end
+func (s *stepper) step() {
+	s.cl.advance(s.wait())
+	s.ticks <- s.cl.Now()
+}
+
+func newTestClock() *testClock {
+	return &testClock{t: time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)}
+}
+
+// waitDone fails the test if ctx is not done in a reasonable time
+func waitDone(t *testing.T, ctx context.Context, desc string) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("%s: context not canceled", desc)
+	}
+}
+
+func TestCampaignWaitsForExpiry(t *testing.T) {
+	store := newMemStore()
+	cl := newTestClock()
+	store.set(Lease{"leader", "other", 5, cl.Now().Add(ttl)})
+
+	e, steps := newTestElector(store, cl, "me")
+
+	type result struct {
+		token int64
+		err   error
+	}
+	done := make(chan result)
+	go func() {
+		_, token, err := e.Campaign(context.Background())
+		done <- result{token, err}
+	}()
+
+	// The lease expires after three retries
+	for i := 0; i < 3; i++ {
+		steps.step()
+	}
+
+	r := <-done
+	if r.err != nil {
+		t.Fatal(r.err)
+	}
+
+	if got, want := r.token, int64(6); got != want {
+		t.Errorf("got token=%d, want %d", got, want)
+	}
+}
+
+func TestCampaignCanceled(t *testing.T) {
+	store := newMemStore()
+	cl := newTestClock()
+	store.set(Lease{"leader", "other", 5, cl.Now().Add(ttl)})
+
+	e, _ := newTestElector(store, cl, "me")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err := e.Campaign(ctx)
+	if err != context.Canceled {
+		t.Errorf("got err=%v, want %v", err, context.Canceled)
+	}
+}
+
+func TestLeaseLost(t *testing.T) {
+	store := newMemStore()
+	cl := newTestClock()
+
+	e, steps := newTestElector(store, cl, "me")
+
+	leaderCtx, token, err := e.Campaign(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Renewals keep the lease and the token
+	for i := 0; i < 5; i++ {
+		steps.step()
+	}
+	steps.wait()
+
+	if leaderCtx.Err() != nil {
+		t.Fatal("lost lease while renewing")
+	}
+
+	store.mu.Lock()
+	l := store.leases["leader"]
+	store.mu.Unlock()
+
+	if l.Token != token || !l.Expires.After(cl.Now().Add(ttl/2)) {
+		t.Errorf("got lease=%+v after renewals at %s, want token %d renewed", l, cl.Now(), token)
+	}
+
+	// Another replica took over, e.g. after a long pause of this process
+	store.set(Lease{"leader", "other", token + 1, cl.Now().Add(ttl)})
+	cl.advance(ttl / 3)
+	steps.ticks <- cl.Now()
+
+	waitDone(t, leaderCtx, "lease taken over")
+}
+
+func TestStepDownWhenRenewalFails(t *testing.T) {
+	store := newMemStore()
+	cl := newTestClock()
+
+	e, steps := newTestElector(store, cl, "me")
+
+	leaderCtx, _, err := e.Campaign(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store.setFail(true)
+
+	// One failed renewal is tolerated
+	steps.step()
+	steps.wait()
+	if leaderCtx.Err() != nil {
+		t.Fatal("stepped down after a single failed renewal")
+	}
+
+	// After two, the lease may expire before the next one
+	cl.advance(ttl / 3)
+	steps.ticks <- cl.Now()
+	waitDone(t, leaderCtx, "renewal failed")
+}
+
+func TestReleaseOnCancel(t *testing.T) {
+	store := newMemStore()
+	cl := newTestClock()
+
+	e, steps := newTestElector(store, cl, "me")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderCtx, _, err := e.Campaign(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps.wait()
+	cancel()
+	waitDone(t, leaderCtx, "parent canceled")
+
+	// The release happens in the renewal goroutine after the cancellation
+	if !e.Wait(5 * time.Second) {
+		t.Fatal("lease not released")
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if got, want := len(store.released), 1; got != want {
+		t.Fatalf("got %d releases, want %d", got, want)
+	}
+	if expires := store.leases["leader"].Expires; expires.After(cl.Now()) {
+		t.Errorf("got lease expiring at %s after release, want %s", expires, cl.Now())
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lease provides leader election between Chaos Monkey replicas,
+// using leases stored in the database.
+//
+// Only the holder of the lease schedules and executes terminations. Every
+// time the lease changes hands, its fencing token is incremented.
+// Terminations carry the token of the lease they were made under, and the
+// database refuses to record a termination whose token is no longer current,
+// so a replica that lost the lease without noticing can't terminate instances.
+package lease
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Name is the name of the lease held by the Chaos Monkey leader
+const Name = "chaosmonkey"
+
+// Lease is a time-limited claim on leadership
+type Lease struct {
+	Name    string
+	Holder  string
+	Token   int64     // fencing token, incremented whenever the lease changes hands
+	Expires time.Time // the lease is free after this time
+}
+
+// Store stores leases
+type Store interface {
+	// Acquire takes the named lease for holder until now+ttl if it is free,
+	// expired, or already held by holder, in which case it is renewed and
+	// keeps its token. It returns the lease as it is after the attempt:
+	// holder holds the lease if the returned Holder is holder.
+	Acquire(name string, holder string, now time.Time, ttl time.Duration) (Lease, error)
+
+	// Release gives up the named lease if it is held by holder, so that
+	// another replica can take over without waiting for it to expire
+	Release(name string, holder string, now time.Time) error
+}
+
+// ErrStaleToken is returned when recording a termination made under a lease
+// that has since changed hands
+type ErrStaleToken struct {
+	Token   int64 // token the termination was made under
+	Current int64 // current token of the lease
+}
+
+func (e ErrStaleToken) Error() string {
+	return fmt.Sprintf("stale fencing token %d, current token is %d", e.Token, e.Current)
+}
+
+// StaleToken returns true if the error is because of a stale fencing token
+func StaleToken(err error) bool {
+	_, ok := errors.Cause(err).(ErrStaleToken)
+	return ok
+}
+
+// Claim returns the lease that results from holder trying to acquire the
+// named lease at now, given its current state. cur is nil if the lease
+// doesn't exist yet. If cur is held by another holder and has not expired,
+// Claim returns cur and false.
+//
+// Stores call Claim inside a transaction to implement Acquire.
+func Claim(cur *Lease, name string, holder string, now time.Time, ttl time.Duration) (Lease, bool) {
+	expires := now.Add(ttl)
+
+	switch {
+	case cur == nil:
+		return Lease{Name: name, Holder: holder, Token: 1, Expires: expires}, true
+	case cur.Holder == holder:
+		// Renewal keeps the token
+		return Lease{Name: name, Holder: holder, Token: cur.Token, Expires: expires}, true
+	case !cur.Expires.After(now):
+		// Take over an expired lease
+		return Lease{Name: name, Holder: holder, Token: cur.Token + 1, Expires: expires}, true
+	default:
+		return *cur, false
+	}
+}
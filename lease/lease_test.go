@@ -0,0 +1,59 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestClaim(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	ttl := 30 * time.Second
+
+	tests := []struct {
+		desc   string
+		cur    *Lease
+		holder string
+		want   Lease
+		ok     bool
+	}{
+		{"new lease", nil, "a", Lease{"leader", "a", 1, now.Add(ttl)}, true},
+		{"renew", &Lease{"leader", "a", 3, now.Add(time.Second)}, "a", Lease{"leader", "a", 3, now.Add(ttl)}, true},
+		{"renew expired", &Lease{"leader", "a", 3, now.Add(-time.Minute)}, "a", Lease{"leader", "a", 3, now.Add(ttl)}, true},
+		{"held by other", &Lease{"leader", "b", 3, now.Add(time.Second)}, "a", Lease{"leader", "b", 3, now.Add(time.Second)}, false},
+		{"take over expired", &Lease{"leader", "b", 3, now.Add(-time.Second)}, "a", Lease{"leader", "a", 4, now.Add(ttl)}, true},
+		{"take over at expiry", &Lease{"leader", "b", 3, now}, "a", Lease{"leader", "a", 4, now.Add(ttl)}, true},
+	}
+
+	for _, tt := range tests {
+		got, ok := Claim(tt.cur, "leader", tt.holder, now, ttl)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("%s: got Claim()=(%+v, %t), want (%+v, %t)", tt.desc, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestStaleToken(t *testing.T) {
+	if !StaleToken(errors.Wrap(ErrStaleToken{Token: 1, Current: 2}, "check failed")) {
+		t.Error("got StaleToken()=false for a wrapped ErrStaleToken, want true")
+	}
+
+	if StaleToken(errors.New("other")) {
+		t.Error("got StaleToken()=true for another error, want false")
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Reasons for skipping a termination, used as the "reason" label of
+// TerminationsSkipped
+const (
+	ReasonDisabled            = "disabled"
+	ReasonOutage              = "outage"
+	ReasonAccountNotEnabled   = "account_not_enabled"
+	ReasonTestEnv             = "test_env"
+	ReasonAppDisabled         = "app_disabled"
+	ReasonWhitelist           = "whitelist"
+	ReasonSnoozed             = "snoozed"
+	ReasonNoEligibleInstances = "no_eligible_instances"
+	ReasonMinTimeViolation    = "min_time_violation"
+	ReasonError               = "error"
+)
+
+// Stages of terminations and schedule generation, used as the "stage" label
+// of StageDuration
+const (
+	StageTerminate    = "terminate"
+	StageOutageCheck  = "terminate.outage_check"
+	StageAppConfig    = "terminate.app_config"
+	StagePickInstance = "terminate.pick_instance"
+	StageMinTimeCheck = "terminate.min_time_check"
+	StageTrack        = "terminate.track"
+	StageKill         = "terminate.kill"
+	StagePopulate     = "schedule.populate"
+	StageAppNames     = "schedule.app_names"
+	StageScheduleApp  = "schedule.app"
+)
+
+// Default is the registry of the Chaos Monkey metrics below
+var Default = NewRegistry()
+
+var (
+	// TerminationsAttempted counts calls to terminate an instance of a group
+	TerminationsAttempted = Default.NewCounter("chaosmonkey_terminations_attempted_total",
+		"Number of attempts to terminate an instance.")
+
+	// TerminationsExecuted counts terminations that went through, by whether
+	// they were leashed
+	TerminationsExecuted = Default.NewCounter("chaosmonkey_terminations_executed_total",
+		"Number of instances terminated, or that would have been if leashed.", "leashed")
+
+	// TerminationsSkipped counts attempts that didn't terminate an instance,
+	// by reason
+	TerminationsSkipped = Default.NewCounter("chaosmonkey_terminations_skipped_total",
+		"Number of attempts that didn't terminate an instance, by reason.", "reason")
+
+	// ScheduleSize is the number of terminations in the most recent schedule
+	ScheduleSize = Default.NewGauge("chaosmonkey_schedule_terminations",
+		"Number of terminations in the most recent daily schedule.")
+
+	// ScheduleTimestamp is when the most recent schedule was generated
+	ScheduleTimestamp = Default.NewGauge("chaosmonkey_schedule_timestamp_seconds",
+		"Time the most recent daily schedule was generated, in seconds since the Unix epoch.")
+
+	// SpinnakerRequestDuration is the latency of Spinnaker API calls, by
+	// method and endpoint
+	SpinnakerRequestDuration = Default.NewHistogram("chaosmonkey_spinnaker_request_duration_seconds",
+		"Latency of Spinnaker API calls.", DefaultBuckets, "method", "endpoint")
+
+	// SpinnakerRequestErrors counts failed Spinnaker API calls, by method and
+	// endpoint. Calls fail if no response is received or its status is 5xx.
+	SpinnakerRequestErrors = Default.NewCounter("chaosmonkey_spinnaker_request_errors_total",
+		"Number of failed Spinnaker API calls.", "method", "endpoint")
+
+	// StageDuration is the latency of each stage of terminations and schedule
+	// generation
+	StageDuration = Default.NewHistogram("chaosmonkey_stage_duration_seconds",
+		"Latency of each stage of terminations and schedule generation.", DefaultBuckets, "stage")
+
+	// Errors counts errors reported through the error counter
+	Errors = Default.NewCounter("chaosmonkey_errors_total",
+		"Number of errors.")
+)
+
+// ErrorCounter implements chaosmonkey.ErrorCounter by incrementing Errors
+type ErrorCounter struct{}
+
+// Increment implements chaosmonkey.ErrorCounter.Increment
+func (ErrorCounter) Increment() error {
+	Errors.Inc()
+	return nil
+}
+
+// RecordAttempt records an attempt to terminate an instance
+func RecordAttempt(tags ...string) {
+	TerminationsAttempted.Inc()
+	eachSink(func(s Sink) { s.Count("terminations.attempted", 1, tags...) })
+}
+
+// RecordSkip records an attempt that didn't terminate an instance for reason
+func RecordSkip(reason string, tags ...string) {
+	TerminationsSkipped.Inc(reason)
+	tags = append(tags[:len(tags):len(tags)], "reason:"+reason)
+	eachSink(func(s Sink) { s.Count("terminations.skipped", 1, tags...) })
+}
+
+// RecordFailure records an attempt that failed with err, for reason
+func RecordFailure(reason string, err error, tags ...string) {
+	RecordSkip(reason, tags...)
+	eachSink(func(s Sink) { s.Event("Chaos Monkey termination failed", err.Error(), AlertError, tags...) })
+}
+
+// RecordTermination records the termination of instance, which was picked
+// from group
+func RecordTermination(leashed bool, instance string, group string, tags ...string) {
+	l := strconv.FormatBool(leashed)
+	TerminationsExecuted.Inc(l)
+
+	text := fmt.Sprintf("Chaos Monkey terminated instance %s of %s", instance, group)
+	if leashed {
+		text = fmt.Sprintf("Chaos Monkey would have terminated instance %s of %s, but it is leashed", instance, group)
+	}
+
+	tags = append(tags[:len(tags):len(tags)], "leashed:"+l)
+	eachSink(func(s Sink) {
+		s.Count("terminations.executed", 1, tags...)
+		s.Event("Chaos Monkey terminated an instance", text, AlertInfo, tags...)
+	})
+}
+
+// ObserveStage records the time since start as the duration of stage
+func ObserveStage(stage string, start time.Time, tags ...string) {
+	d := time.Since(start)
+	StageDuration.Observe(d.Seconds(), stage)
+	eachSink(func(s Sink) { s.Timing(stage, d, tags...) })
+}
+
+// RecordSchedule records the size of a schedule generated at t
+func RecordSchedule(size int, t time.Time) {
+	ScheduleSize.Set(float64(size))
+	ScheduleTimestamp.Set(float64(t.Unix()))
+
+	text := fmt.Sprintf("Chaos Monkey scheduled %d termination(s) for %s", size, t.Format("2006-01-02"))
+	eachSink(func(s Sink) {
+		s.Gauge("schedule.terminations", float64(size))
+		s.Event("Chaos Monkey generated a schedule", text, AlertInfo)
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// contentType is the content type of the Prometheus text format
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Handler returns a handler that serves the metrics of r, for use as the
+// /metrics endpoint
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var buf bytes.Buffer
+		err := r.Write(&buf)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		_, _ = buf.WriteTo(w)
+	})
+}
+
+// Push sends the metrics of r to the Pushgateway at endpoint, replacing the
+// metrics it holds for job and the grouping labels, given as name, value
+// pairs.
+//
+// See: https://github.com/prometheus/pushgateway#api
+func (r *Registry) Push(endpoint string, job string, grouping ...string) (err error) {
+	var buf bytes.Buffer
+	err = r.Write(&buf)
+	if err != nil {
+		return errors.Wrap(err, "could not write metrics")
+	}
+
+	u := strings.TrimSuffix(endpoint, "/") + "/metrics/job/" + url.PathEscape(job)
+	for i := 0; i+1 < len(grouping); i += 2 {
+		u += "/" + url.PathEscape(grouping[i]) + "/" + url.PathEscape(grouping[i+1])
+	}
+
+	req, err := http.NewRequest(http.MethodPut, u, &buf)
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	client := http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "http put failed at %s", u)
+	}
+
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = errors.Wrapf(cerr, "body close failed at %s", u)
+		}
+	}()
+
+	if resp.StatusCode/100 != 2 {
+		return errors.Errorf("unexpected response code (%d) from %s", resp.StatusCode, u)
+	}
+
+	return nil
+}
+
+// PushOnExit is meant to be deferred by short-lived commands. It pushes the
+// metrics of r if endpoint is not blank, and logs any failure.
+func (r *Registry) PushOnExit(endpoint string, job string, grouping ...string) {
+	if endpoint == "" {
+		return
+	}
+
+	err := r.Push(endpoint, job, grouping...)
+	if err != nil {
+		log.Printf("WARNING: could not push metrics: %v", err)
+	}
+}
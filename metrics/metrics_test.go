@@ -0,0 +1,133 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("test_total", "A counter.", "reason")
+	g := r.NewGauge("test_gauge", "A gauge\nwith two lines.")
+	h := r.NewHistogram("test_seconds", "A histogram.", []float64{0.1, 1}, "method")
+
+	c.Inc("b")
+	c.Add(2, `a "quoted"`)
+	g.Set(1.5)
+	h.Observe(0.05, "GET")
+	h.Observe(0.5, "GET")
+	h.Observe(3, "GET")
+
+	var buf bytes.Buffer
+	err := r.Write(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `# HELP test_total A counter.
+# TYPE test_total counter
+test_total{reason="a \"quoted\""} 2
+test_total{reason="b"} 1
+# HELP test_gauge A gauge\nwith two lines.
+# TYPE test_gauge gauge
+test_gauge 1.5
+# HELP test_seconds A histogram.
+# TYPE test_seconds histogram
+test_seconds_bucket{method="GET",le="0.1"} 1
+test_seconds_bucket{method="GET",le="1"} 2
+test_seconds_bucket{method="GET",le="+Inf"} 3
+test_seconds_sum{method="GET"} 3.55
+test_seconds_count{method="GET"} 3
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestLabelCountMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic with the wrong number of label values")
+		}
+	}()
+
+	NewRegistry().NewCounter("test_total", "A counter.", "reason").Inc()
+}
+
+func TestHandler(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounter("test_total", "A counter.").Inc()
+
+	ts := httptest.NewServer(r.Handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if !bytes.Contains(body, []byte("test_total 1\n")) {
+		t.Errorf("got %s, want test_total 1", body)
+	}
+
+	if got, want := resp.Header.Get("Content-Type"), contentType; got != want {
+		t.Errorf("got Content-Type %q, want %q", got, want)
+	}
+}
+
+func TestPush(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounter("test_total", "A counter.").Inc()
+
+	var method, path string
+	var body []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		method, path = req.Method, req.URL.Path
+		body, _ = io.ReadAll(req.Body)
+	}))
+	defer ts.Close()
+
+	err := r.Push(ts.URL+"/", "chaosmonkey", "command", "terminate")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := method+" "+path, "PUT /metrics/job/chaosmonkey/command/terminate"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	if !bytes.Contains(body, []byte("test_total 1\n")) {
+		t.Errorf("got %s, want test_total 1", body)
+	}
+}
+
+func TestPushFailure(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer ts.Close()
+
+	err := NewRegistry().Push(ts.URL, "chaosmonkey")
+	if err == nil {
+		t.Error("got no error")
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+// Alert types of events
+const (
+	AlertInfo    = "info"
+	AlertSuccess = "success"
+	AlertWarning = "warning"
+	AlertError   = "error"
+)
+
+// Sink receives Chaos Monkey metrics as they are recorded, to forward them to
+// a system other than Prometheus, e.g. StatsD. Tags are "name:value" pairs.
+//
+// Sinks must not block, since they are called inline by terminations and
+// schedule generation.
+type Sink interface {
+	// Count adds value to the counter name
+	Count(name string, value int64, tags ...string)
+
+	// Gauge sets the gauge name to value
+	Gauge(name string, value float64, tags ...string)
+
+	// Timing records a duration of name
+	Timing(name string, d time.Duration, tags ...string)
+
+	// Event records a notable occurrence, e.g. a termination
+	Event(title string, text string, alertType string, tags ...string)
+}
+
+var (
+	sinksMu sync.RWMutex
+	sinks   []Sink
+)
+
+// AddSink makes s receive the metrics recorded from now on
+func AddSink(s Sink) {
+	sinksMu.Lock()
+	defer sinksMu.Unlock()
+	sinks = append(sinks, s)
+}
+
+// eachSink calls f with every sink
+func eachSink(f func(s Sink)) {
+	sinksMu.RLock()
+	defer sinksMu.RUnlock()
+	for _, s := range sinks {
+		f(s)
+	}
+}
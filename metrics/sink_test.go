@@ -0,0 +1,74 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// recordingSink records the calls it receives as strings
+type recordingSink struct {
+	calls []string
+}
+
+func (r *recordingSink) Count(name string, value int64, tags ...string) {
+	r.calls = append(r.calls, fmt.Sprintf("count %s %d %v", name, value, tags))
+}
+
+func (r *recordingSink) Gauge(name string, value float64, tags ...string) {
+	r.calls = append(r.calls, fmt.Sprintf("gauge %s %v %v", name, value, tags))
+}
+
+func (r *recordingSink) Timing(name string, d time.Duration, tags ...string) {
+	r.calls = append(r.calls, fmt.Sprintf("timing %s %v", name, tags))
+}
+
+func (r *recordingSink) Event(title string, text string, alertType string, tags ...string) {
+	r.calls = append(r.calls, fmt.Sprintf("event %s: %s (%s) %v", title, text, alertType, tags))
+}
+
+func TestSink(t *testing.T) {
+	sink := &recordingSink{}
+	AddSink(sink)
+
+	tags := []string{"app:foo", "account:prod"}
+	RecordAttempt(tags...)
+	ObserveStage(StageKill, time.Now(), tags...)
+	RecordTermination(false, "i-1", "app=foo account=prod", tags...)
+	RecordFailure(ReasonError, errors.New("boom"), tags...)
+	RecordSchedule(3, time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC))
+
+	want := []string{
+		"count terminations.attempted 1 [app:foo account:prod]",
+		"timing terminate.kill [app:foo account:prod]",
+		"count terminations.executed 1 [app:foo account:prod leashed:false]",
+		"event Chaos Monkey terminated an instance: Chaos Monkey terminated instance i-1 of app=foo account=prod (info) [app:foo account:prod leashed:false]",
+		"count terminations.skipped 1 [app:foo account:prod reason:error]",
+		"event Chaos Monkey termination failed: boom (error) [app:foo account:prod]",
+		"gauge schedule.terminations 3 []",
+		"event Chaos Monkey generated a schedule: Chaos Monkey scheduled 3 termination(s) for 2026-10-19 (info) []",
+	}
+	if got := sink.calls; !reflect.DeepEqual(got, want) {
+		t.Errorf("got:\n%q\nwant:\n%q", got, want)
+	}
+
+	if got, want := StageDuration.Count(StageKill), uint64(1); got != want {
+		t.Errorf("got %d kill stage observations, want %d", got, want)
+	}
+}
@@ -1,6 +1,23 @@
 // Code generated by go-bindata.
 // sources:
 // migration/mysql/1.0.0_initial_schema.sql
+// migration/mysql/1.1.0_leases.sql
+// migration/mysql/1.2.0_schedule_seeds.sql
+// migration/mysql/1.3.0_schedule_amendments.sql
+// migration/mysql/1.4.0_snoozes.sql
+// migration/mysql/1.5.0_schedule_anchors.sql
+// migration/postgres/1.0.0_initial_schema.sql
+// migration/postgres/1.1.0_leases.sql
+// migration/postgres/1.2.0_schedule_seeds.sql
+// migration/postgres/1.3.0_schedule_amendments.sql
+// migration/postgres/1.4.0_snoozes.sql
+// migration/postgres/1.5.0_schedule_anchors.sql
+// migration/sqlite/1.0.0_initial_schema.sql
+// migration/sqlite/1.1.0_leases.sql
+// migration/sqlite/1.2.0_schedule_seeds.sql
+// migration/sqlite/1.3.0_schedule_amendments.sql
+// migration/sqlite/1.4.0_snoozes.sql
+// migration/sqlite/1.5.0_schedule_anchors.sql
 // DO NOT EDIT!
 
 package migration
@@ -88,6 +105,346 @@ func migrationMysql100_initial_schemaSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrationMysql110_leasesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x52\x4d\x6f\x82\x40\x10\xbd\xef\xaf\x98\x9b\x9a\x4a\x62\x9a\x78\x32\x3d\x20\xac\x2d\x29\xa2\xc5\xa5\xa9\x27\x43\x61\x94\x8d\xb8\x10\x58\x6b\x7f\x7e\x07\x56\xb4\x31\x6d\xd3\xb9\x6c\x76\x66\x5e\xde\x47\xc6\xb2\xe0\xee\x20\x77\x55\xac\x11\xa2\x92\x59\x16\xac\x5e\x7c\x90\x0a\x6a\x4c\xb4\x2c\x14\xf4\xa2\xb2\x07\xb2\x06\xfc\xc4\xe4\xa8\x31\x85\x53\x86\x0a\x74\x46\x2d\x83\x6b\x96\xe8\x13\x97\x65\x2e\x31\x65\x4e\xc8\x6d\xc1\x41\xd8\x53\x9f\x83\x37\x83\x60\x21\x80\xbf\x79\x2b\xb1\x82\x1c\xe3\x1a\x6b\xe8\x33\xa0\x52\xf1\x01\xa1\xab\x57\x3b\x74\x9e\xec\xb0\x7f\x3f\x1e\x0f\x5a\x44\x10\xf9\x3e\x2c\x43\x6f\x6e\x87\x6b\x78\xe6\xeb\x61\x8b\xc9\x8a\x3c\xc5\xea\x2f\x8c\xd9\xd3\xc5\x9e\x34\x9e\x6b\xea\x3d\x7a\x81\xb8\x6e\x98\x2e\x19\xdd\xa2\x4a\xa4\xda\x99\xed\x21\x79\x4e\x2a\x3c\xa0\xea\x3c\xe2\x07\x51\xe9\x0c\x8d\x6c\x48\xb2\x58\xed\x48\x3d\x3d\x69\xdd\xb2\xe0\x67\x29\x2b\xac\x37\xb1\xa6\x8f\x4b\xa6\x85\x37\xe7\x57\xf5\x67\x16\x2d\xc9\x27\xe5\x19\x09\xa7\x45\x0d\x18\x0f\x48\x10\x7f\xf0\x94\x2a\xdc\xe9\x84\xb1\x5b\x29\x50\x6c\x3b\xde\xc6\xad\xa1\x6f\x1a\x1a\xab\x83\x54\x26\xf1\x53\x4c\xf9\xd3\x1c\x8e\x8a\x96\x86\x30\x02\xb9\x05\x55\x28\x64\xb6\x2f\x78\x78\xce\xff\x1b\xa2\x06\xdb\x75\xc1\x59\xf8\xd1\x3c\xe8\xf8\x36\x86\xef\x26\x21\x70\xf9\xcc\x8e\x7c\x01\x23\x12\xd7\xa8\xbb\x1c\x88\x5b\x9c\x54\x77\x22\x97\xfb\x68\x9a\xff\xba\x90\xaa\xc8\x73\x9a\xbe\xc7\xc9\xfe\x77\x91\x6e\xb8\x58\xfe\xa8\x72\xc2\xda\x91\x81\x98\x4b\x9a\xb0\x2f\x16\x5a\x9e\x0a\xbe\x02\x00\x00")
+
+func migrationMysql110_leasesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationMysql110_leasesSql,
+		"migration/mysql/1.1.0_leases.sql",
+	)
+}
+
+func migrationMysql110_leasesSql() (*asset, error) {
+	bytes, err := migrationMysql110_leasesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/mysql/1.1.0_leases.sql", size: 702, mode: os.FileMode(420), modTime: time.Unix(1792203294, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationMysql120_schedule_seedsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x90\x41\x4e\xc3\x30\x10\x45\xf7\x3e\xc5\xdf\x15\x44\x73\x82\x8a\x45\x4b\x0c\x8a\x08\x69\x49\x13\x89\xae\xaa\x60\x4f\x89\xd5\xc4\x8e\x62\x97\x22\x4e\x8f\x9d\x92\x00\x3b\x66\xe7\xf1\xcc\xfb\xa3\x17\x45\xb8\x69\xd5\x5b\x5f\x39\x42\xd9\xb1\x28\xc2\xf6\x39\x85\xd2\xb0\x24\x9c\x32\x1a\xb3\xb2\x9b\x41\x59\xd0\x07\x89\x93\x23\x89\x73\x4d\x1a\xae\xf6\xad\xcb\x5e\x18\xf2\x8f\xaa\xeb\x1a\x45\x92\xdd\xe5\x7c\x59\x70\x14\xcb\x55\xca\x91\xdc\x23\x5b\x17\xe0\x2f\xc9\xb6\xd8\xc2\x8a\x9a\xe4\xa9\xa1\xbd\x25\x92\x16\x57\x0c\xbe\x64\x48\x1e\x2b\x0e\xab\x61\x23\x2b\xd3\x14\x9b\x3c\x79\x5a\xe6\x3b\x3c\xf2\xdd\x1c\xfe\xb2\x61\xd4\x1c\xe0\xa8\x6f\x95\xbe\x24\x8f\xcc\x79\xb8\xb9\x31\xa2\x6a\xe0\x54\x4b\xf8\x34\x9a\x06\x7e\xc8\x9a\xf8\xab\xe4\x21\xc9\x8a\x9f\x84\x5f\xe5\xf9\xc3\xa8\xab\x69\x82\xa2\xd2\xd2\x73\xad\xab\xb4\x20\x74\x4a\x1c\x2d\x24\xf5\xea\x9d\x70\xe8\x4d\x3b\xf0\xaf\x19\xcf\x3c\x94\xdf\x26\x5a\x9b\x78\xb5\x60\x2c\x48\x9c\x9c\xc6\xe6\xac\x47\xab\x93\xd2\xd0\xfc\x97\xd4\xde\x34\x8d\xff\x7d\xad\xc4\x91\xc5\xf9\x7a\xf3\xad\xf5\xaf\xc8\x05\xfb\x02\x02\x23\xcf\x6d\xc5\x01\x00\x00")
+
+func migrationMysql120_schedule_seedsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationMysql120_schedule_seedsSql,
+		"migration/mysql/1.2.0_schedule_seeds.sql",
+	)
+}
+
+func migrationMysql120_schedule_seedsSql() (*asset, error) {
+	bytes, err := migrationMysql120_schedule_seedsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/mysql/1.2.0_schedule_seeds.sql", size: 453, mode: os.FileMode(420), modTime: time.Unix(1792206286, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationMysql130_schedule_amendmentsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa5\x94\x51\x6f\xda\x30\x14\x85\xdf\xf3\x2b\xee\x5b\x41\x03\x89\x56\x62\x9b\x54\xed\x21\x25\xde\x16\x0d\x42\x17\x92\x89\x3e\x45\xc6\xbe\x03\xab\xc1\x8e\x12\x23\xda\xfd\xfa\xdd\x18\x92\x11\x68\xa5\x6a\xb5\x84\x04\xe6\xf8\xf3\xf1\x3d\xf6\x1d\x0e\xe1\xc3\x56\xad\x4b\x6e\x11\xd2\xc2\x1b\x0e\x61\xf1\x73\x0a\x4a\x43\x85\xc2\x2a\xa3\xe1\x2a\x2d\xae\x40\x55\x80\x4f\x28\x76\x16\x25\xec\x37\xa8\xc1\x6e\x68\xea\xb0\xae\x16\xd1\x0f\x5e\x14\xb9\x42\xe9\x4d\x62\xe6\x27\x0c\x12\xff\x6e\xca\x20\xfc\x0a\xd1\x3c\x01\xb6\x0c\x17\xc9\x02\x2a\xb1\x41\xb9\xcb\x31\xe3\x5b\xd4\x92\x3e\xb6\x82\x9e\x07\x34\x94\x84\x30\x4a\x9c\x36\x4a\xa7\x53\xf0\xd3\x64\x9e\x85\x11\xb1\x66\x8c\xe6\xef\xe3\x70\xe6\xc7\x0f\xf0\x83\x3d\x0c\x9c\x5e\xd6\x76\x9b\x11\xd4\xfb\x35\x4b\x07\xed\x34\x1d\xc5\xc9\xcc\x6f\xb0\x58\x6e\x95\x3e\x58\x6d\x4c\x0c\xea\x43\xe6\x46\xf0\x1c\xac\xda\x22\xfc\x31\x1a\x1d\xdb\x14\xf0\x6f\xfc\xf2\xe3\xc9\x77\x3f\xee\xdd\x8c\xfa\xa7\x5b\x10\x5b\x70\x2d\x30\x1f\x00\x97\x12\x4c\x09\x25\x36\x60\x07\xa1\x6a\x5c\x42\xc6\xd7\x37\x27\x94\x83\x4e\x08\xb3\xd3\xb6\xab\xbb\x1e\x8d\xce\x75\x25\xae\x6b\xf3\x67\x3c\x92\x9d\xb8\x22\x53\xbb\x0a\x61\x95\x73\xfd\x08\x95\x2d\x95\x5e\x83\x35\x74\x4c\xa9\x44\x5d\x08\x6d\x2c\x14\xe4\x93\xea\xee\x98\x95\xe5\xe2\xf1\xe2\xa0\xe3\x71\xff\x1d\x4c\x91\xef\x2a\xaa\x76\x97\xf9\xe9\xe3\xe7\xf7\x30\x5d\x3c\xa7\x61\x27\xe1\x8c\x5d\x86\xed\x64\x94\x69\x9a\x4c\x5c\xe6\x1b\x04\x22\x94\xcf\xc7\xa4\x72\x3c\xcb\x49\x3a\xb8\xc6\x7d\xd6\x6e\xf0\x1f\x70\x8a\xff\x65\xb0\xbb\xe3\x28\xb3\xd5\xf3\xab\xd5\x3d\x26\xcb\xab\x36\xd9\x84\x2d\x93\xf3\x1b\x72\xe4\x70\xdb\x31\xd8\xb9\xee\x5d\x83\x6e\x59\x18\x05\x6c\xe9\x1e\x40\x46\x85\xc5\x27\xe8\xd5\xdf\xfb\xee\xbf\xbe\xc7\xa2\x6f\x61\xc4\xbe\x84\x5a\x9b\xe0\xee\xd6\xf3\xea\x77\xdf\xb6\x81\xc0\xec\x75\xd3\x08\xda\x2e\x50\x4f\xbe\xa9\x0f\x94\xc6\x95\x7a\x45\x77\xcb\x0b\xe2\xf9\xfd\xb1\x13\xbc\xf0\xf6\x6f\xbd\xbf\x72\x6f\xa1\x9a\x7d\x04\x00\x00")
+
+func migrationMysql130_schedule_amendmentsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationMysql130_schedule_amendmentsSql,
+		"migration/mysql/1.3.0_schedule_amendments.sql",
+	)
+}
+
+func migrationMysql130_schedule_amendmentsSql() (*asset, error) {
+	bytes, err := migrationMysql130_schedule_amendmentsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/mysql/1.3.0_schedule_amendments.sql", size: 1149, mode: os.FileMode(420), modTime: time.Unix(1792206651, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationMysql140_snoozesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x52\x4d\x6f\xc2\x30\x0c\xbd\xe7\x57\xf8\x06\x68\xab\x04\x48\x6c\x93\xd0\x0e\x85\x66\x5b\xb4\x52\x58\x49\x27\x38\xa1\xd2\x66\x10\x51\x92\xaa\x49\x05\xdb\xaf\x5f\xfa\x29\xe0\xc4\xbb\xd9\x7e\x7e\xb1\x9d\x67\x59\xf0\x70\xe4\xbb\x2c\xd4\x0c\x82\x14\x59\x16\x2c\xbf\x5c\xe0\x02\x14\x8b\x34\x97\x02\x3a\x41\xda\x01\xae\x80\x9d\x59\x94\x6b\x16\xc3\x69\xcf\x04\xe8\xbd\x49\x55\x7d\x05\xc9\x04\x61\x9a\x26\x9c\xc5\x68\xea\x63\x9b\x62\xa0\xf6\xc4\xc5\x40\xde\xc0\x9b\x53\xc0\x2b\xb2\xa4\x4b\x50\x42\xca\x3f\xa6\xa0\x8b\xc0\x80\xc7\x40\x3c\x5a\xd6\xbd\xc0\x75\xc1\x0e\xe8\x7c\x43\x3c\xd3\x3f\xc3\x26\xbf\xf0\xc9\xcc\xf6\xd7\xf0\x89\xd7\x8f\x25\xdf\x3c\x00\x2d\xbe\x6d\x7f\xfa\x61\xfb\xdd\xd1\x60\xd8\x6b\x25\x6a\x5e\x14\xc9\x5c\xe8\x6b\xde\xa0\xdf\xbf\xe0\x81\x59\x33\x57\x0c\xb6\x49\x28\x0e\xa0\x74\xc6\xc5\x0e\x7e\x64\x06\x61\x92\x34\xfd\xaa\x14\x8b\x92\x5c\x69\x96\x5d\x8b\x3d\x3f\xbd\xdc\x2b\x56\xf7\x57\x62\xec\x9c\xf2\xcc\xec\x5f\xc2\x31\x57\xa2\x64\x86\x2f\x84\x0a\x18\x31\xcd\x8f\xac\xf8\x81\x80\x4e\xcd\x9d\x59\x7d\x36\x60\x22\xae\x64\xaa\x38\xde\x6c\x7f\x2f\x66\x1a\x8e\x46\xb7\x87\xc8\x58\xa8\xcc\xdf\x54\xa0\x78\x45\x6f\xea\x8d\x4e\xa8\xef\x1d\xa7\x6c\x23\x9e\x83\x57\xcd\x2e\x1b\x2e\x62\x76\x86\x6e\x1d\xf6\x4a\x46\x0f\x61\xef\x9d\x78\xf8\x95\x08\x21\x9d\xc9\x18\xa1\xc2\x57\xad\xcd\x1c\x79\x12\x8d\xd1\x5a\x97\x15\xc9\xbb\x7c\x96\xc9\x24\x31\xd5\x6d\x18\x1d\x90\xe3\xcf\x17\xb5\xd3\x6a\x6f\x8d\xd1\x3f\x65\x95\xd9\xf8\xd1\x02\x00\x00")
+
+func migrationMysql140_snoozesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationMysql140_snoozesSql,
+		"migration/mysql/1.4.0_snoozes.sql",
+	)
+}
+
+func migrationMysql140_snoozesSql() (*asset, error) {
+	bytes, err := migrationMysql140_snoozesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/mysql/1.4.0_snoozes.sql", size: 721, mode: os.FileMode(420), modTime: time.Unix(1792207014, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationMysql150_schedule_anchorsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x8f\xcd\x6e\xc3\x20\x10\x84\xef\x3c\xc5\xdc\x72\x88\xfc\x04\x39\xb9\xc1\x87\x48\xe4\xa7\x09\x9c\x2b\x82\x37\x31\x0a\x01\x64\xb0\xdc\xc7\x2f\xa8\x8a\xa5\x5c\xaa\x1e\x77\x76\x76\xe7\x9b\xa6\xc1\xfa\x69\xef\xa3\xce\x04\x15\x59\xd3\xe0\xf2\x29\x60\x3d\x12\x99\x6c\x83\xc7\x4a\xc5\x15\x6c\x02\x7d\x93\x99\x32\xf5\x98\x07\xf2\xc8\x43\x91\x7e\xef\xaa\xa9\x0c\x3a\x46\x67\xa9\x67\xad\x90\xdd\x19\xb2\xfd\x10\x1d\x92\x19\xa8\x9f\x1c\x7d\x25\xa2\x3e\xa1\xe5\x1c\xdb\xa3\x50\xfb\x03\xb4\x37\x43\x18\xc1\x5b\xd9\xc9\xdd\xbe\xc3\x41\x09\xb1\x41\x49\xcf\xf6\x49\x35\x5e\xc9\x6d\x09\xa1\xe5\x05\x66\x9d\x10\x43\x9c\x9c\xae\x10\xb7\x30\x32\x56\x69\x17\x78\x1e\x66\xff\xc2\x5f\xd8\xab\xf8\x2f\xfa\x31\x38\x57\xb6\x57\x6d\x1e\x7f\x35\xe0\xe7\xe3\xe9\xbd\xc2\x86\xfd\x00\xb9\x9b\x4e\xa7\x42\x01\x00\x00")
+
+func migrationMysql150_schedule_anchorsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationMysql150_schedule_anchorsSql,
+		"migration/mysql/1.5.0_schedule_anchors.sql",
+	)
+}
+
+func migrationMysql150_schedule_anchorsSql() (*asset, error) {
+	bytes, err := migrationMysql150_schedule_anchorsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/mysql/1.5.0_schedule_anchors.sql", size: 322, mode: os.FileMode(420), modTime: time.Unix(1792207938, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationPostgres100_initial_schemaSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x54\x4d\x6f\xda\x40\x10\xbd\xfb\x57\xcc\x2d\x41\x85\x2a\x89\x4a\x5b\x89\x93\xc1\x8b\x6a\xd5\x18\x6a\x9b\x2a\xe9\xc5\x72\xd6\x03\xac\xb0\x77\x91\x77\x51\x92\xfe\xfa\xee\xda\xf1\x17\x34\x2a\x55\x3b\xb7\xdd\x7d\x7e\xf3\x66\xe6\x79\x46\x23\x78\x97\xb3\x6d\x91\x28\x84\xf5\xc1\x1a\x8d\x20\xfc\xe6\x01\xe3\x20\x91\x2a\x26\x38\x5c\xad\x0f\x57\xc0\x24\xe0\x33\xd2\xa3\xc2\x14\x9e\x76\xc8\x41\xed\xf4\x55\xf5\x9d\x01\xe9\x43\x72\x38\x64\x0c\x53\x6b\x16\x10\x3b\x22\x10\xd9\x53\x8f\x80\x3b\x07\x7f\x19\x01\xb9\x77\xc3\x28\x04\x49\x77\x98\x1e\x33\x94\x70\x6d\x81\x0e\x96\x42\x48\x02\xd7\xf6\x60\x15\xb8\x0b\x3b\x78\x80\xaf\xe4\x61\x58\x3e\xa5\x46\x4f\x1d\x8e\x21\x34\x3c\xfe\xda\xf3\x86\xd0\x86\x56\x5b\x02\xc5\x06\x14\x16\x39\xe3\x95\x9a\x3a\xcf\xd0\xd4\x91\x09\x9a\x64\xa0\x58\x8e\xf0\x53\x70\x2c\xd9\xcb\x53\x1d\x91\xbb\x20\x61\x64\x2f\x56\xd1\x8f\x7e\x12\xcd\x5e\x02\xfb\xec\xef\x61\x8a\x34\x39\xca\xea\xde\xbc\xa7\x6c\xb3\xc1\x02\x39\xd5\x09\xf3\xe4\xe5\xf5\x0c\x9b\x42\xe4\xa5\xbc\x32\xa5\x6e\x4f\xab\xfb\xbb\x1d\xcc\xbe\xd8\xc1\xf5\xf8\xf6\x6e\xd0\xe6\xac\x70\x94\x8a\x23\x57\x7d\xdc\xed\xcd\xcd\x29\xae\xc0\xad\x29\xf5\x84\x4f\xc3\x3a\x35\xe8\x02\x8c\xce\xc7\x2c\xe1\x7b\x90\xaa\x60\x7c\x0b\x4a\xe8\xa6\xa4\x8c\x9a\xb6\x71\xa1\xe0\x50\xa0\x44\xae\x4a\x4e\xa9\x12\xba\x3f\xd5\x78\x37\x1e\x0f\xfe\x81\x93\x66\x47\xa9\xbb\xd7\xe7\xfc\xf4\xf1\x73\xcb\x09\x7f\xcd\x39\x98\x58\xb5\xcd\x5c\xdf\x21\xf7\x6f\xd9\x2c\x36\xdd\x8f\x35\x0d\x3e\xc3\xd2\xef\xda\xcf\x3c\x74\x58\x7e\x67\xd6\xce\xc8\x2f\xf0\xeb\xff\x1e\xef\x05\xa3\xb8\xb0\xbd\x7f\xb0\xcb\x89\x3c\xb9\x3d\x2f\x43\xcb\x3b\x03\x32\xae\x15\x6a\xc7\xc7\xba\x27\x0d\xf0\xc3\x59\xda\x3d\xcb\x32\x4c\xe3\x44\xbd\xf5\xa3\x81\x43\xe6\xf6\xda\x8b\x60\xb6\x0e\x02\xe2\x47\x71\x03\xaa\x08\x32\x4c\xa4\x9e\x5a\x25\x68\xba\x5c\x7a\xc4\xf6\xcf\x3f\x9e\xdb\x5e\x48\x2e\xb1\x46\x77\xa8\xb1\x9e\x59\xdc\x08\x6c\x6d\xd2\x1f\xbc\x06\x0d\xdb\x32\x0c\xbd\xd9\x91\xcd\xca\x74\xc4\x13\xaf\x97\x66\xb3\x31\xcd\xe5\x45\x3b\xb3\x10\x86\x17\x1e\xf5\xac\x2d\x27\x58\xae\x5e\x8d\xd8\x18\x75\xd2\xbd\xed\xea\x9a\x58\xbf\x00\xd4\xda\x87\xeb\xb8\x05\x00\x00")
+
+func migrationPostgres100_initial_schemaSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationPostgres100_initial_schemaSql,
+		"migration/postgres/1.0.0_initial_schema.sql",
+	)
+}
+
+func migrationPostgres100_initial_schemaSql() (*asset, error) {
+	bytes, err := migrationPostgres100_initial_schemaSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/postgres/1.0.0_initial_schema.sql", size: 1464, mode: os.FileMode(420), modTime: time.Unix(1792202287, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationPostgres110_leasesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x52\xc1\x6e\x82\x40\x10\xbd\xef\x57\xcc\x4d\x4d\x25\x31\x4d\x3c\x79\x5a\x05\x5b\x52\x40\x0b\x4b\x53\x7b\x31\x5b\x18\x65\x23\x2c\x04\xd6\xea\xe7\x77\x61\xc5\x36\xa6\x6d\x3a\x17\xb2\x33\xef\xf1\xde\x4c\x9e\x65\xc1\x5d\x21\xf6\x35\x57\x08\x71\x45\x2c\x0b\xa2\x67\x0f\x84\x84\x06\x13\x25\x4a\x09\x83\xb8\x1a\x80\x68\x00\xcf\x98\x1c\x15\xa6\x70\xca\x50\x82\xca\x74\xcb\xf0\x5a\x90\x7e\xf0\xaa\xca\x05\xa6\x64\x11\x3a\x94\x39\xc0\xe8\xdc\x73\xc0\x5d\x42\xb0\x62\xe0\xbc\xba\x11\x8b\x20\x47\xde\x60\x03\x43\x02\xba\x24\x2f\x10\xfa\x7a\xa1\xe1\xe2\x91\x86\xc3\xfb\xe9\x74\xd4\x31\x82\xd8\xf3\x60\x1d\xba\x3e\x0d\x37\xf0\xe4\x6c\xc6\x1d\x27\x2b\xf3\x14\xeb\xbf\x38\x06\xa7\xca\x83\xf6\x78\xa9\xb9\xfb\xe0\x06\xec\x0b\x61\xba\x7a\xd1\x1d\xca\x44\xc8\xbd\x41\x8f\xf5\xce\x49\x8d\x05\xca\x7e\x47\xfc\xd0\x52\x2a\x43\x63\x1b\x92\x8c\xcb\xbd\x76\xaf\x3f\x69\xd3\xa9\xe0\xb9\x12\x35\x36\x5b\xae\xf4\x83\xb9\xbe\x13\x31\xea\xaf\xd9\xdb\x55\xaa\x43\x8d\x66\x84\xdc\x8a\x41\xb9\xeb\xff\xdc\xee\x63\x04\xda\x86\xc2\xba\x10\xd2\xdc\xf4\xc4\xf5\x85\xf5\x1c\x8e\x52\x83\xc6\x30\x01\xb1\x03\x59\x4a\x24\xd4\x63\x4e\x78\xb9\xf0\x37\x46\x03\xd4\xb6\x61\xb1\xf2\x62\x3f\xe8\xf5\xb6\x46\xef\xe6\x06\x60\x3b\x4b\x1a\x7b\x0c\x26\xda\x5c\xeb\xee\x1a\x01\xbb\x3c\xc9\x3e\x04\xd7\x04\xb4\xcd\x7f\x65\xa0\x2e\xf3\x5c\x4f\xdf\x79\x72\xf8\xdd\xa4\x1d\xae\xd6\x3f\xba\x9c\x91\x6e\x64\x28\x26\x2b\x33\xf2\x09\x20\xfb\x70\xa4\xa0\x02\x00\x00")
+
+func migrationPostgres110_leasesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationPostgres110_leasesSql,
+		"migration/postgres/1.1.0_leases.sql",
+	)
+}
+
+func migrationPostgres110_leasesSql() (*asset, error) {
+	bytes, err := migrationPostgres110_leasesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/postgres/1.1.0_leases.sql", size: 672, mode: os.FileMode(420), modTime: time.Unix(1792203294, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationPostgres120_schedule_seedsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x90\xc1\x52\x83\x30\x10\x86\xef\x79\x8a\xff\x56\x1d\xcb\x13\xf4\x44\x05\x1d\x46\xa4\x15\xe8\x8c\x3d\x39\x98\x6c\x25\xd3\x90\x30\x24\xb5\x8e\x4f\x6f\x42\x05\xf5\xe6\xde\xb2\xd9\xfd\xfe\x9d\x2f\x8a\x70\xd3\xc9\xb7\xa1\x71\x84\x5d\xcf\xa2\x08\xd5\x53\x0e\xa9\x61\x89\x3b\x69\x34\x16\xbb\x7e\x01\x69\x41\x1f\xc4\x4f\x8e\x04\xce\x2d\x69\xb8\xd6\xb7\x2e\x7b\x61\xc8\x3f\x9a\xbe\x57\x92\x04\xbb\x2d\xd3\xb8\x4e\x51\xc7\xeb\x3c\x45\x76\x87\x62\x53\x23\x7d\xce\xaa\xba\x82\xe5\x2d\x89\x93\xa2\x17\x4b\x24\x2c\xae\x18\x7c\x89\x90\x3c\x55\x12\x56\xc3\x46\xb1\xcb\x73\x6c\xcb\xec\x31\x2e\xf7\x78\x48\xf7\x4b\xf8\xcb\xc6\x51\x73\x80\xa3\xa1\x93\xfa\x92\x3c\x31\x97\xe1\x66\x65\x78\xa3\xe0\x64\x47\xf8\x34\x9a\x46\x7e\xc8\x9a\xf9\xeb\xec\x3e\x2b\xea\x9f\x84\x5f\xe5\xf9\xe3\xa8\x6b\x69\x86\xa2\xd1\xc2\x73\xad\x6b\x34\x27\xf4\x92\x1f\x2d\x04\x0d\xf2\x9d\x70\x18\x4c\x37\xf2\xaf\x57\x8c\x05\x6d\xb3\xc5\xc4\x9c\xf5\xe4\x71\x96\x18\x9a\xff\xd2\x38\x18\xa5\xfc\xef\x6b\xc3\x8f\x2c\x29\x37\xdb\x6f\x91\x7f\xd5\xad\xd8\x17\x08\xcf\x65\xcb\xb7\x01\x00\x00")
+
+func migrationPostgres120_schedule_seedsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationPostgres120_schedule_seedsSql,
+		"migration/postgres/1.2.0_schedule_seeds.sql",
+	)
+}
+
+func migrationPostgres120_schedule_seedsSql() (*asset, error) {
+	bytes, err := migrationPostgres120_schedule_seedsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/postgres/1.2.0_schedule_seeds.sql", size: 439, mode: os.FileMode(420), modTime: time.Unix(1792206286, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationPostgres130_schedule_amendmentsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa5\x94\xdf\x6f\x9b\x30\x10\xc7\xdf\xf9\x2b\xee\xad\x8d\x96\x48\x69\xa5\x6c\x93\xfa\x44\x1b\x4f\x43\x23\x3f\x06\x64\x4a\xf7\x82\x1c\xfb\x96\x58\x25\x36\xc2\x46\x69\xf7\xd7\xd7\x76\x80\xa5\x34\xd5\x36\xd5\x12\x0f\x98\x2f\x1f\xdf\xdd\xd7\x77\xa3\x11\x7c\xd8\x8b\x6d\x45\x0d\xc2\xaa\x0c\x46\x23\x48\xbf\xc7\x20\x24\x68\x64\x46\x28\x09\x17\xab\xf2\x02\x84\x06\x7c\x44\x56\x1b\xe4\x70\xd8\xa1\x04\xb3\xb3\x5b\xc7\xff\x9c\xc8\xbe\xd0\xb2\x2c\x04\xf2\xe0\x2e\x21\x61\x46\x20\x0b\x6f\x63\x02\xd1\x17\x98\x2f\x32\x20\xeb\x28\xcd\x52\xd0\x6c\x87\xbc\x2e\x30\xa7\x7b\x94\xdc\x3e\x46\xc3\x65\x00\x76\x09\x0e\x29\x49\xa2\x30\x86\x65\x12\xcd\xc2\xe4\x1e\xbe\x91\xfb\xa1\xff\xc4\x5d\x64\xed\x9a\x3a\xb4\x23\xce\x57\x71\x3c\xec\xb6\x6d\xd4\x5e\xa6\x7e\x81\xc1\x6a\x2f\xe4\x31\xaa\xf6\xbc\xa1\xcb\xa7\x50\x8c\x16\x60\xc4\x1e\xe1\xb7\x92\xe8\xd9\xaa\x84\x3f\xeb\x47\x98\xdc\x7d\x0d\x93\xcb\xeb\xf1\xe0\xf4\x08\xcb\x66\x54\x32\x2c\x86\x40\x39\x07\x55\x41\x85\x2d\xd8\x43\x6c\xe2\xaf\x21\x93\xab\xeb\x13\xca\x51\xc7\x98\xaa\xa5\x79\xa9\xbb\x1a\x8f\xfb\xba\x0a\xb7\x2e\xf8\x1e\xcf\xca\x4e\xa2\xb2\x41\xd5\x1a\x61\x53\x50\xf9\x00\xda\x54\x42\x6e\xc1\x28\x9b\x26\x17\xcc\x15\x42\x2a\x03\xa5\x8d\xd3\x96\xd8\x33\xb5\xa1\xec\xe1\x55\xa2\x93\xc9\xe0\x1d\x4c\x56\xd4\xda\x56\xfb\x25\xf3\xd3\xc7\xcf\xef\x61\x7a\x7b\xda\x95\x45\x33\x92\x66\xe1\x6c\x99\xfd\x3c\xf5\xdb\x32\xbd\xcc\x99\xbd\x43\xb0\xbf\x56\x4f\x8d\x45\x05\xf6\x0c\xe2\x9e\x2a\xf1\x90\x77\xe4\xff\xa1\x5a\xc3\xcf\x13\xfd\x05\x46\x9e\x6f\x9e\xde\xac\x67\xe3\x25\xd5\x9d\x97\x19\x59\x67\xfd\x3b\xd1\x70\xa8\xe9\x47\xd6\xc8\xbc\x6a\x70\x13\xb4\x6d\x15\xcd\xa7\x64\xfd\xf7\xb6\xca\x5d\x3b\xe4\xb6\xcc\xf8\x08\x8b\xf9\xf9\xc6\x73\x12\x47\x76\x3d\xdf\x8d\x80\xa9\x3a\xc8\x76\x08\x74\x13\xc0\x6d\xfe\xd3\x0c\xa8\x94\xb7\x60\x63\x2f\x5b\x30\x4d\x16\xcb\x66\x0a\x9c\x39\xfe\x26\x78\x06\xea\x2a\x01\x0b\x79\x04\x00\x00")
+
+func migrationPostgres130_schedule_amendmentsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationPostgres130_schedule_amendmentsSql,
+		"migration/postgres/1.3.0_schedule_amendments.sql",
+	)
+}
+
+func migrationPostgres130_schedule_amendmentsSql() (*asset, error) {
+	bytes, err := migrationPostgres130_schedule_amendmentsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/postgres/1.3.0_schedule_amendments.sql", size: 1145, mode: os.FileMode(420), modTime: time.Unix(1792206651, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationPostgres140_snoozesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x92\x51\x4f\xc2\x30\x10\xc7\xdf\xf7\x29\xee\x0d\x88\x2e\x01\x12\xd4\x84\xa7\x09\x35\x2e\x8e\x81\x5b\x31\xe0\x0b\x19\xdb\x09\x0d\xa3\x5d\xd6\x2e\xa0\x9f\xde\x6e\xeb\x10\x88\x26\xde\x5b\x7b\xff\xfb\xb5\x77\xf7\xb7\x6d\xb8\xd9\xb3\x4d\x1e\x29\x84\x79\x66\xd9\x36\x84\xaf\x1e\x30\x0e\x12\x63\xc5\x04\x87\xd6\x3c\x6b\x01\x93\x80\x47\x8c\x0b\x85\x09\x1c\xb6\xc8\x41\x6d\xf5\x55\x5d\x57\x8a\xf4\x21\xca\xb2\x94\x61\x62\x8d\x02\xe2\x50\x02\xd4\x79\xf4\x08\xb8\x4f\xe0\x4f\x29\x90\x85\x1b\xd2\x10\x24\x17\xe2\x0b\x25\xb4\x2d\xd0\xc1\x12\x08\x49\xe0\x3a\x1e\xcc\x02\x77\xe2\x04\x4b\x78\x21\xcb\xdb\x2a\xa5\x59\x70\x8a\x37\x27\x18\x3d\x3b\x41\x7b\xd0\xeb\x77\x2a\x9a\x3f\xf7\x3c\xa3\x8b\x63\x51\x70\x75\xa9\xeb\x75\xbb\x67\x3a\xd0\x1d\x15\x12\x61\x9d\x46\x7c\x07\x52\xe5\x8c\x6f\xe0\x43\xe4\x10\xa5\x69\x53\x2f\x2b\x58\x9c\x16\x52\x61\x7e\x09\xbb\xbf\x7b\xf8\x2f\xcc\xd4\xd7\x30\x3c\x66\x2c\xd7\xad\x56\x41\xdd\x09\x09\xa9\x33\x99\xd1\xf7\x33\x56\x09\x53\x6c\x8f\x7a\x96\x68\x46\x03\xc8\x93\xba\xbe\x3e\x27\xab\xf5\xe7\xd9\x67\xfa\x83\xc1\xf5\x04\x72\x8c\xa4\x9e\xbf\x79\x87\x2c\xe8\x55\xbe\xe1\x44\xea\x8f\x7f\x54\xaa\xce\xd0\x6a\xf6\xe6\xfa\x63\xb2\xf8\x7d\x6f\x2b\xd3\xd4\x8a\xf1\x04\x8f\x30\xf5\x7f\x16\x6a\x32\x25\xa7\xb4\xd0\xc9\x51\x63\x71\xe0\x8d\xa7\x4e\x86\x2a\x2f\xff\x65\xa9\x5c\xa4\xa9\xce\xae\xa3\x78\x67\x8d\x83\xe9\xcc\x98\xca\xbc\x3a\xb4\xbe\x01\xc0\xda\xbc\xea\xbc\x02\x00\x00")
+
+func migrationPostgres140_snoozesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationPostgres140_snoozesSql,
+		"migration/postgres/1.4.0_snoozes.sql",
+	)
+}
+
+func migrationPostgres140_snoozesSql() (*asset, error) {
+	bytes, err := migrationPostgres140_snoozesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/postgres/1.4.0_snoozes.sql", size: 700, mode: os.FileMode(420), modTime: time.Unix(1792207014, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationPostgres150_schedule_anchorsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\xce\x3b\x0e\xc2\x30\x10\x04\xd0\xde\xa7\x98\x8e\x02\xe5\x04\x54\x81\xa4\x40\x72\xf8\x3a\x0d\x0d\x32\xce\x42\x2c\x8c\x6d\xc5\x8e\xc2\xf1\x71\x84\x88\x44\x83\x28\x77\x76\x47\xfb\xb2\x0c\xf3\x87\xbe\x75\x32\x12\x6a\xcf\xb2\x0c\xc7\x3d\x87\xb6\x08\xa4\xa2\x76\x16\xb3\xda\xcf\xa0\x03\xe8\x49\xaa\x8f\xd4\x60\x68\xc9\x22\xb6\x29\x7a\xf7\xc6\xa3\x34\x48\xef\x8d\xa6\x86\xe5\x5c\x94\x07\x88\x7c\xc9\x4b\x04\xd5\x52\xd3\x1b\x3a\x07\xa2\x26\x20\x2f\x0a\xac\xb6\xbc\xae\x36\x90\x56\xb5\xae\x83\x58\x57\xe5\x51\xe4\xd5\x4e\x9c\xb0\xa9\x39\x5f\x20\x01\xa2\x7e\x50\x7a\x40\x53\x1d\x83\x0c\xf0\xce\xf7\x46\x8e\x80\xab\xeb\x18\x1b\xa5\x13\xbc\x70\x83\xfd\xd0\x27\xf7\x18\xfe\x25\xef\x9c\x31\x69\x7b\x91\xea\xfe\x4b\x5f\x1c\xb6\xbb\x6f\xfe\x82\xbd\x00\x5a\x5f\xec\x47\x3e\x01\x00\x00")
+
+func migrationPostgres150_schedule_anchorsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationPostgres150_schedule_anchorsSql,
+		"migration/postgres/1.5.0_schedule_anchors.sql",
+	)
+}
+
+func migrationPostgres150_schedule_anchorsSql() (*asset, error) {
+	bytes, err := migrationPostgres150_schedule_anchorsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/postgres/1.5.0_schedule_anchors.sql", size: 318, mode: os.FileMode(420), modTime: time.Unix(1792207938, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationSqlite100_initial_schemaSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x54\xdb\x8e\x9b\x30\x10\x7d\xe7\x2b\xe6\x6d\x5b\x15\xfa\x03\x79\x22\xc1\xa9\x50\xb9\xa4\x60\xa4\xe4\x09\xb1\xc6\x49\xac\x80\x41\xd8\x68\xb7\xfd\xfa\x8e\x61\x49\xe8\x96\xbd\xa9\xf5\x9b\x67\x86\x73\x8e\x67\x0e\xe3\x38\xf0\xa5\x16\xa7\xae\xd0\x1c\xb2\xd6\x72\x1c\x48\x7f\x04\x20\x24\x28\xce\xb4\x68\x24\xdc\x65\xed\x1d\x08\x05\xfc\x91\xb3\x5e\xf3\x12\x1e\xce\x5c\x82\x3e\x63\x68\xfc\xce\x14\xe1\xa5\x68\xdb\x4a\xf0\xd2\xda\x24\xc4\xa5\x04\xa8\xbb\x0e\x08\xf8\x5b\x88\x62\x0a\x64\xef\xa7\x34\x05\xc5\xce\xbc\xec\x2b\xae\xe0\x93\x05\x78\x44\x09\x7e\x44\xc9\x37\x92\xc0\x2e\xf1\x43\x37\x39\xc0\x77\x72\x00\x37\xa3\xb1\x1f\x21\x4e\x48\x22\x6a\x0f\x95\xa5\x91\x37\x1d\xcf\xe0\x1b\xd8\x28\x0b\x02\x7b\x8a\xa2\xf2\xa1\xaa\x39\x82\xe6\x5d\x2d\xe4\xa8\x6c\xe2\xb4\xcd\x9b\xaa\x86\x15\x15\x68\x51\x73\xf8\xd5\x48\x8c\x15\x0a\x0e\x78\x9c\x30\x74\x3c\x6f\x60\x1a\x92\x73\x26\xea\x87\xcf\xd8\x90\x69\xa8\x42\xc0\x8c\x6e\xbe\xc2\x9a\xb3\xa2\x57\x23\xb3\x89\x97\xe2\x78\xe4\x1d\x97\x0c\x09\xea\xe2\xe7\xd3\x1d\x8e\x5d\x53\x0f\x12\x07\x1e\x6c\xd7\x95\x06\x28\xd9\xd3\x1b\xc7\x98\x67\xac\xe9\xa5\x7e\x31\xdf\xf1\x93\x79\xde\x52\xde\x08\x34\x7a\xee\xab\x42\x5e\x40\xe9\x4e\xc8\x13\xe8\x06\xf5\x96\x82\x99\x16\xc9\x46\x43\xdb\x71\xc5\xa5\x1e\xb0\x94\x2e\xd8\x05\xfe\x0f\x16\xab\x7a\x85\xfd\x5f\xc0\x82\x0f\x63\x7d\x5e\x59\x93\x9d\xfc\xc8\x23\xfb\x97\xec\x94\x9b\xae\xe6\x08\xc3\x1f\x21\x8e\xe6\x36\x33\x89\x19\xca\x92\x29\x67\x66\xf9\xb8\x2f\xff\x75\x8a\xaf\x74\xfe\x8d\x6e\xbe\xe9\x82\x91\x5f\x9d\x5e\xd5\x27\x24\x2a\x40\xa3\xe6\xf8\xe4\xa5\xfc\x45\x54\x15\x2f\xf3\x42\x2f\xfe\x0d\xe0\x91\xad\x9b\x05\x14\x36\x59\x92\x60\x4f\x72\x93\x4d\xa9\x1b\xee\xec\x67\x3f\xc9\x00\x56\xf1\x42\xe1\x64\x46\x31\xeb\x38\x0e\x88\x1b\xfd\x8d\xb5\x75\x83\x94\xbc\x67\xfc\xf3\xc1\xe5\x38\x88\xfc\x2a\xf6\x66\x85\x3f\x87\x8b\x45\xf6\xed\x49\x06\xde\xec\xbb\xeb\xfa\xf3\x9a\x07\x39\x2d\xc0\xeb\xf6\x33\xc1\x77\xed\xbf\xae\x31\xb8\x70\x8f\x03\xb5\xbc\x24\xde\x3d\x99\xed\x6a\xc6\xd5\x3c\x3a\xd7\xb5\xb2\x7e\x03\x03\x95\xc6\x17\x84\x05\x00\x00")
+
+func migrationSqlite100_initial_schemaSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationSqlite100_initial_schemaSql,
+		"migration/sqlite/1.0.0_initial_schema.sql",
+	)
+}
+
+func migrationSqlite100_initial_schemaSql() (*asset, error) {
+	bytes, err := migrationSqlite100_initial_schemaSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/sqlite/1.0.0_initial_schema.sql", size: 1412, mode: os.FileMode(420), modTime: time.Unix(1792201880, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationSqlite110_leasesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x52\x4d\x6f\xc2\x30\x0c\xbd\xe7\x57\xf8\xc6\xa6\x51\x89\x3b\xa7\x8e\x86\xa9\x5a\x28\xac\xa4\x12\x3b\xa1\xac\x35\x34\xa2\x4d\xab\x36\x0c\x7e\xfe\xdc\x86\xc2\x84\x98\x34\x5f\xa2\xd8\xcf\x7a\x1f\xb2\xe7\xc1\x4b\xa9\xf7\x8d\xb2\x08\x49\xcd\x3c\x0f\xd6\x1f\x02\xb4\x81\x16\x53\xab\x2b\x03\xa3\xa4\x1e\x81\x6e\x01\xcf\x98\x1e\x2d\x66\x70\xca\xd1\x80\xcd\xa9\xe5\xf6\x3a\x10\x7d\x54\x5d\x17\x1a\x33\x36\x8b\xb9\x2f\x39\x48\xff\x55\x70\x08\xe7\x10\x2d\x25\xf0\x4d\xb8\x96\x6b\x28\x50\xb5\xd8\xc2\x13\x03\x2a\xa3\x4a\x84\xa1\x24\xdf\xc8\x1e\x19\x25\x42\xc0\x2a\x0e\x17\x7e\xfc\x09\xef\xfc\x73\xdc\x63\xf3\xaa\xc8\xb0\x79\x84\x75\x73\x5b\x1d\x48\xd3\xa5\xc2\x48\xf2\x37\x1e\xdf\x20\x7d\x97\x8c\xed\xd0\xa4\xda\xec\x1d\x7a\x4c\x1e\xd3\x06\x4b\x34\x83\x27\xfc\x26\x0a\x9b\xa3\x93\x09\x69\xae\xcc\x9e\xd4\xd2\x93\xb5\x3d\x0b\x9e\x6b\xdd\x60\xbb\x55\x96\x3e\x01\x99\x94\xe1\x82\xdf\x54\x5f\x58\xac\x26\x5f\x94\x5f\x22\x67\xfd\xd6\xf3\x94\xb1\x7b\x72\xa8\x76\x03\x53\xe7\xcb\x11\x76\x0d\x8b\x4d\xa9\x8d\xcb\xf4\xa4\x28\x61\x9a\xc3\xd1\x10\x68\x0c\x13\xd0\x3b\x30\x95\x41\xe6\x0b\x49\xfe\x5c\xc2\xbf\x36\x5a\xf0\x83\x00\x66\x4b\x91\x2c\xa2\x81\x6f\xeb\xf8\xee\x33\x81\x80\xcf\xfd\x44\x48\x98\x90\xba\x4e\xde\xf5\x06\x82\xea\x64\x86\x2b\xb8\x9e\x40\xd7\xfc\xd7\x11\x34\x55\x51\xd0\xf4\x4b\xa5\x87\xbf\x55\x06\xf1\x72\xf5\x50\xe6\x94\xf5\x23\xb7\xe2\x8e\x65\xca\x7e\x00\xeb\x39\x0b\x64\xa1\x02\x00\x00")
+
+func migrationSqlite110_leasesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationSqlite110_leasesSql,
+		"migration/sqlite/1.1.0_leases.sql",
+	)
+}
+
+func migrationSqlite110_leasesSql() (*asset, error) {
+	bytes, err := migrationSqlite110_leasesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/sqlite/1.1.0_leases.sql", size: 673, mode: os.FileMode(420), modTime: time.Unix(1792203294, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationSqlite120_schedule_seedsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x90\xcb\x4e\xc3\x30\x10\x45\xf7\xfe\x8a\xbb\x2b\x88\xe6\x0b\xba\x2a\xc4\xa0\x88\xf4\x41\x9a\x48\x64\x85\x8c\x3d\x25\x56\x13\x3b\x8a\x5d\x8a\xf8\x7a\xec\x94\xa6\x62\xc7\xdd\x79\x3c\x33\x67\x74\x92\x04\x77\x9d\xfe\x18\x84\x27\x54\x3d\x4b\x12\xec\x5e\x72\x68\x03\x47\xd2\x6b\x6b\x30\xab\xfa\x19\xb4\x03\x7d\x91\x3c\x7a\x52\x38\x35\x64\xe0\x9b\x50\x3a\xcf\xc5\xa6\xf0\x10\x7d\xdf\x6a\x52\xec\xa1\xe0\xcb\x92\xa3\x5c\xde\xe7\x1c\xd9\x23\xd6\x9b\x12\xfc\x35\xdb\x95\x3b\x38\xd9\x90\x3a\xb6\xf4\xe6\x88\x94\xc3\x0d\x43\x88\x8a\xe4\x4b\xd2\x38\x1a\x27\xd6\x55\x9e\x63\x5b\x64\xab\x65\x51\xe3\x99\xd7\x73\x84\xcb\xc6\x56\xbb\x87\xa7\xa1\xd3\xe6\x4c\xbe\xec\x9c\xc7\x9b\x5b\x2b\x45\x0b\xaf\x3b\xc2\xb7\x35\xa1\x26\x1c\xea\x90\x64\xb5\x4a\xd2\x74\xc4\x45\xf4\x84\xcb\xd6\x25\x7f\xe2\xc5\x95\x78\x4d\xc0\x8d\xad\xbe\xa1\x89\x01\x61\x54\xc0\x38\x2f\x8c\x24\xf4\x5a\x1e\x1c\x14\x0d\xfa\x93\xb0\x1f\x6c\x37\xee\xbf\x5d\x30\x16\x2d\x4e\x52\x53\x7b\x32\x17\xad\x93\xd3\x58\xfc\x97\xd5\xc1\xb6\x6d\xf8\x7d\x17\xf2\xc0\xd2\x62\xb3\xfd\xf5\xfa\xd7\xe4\x82\xfd\x00\x1f\xc9\x95\x62\xc6\x01\x00\x00")
+
+func migrationSqlite120_schedule_seedsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationSqlite120_schedule_seedsSql,
+		"migration/sqlite/1.2.0_schedule_seeds.sql",
+	)
+}
+
+func migrationSqlite120_schedule_seedsSql() (*asset, error) {
+	bytes, err := migrationSqlite120_schedule_seedsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/sqlite/1.2.0_schedule_seeds.sql", size: 454, mode: os.FileMode(420), modTime: time.Unix(1792206286, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationSqlite130_schedule_amendmentsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x54\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x78\xeb\x86\xc5\x5f\xd0\x53\x56\x6b\x85\xb1\xd8\x69\x1d\x19\x48\x4e\x86\x22\x71\x89\x50\x47\x32\x2c\x19\x69\xfb\xf5\xa3\x94\x38\x5b\x57\x07\xdb\x80\x0a\xf0\xc1\x14\xf9\x1e\xc9\x27\x32\x4d\xe1\xcb\x41\xef\x7a\xe1\x11\xea\x2e\x49\x53\x58\x3d\x2e\x40\x1b\x70\x28\xbd\xb6\x06\x6e\xea\xee\x06\xb4\x03\x7c\x46\x39\x78\x54\x70\xdc\xa3\x01\xbf\x27\xd3\x29\x2e\x38\xd1\x8f\xe8\xba\x56\xa3\x4a\xee\x2a\x36\xe7\x0c\xf8\xfc\xeb\x82\x41\xfe\x0d\xca\x25\x07\xb6\xce\x57\x7c\x05\x4e\xee\x51\x0d\x2d\x36\xe2\x80\x46\xd1\xe7\x1d\x7c\x4a\x80\x8e\x56\x90\x97\x9c\xdd\xb3\x0a\x1e\xaa\xbc\x98\x57\x1b\xf8\xce\x36\x30\xaf\xf9\x32\x2f\x09\xb1\x60\x25\x9f\x45\x4f\x15\x12\x1d\x4f\x16\x98\x02\x41\x59\x2f\x16\xb3\xd1\x4a\x35\x44\x2f\xfb\x03\x3c\xf6\x07\x6d\x4e\x39\x8e\xec\xb3\x50\x5d\x6b\xa5\x68\xc1\xeb\x03\xc2\xab\x35\x64\x13\x0e\x36\x74\xd2\xa2\x48\xb3\x2c\x32\xd9\x0e\x7e\x1d\xce\xd6\x7c\x92\x49\x0a\x23\xb1\xa5\x78\xa5\xc0\xf6\xd0\xe3\x48\x13\x31\xa8\x29\xd7\x30\x4e\xf7\x52\xda\xc1\xf8\xab\xf7\x3d\xee\x42\xea\x93\x39\x10\xf9\xe0\x10\xb6\xad\x30\x4f\xe0\x7c\xaf\xcd\x0e\xbc\xa5\xe2\x94\x96\xa1\x7c\x63\x3d\x74\x94\x0f\xb5\x39\x62\x39\x2f\xe4\x13\x7c\x0c\x96\x6c\x07\x47\xbd\xfd\x10\xac\x28\xc2\xef\x8a\xf2\xbc\x60\xef\xfa\x1c\xbd\x48\xb8\x9a\xdf\x45\x61\xf7\x08\x04\xd0\xbf\x9c\x05\x68\xf1\x8f\xf6\xab\x88\x6d\xf0\xd8\x5c\xf0\xff\x1f\x9b\x44\x9d\xc6\x8d\x0f\x18\x55\xb3\x7d\xb9\xa6\x9b\x70\x57\x74\x7b\x13\x2f\xfc\x9b\xbc\xce\x3e\x13\x79\xc5\xa8\xcf\xb7\xc9\x38\x5e\x79\x99\xb1\xf5\xdf\xc7\xab\x09\x83\xd0\x50\xeb\xf1\x19\x96\xe5\xf4\x00\x06\x97\x80\x1c\x66\xff\xb2\x0a\x32\x7b\x34\xe3\x32\xb8\x6c\x82\x60\xfc\xa7\x5d\xd0\xdb\x28\xc8\x96\x1e\x5c\x92\x55\xcb\x87\xf3\x36\x98\xa0\xbf\x4d\x7e\x02\xc1\x4b\xca\x68\x81\x04\x00\x00")
+
+func migrationSqlite130_schedule_amendmentsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationSqlite130_schedule_amendmentsSql,
+		"migration/sqlite/1.3.0_schedule_amendments.sql",
+	)
+}
+
+func migrationSqlite130_schedule_amendmentsSql() (*asset, error) {
+	bytes, err := migrationSqlite130_schedule_amendmentsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/sqlite/1.3.0_schedule_amendments.sql", size: 1153, mode: os.FileMode(420), modTime: time.Unix(1792206651, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationSqlite140_snoozesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9d\x92\x5d\x4f\x83\x30\x14\x86\xef\xf9\x15\xef\x9d\x1a\xe5\x17\xec\x0a\x47\x35\x8d\x0c\x26\x2b\xc9\x76\xb5\x30\x38\x6e\xcd\x58\x21\xb4\x64\xd3\x5f\x6f\xf9\x9a\x31\x62\x62\x3c\x77\xed\xe9\xfb\xf4\x7c\xbc\xae\x8b\xfb\x93\xdc\xd7\xa9\x21\x24\x95\xe3\xba\x58\xbd\x06\x90\x0a\x9a\x32\x23\x4b\x85\x9b\xa4\xba\x81\xd4\xa0\x0b\x65\x8d\xa1\x1c\xe7\x03\x29\x98\x83\xbd\xea\x75\xed\x23\x7b\x48\xab\xaa\x90\x94\x3b\xf3\x98\x79\x82\x41\x78\x8f\x01\x03\x7f\x42\x18\x09\xb0\x35\x5f\x89\x15\xb4\x2a\xcb\x0f\xd2\xb8\x75\x60\x43\xe6\xe0\xa1\x60\xcf\x2c\xc6\x32\xe6\x0b\x2f\xde\xe0\x85\x6d\xe0\x25\x22\xe2\xa1\xa5\x2c\x58\x28\x1e\xba\x97\x16\x8d\x6b\x08\xb6\x16\x1d\x34\x4c\x82\x60\xc8\x67\x59\xd9\x28\x33\x95\x1f\x55\xb6\xaf\x46\x13\x76\x45\xaa\x8e\xd0\xa6\x96\x6a\x8f\xb7\xb2\x46\x5a\x14\xa3\x5c\x77\xac\xac\x68\xb4\xa1\xfa\xbf\xac\x41\xde\xb3\xe8\x52\xc9\xda\xf6\xdb\x85\x6f\xa7\x22\xf8\x82\x7d\xe7\x59\x96\x91\x27\x6a\x07\x9e\x88\xb9\x1d\x2b\x0d\x53\x02\xa9\xbc\xa7\xf4\xe7\x7c\xbb\x7b\x9f\xee\xbe\xa6\x54\xdb\x15\xfc\x3a\x9d\x51\x9f\x9a\xa9\x2a\xf0\xb3\x8a\x4e\x75\x37\x73\xc6\x55\xf2\xd0\x67\xeb\xe9\x55\x6e\x87\x16\xb7\x52\xe5\x74\x41\x14\x7e\xed\x78\xc8\xb4\x9c\xd6\x55\x57\x93\xf9\xe5\x59\x8d\x36\xbb\x7a\xac\xbd\xfc\x93\xcb\xea\xb2\x28\x6c\x76\x97\x66\x47\xc7\x8f\xa3\xe5\xe0\xb3\xe1\xd7\x99\xf3\x09\x3d\x86\x2b\x0e\xcf\x02\x00\x00")
+
+func migrationSqlite140_snoozesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationSqlite140_snoozesSql,
+		"migration/sqlite/1.4.0_snoozes.sql",
+	)
+}
+
+func migrationSqlite140_snoozesSql() (*asset, error) {
+	bytes, err := migrationSqlite140_snoozesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/sqlite/1.4.0_snoozes.sql", size: 719, mode: os.FileMode(420), modTime: time.Unix(1792207014, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrationSqlite150_schedule_anchorsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x8f\xcd\x6e\xc3\x20\x10\x84\xef\x3c\xc5\xdc\x72\x88\xfc\x04\x39\xb9\xc1\x87\x48\xe4\xa7\x09\x9c\x2b\x82\x37\x31\x0a\x01\x64\xb0\xdc\xc7\x2f\xa8\x8a\xa5\x5c\xaa\x1e\x77\x76\x76\xe7\x9b\xa6\xc1\xfa\x69\xef\xa3\xce\x04\x15\x59\xd3\xe0\xf2\x29\x60\x3d\x12\x99\x6c\x83\xc7\x4a\xc5\x15\x6c\x02\x7d\x93\x99\x32\xf5\x98\x07\xf2\xc8\x43\x91\x7e\xef\xaa\xa9\x0c\x3a\x46\x67\xa9\x67\xad\x90\xdd\x19\xb2\xfd\x10\x1d\x92\x19\xa8\x9f\x1c\x7d\x25\xa2\x3e\xa1\xe5\x1c\xdb\xa3\x50\xfb\x03\xb4\x37\x43\x18\xc1\x5b\xd9\xc9\xdd\xbe\xc3\x41\x09\xb1\x41\x49\xcf\xf6\x49\x35\x5e\xc9\x6d\x09\xa1\xe5\x05\x66\x9d\x10\x43\x9c\x9c\xae\x10\xb7\x30\x32\x56\x69\x17\x78\x1e\x66\xff\xc2\x5f\xd8\xab\xf8\x2f\xfa\x31\x38\x57\xb6\x57\x6d\x1e\x7f\x35\xe0\xe7\xe3\xe9\xbd\xc2\x86\xfd\x00\xb9\x9b\x4e\xa7\x42\x01\x00\x00")
+
+func migrationSqlite150_schedule_anchorsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrationSqlite150_schedule_anchorsSql,
+		"migration/sqlite/1.5.0_schedule_anchors.sql",
+	)
+}
+
+func migrationSqlite150_schedule_anchorsSql() (*asset, error) {
+	bytes, err := migrationSqlite150_schedule_anchorsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/sqlite/1.5.0_schedule_anchors.sql", size: 322, mode: os.FileMode(420), modTime: time.Unix(1792207938, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -141,6 +498,23 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"migration/mysql/1.0.0_initial_schema.sql": migrationMysql100_initial_schemaSql,
+	"migration/mysql/1.1.0_leases.sql": migrationMysql110_leasesSql,
+	"migration/mysql/1.2.0_schedule_seeds.sql": migrationMysql120_schedule_seedsSql,
+	"migration/mysql/1.3.0_schedule_amendments.sql": migrationMysql130_schedule_amendmentsSql,
+	"migration/mysql/1.4.0_snoozes.sql": migrationMysql140_snoozesSql,
+	"migration/mysql/1.5.0_schedule_anchors.sql": migrationMysql150_schedule_anchorsSql,
+	"migration/postgres/1.0.0_initial_schema.sql": migrationPostgres100_initial_schemaSql,
+	"migration/postgres/1.1.0_leases.sql": migrationPostgres110_leasesSql,
+	"migration/postgres/1.2.0_schedule_seeds.sql": migrationPostgres120_schedule_seedsSql,
+	"migration/postgres/1.3.0_schedule_amendments.sql": migrationPostgres130_schedule_amendmentsSql,
+	"migration/postgres/1.4.0_snoozes.sql": migrationPostgres140_snoozesSql,
+	"migration/postgres/1.5.0_schedule_anchors.sql": migrationPostgres150_schedule_anchorsSql,
+	"migration/sqlite/1.0.0_initial_schema.sql": migrationSqlite100_initial_schemaSql,
+	"migration/sqlite/1.1.0_leases.sql": migrationSqlite110_leasesSql,
+	"migration/sqlite/1.2.0_schedule_seeds.sql": migrationSqlite120_schedule_seedsSql,
+	"migration/sqlite/1.3.0_schedule_amendments.sql": migrationSqlite130_schedule_amendmentsSql,
+	"migration/sqlite/1.4.0_snoozes.sql": migrationSqlite140_snoozesSql,
+	"migration/sqlite/1.5.0_schedule_anchors.sql": migrationSqlite150_schedule_anchorsSql,
 }
 
 // AssetDir returns the file names below a certain
@@ -187,6 +561,27 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"migration": {nil, map[string]*bintree{
 		"mysql": {nil, map[string]*bintree{
 			"1.0.0_initial_schema.sql": {migrationMysql100_initial_schemaSql, map[string]*bintree{}},
+			"1.1.0_leases.sql": {migrationMysql110_leasesSql, map[string]*bintree{}},
+			"1.2.0_schedule_seeds.sql": {migrationMysql120_schedule_seedsSql, map[string]*bintree{}},
+			"1.3.0_schedule_amendments.sql": {migrationMysql130_schedule_amendmentsSql, map[string]*bintree{}},
+			"1.4.0_snoozes.sql": {migrationMysql140_snoozesSql, map[string]*bintree{}},
+			"1.5.0_schedule_anchors.sql": {migrationMysql150_schedule_anchorsSql, map[string]*bintree{}},
+		}},
+		"postgres": {nil, map[string]*bintree{
+			"1.0.0_initial_schema.sql": {migrationPostgres100_initial_schemaSql, map[string]*bintree{}},
+			"1.1.0_leases.sql": {migrationPostgres110_leasesSql, map[string]*bintree{}},
+			"1.2.0_schedule_seeds.sql": {migrationPostgres120_schedule_seedsSql, map[string]*bintree{}},
+			"1.3.0_schedule_amendments.sql": {migrationPostgres130_schedule_amendmentsSql, map[string]*bintree{}},
+			"1.4.0_snoozes.sql": {migrationPostgres140_snoozesSql, map[string]*bintree{}},
+			"1.5.0_schedule_anchors.sql": {migrationPostgres150_schedule_anchorsSql, map[string]*bintree{}},
+		}},
+		"sqlite": {nil, map[string]*bintree{
+			"1.0.0_initial_schema.sql": {migrationSqlite100_initial_schemaSql, map[string]*bintree{}},
+			"1.1.0_leases.sql": {migrationSqlite110_leasesSql, map[string]*bintree{}},
+			"1.2.0_schedule_seeds.sql": {migrationSqlite120_schedule_seedsSql, map[string]*bintree{}},
+			"1.3.0_schedule_amendments.sql": {migrationSqlite130_schedule_amendmentsSql, map[string]*bintree{}},
+			"1.4.0_snoozes.sql": {migrationSqlite140_snoozesSql, map[string]*bintree{}},
+			"1.5.0_schedule_anchors.sql": {migrationSqlite150_schedule_anchorsSql, map[string]*bintree{}},
 		}},
 	}},
 }}
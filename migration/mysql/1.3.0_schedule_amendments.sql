@@ -0,0 +1,24 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS schedule_amendments (
+    id INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    date         DATE NOT NULL,         -- date of termination schedule, in local time zone
+    op           VARCHAR(20) NOT NULL,  -- cancel, add or reschedule
+    app          VARCHAR(512) NOT NULL,
+    account      VARCHAR(100) NOT NULL,
+    region       VARCHAR(50)  NOT NULL, -- use blank string to indicate not present
+    stack        VARCHAR(255) NOT NULL, -- use blank string to indicate not present
+    cluster      VARCHAR(768) NOT NULL, -- use blank string to indicate not present
+    time         DATETIME NULL,         -- time in UTC of the entry cancelled or rescheduled
+    new_time     DATETIME NULL,         -- time in UTC of the entry added or rescheduled
+    amended_by   VARCHAR(255) NOT NULL,
+    reason       TEXT NOT NULL,
+    amended_at   DATETIME NOT NULL,     -- time in UTC
+    INDEX date_index (date)
+    )
+ENGINE=InnoDB;
+
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE schedule_amendments;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS snoozes (
+    id INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    app          VARCHAR(512) NOT NULL,
+    account      VARCHAR(100) NOT NULL, -- use blank string for all accounts
+    cluster      VARCHAR(768) NOT NULL, -- use blank string for all clusters
+    expires      DATETIME NOT NULL,     -- time in UTC the snooze ends
+    snoozed_by   VARCHAR(255) NOT NULL,
+    reason       TEXT NOT NULL,
+    snoozed_at   DATETIME NOT NULL,     -- time in UTC
+    INDEX expires_index (expires)
+    )
+ENGINE=InnoDB;
+
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE snoozes;
@@ -0,0 +1,17 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS leases (
+    name         VARCHAR(255) NOT NULL PRIMARY KEY,
+    holder       VARCHAR(255) NOT NULL,
+    token        BIGINT NOT NULL,      -- fencing token, incremented whenever the lease changes hands
+    expires_at   TIMESTAMPTZ NOT NULL
+    );
+
+-- fencing token of the leader lease the termination was made under, 0 if none
+ALTER TABLE terminations ADD COLUMN fencing_token BIGINT NOT NULL DEFAULT 0;
+
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE terminations DROP COLUMN fencing_token;
+DROP TABLE leases;
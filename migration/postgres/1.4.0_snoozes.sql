@@ -0,0 +1,19 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS snoozes (
+    id SERIAL PRIMARY KEY,
+    app          VARCHAR(512) NOT NULL,
+    account      VARCHAR(100) NOT NULL, -- use blank string for all accounts
+    cluster      VARCHAR(768) NOT NULL, -- use blank string for all clusters
+    expires      TIMESTAMPTZ NOT NULL,  -- time the snooze ends
+    snoozed_by   VARCHAR(255) NOT NULL,
+    reason       TEXT NOT NULL,
+    snoozed_at   TIMESTAMPTZ NOT NULL
+    );
+
+CREATE INDEX IF NOT EXISTS snoozes_expires_index ON snoozes (expires);
+
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE snoozes;
@@ -0,0 +1,35 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS schedules (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    date         DATE NOT NULL,        -- date of termination schedule, in local time zone, as YYYY-MM-DD
+    time         DATETIME NOT NULL,    -- time in UTC. Because of time difference, may differ from date
+    app          TEXT NOT NULL,
+    account      TEXT NOT NULL,
+    region       TEXT NOT NULL, -- use blank string to indicate not present
+    stack        TEXT NOT NULL, -- use blank string to indicate not present
+    cluster      TEXT NOT NULL  -- use blank string to indicate not present
+    );
+
+CREATE INDEX IF NOT EXISTS schedules_date_index ON schedules (date);
+
+CREATE TABLE IF NOT EXISTS terminations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    app          TEXT NOT NULL,
+    account      TEXT NOT NULL,
+    stack        TEXT NOT NULL,
+    cluster      TEXT NOT NULL,
+    region       TEXT NOT NULL,
+    asg          TEXT NOT NULL,
+    instance_id  TEXT NOT NULL,
+    killed_at    DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- time in UTC
+    leashed      BOOLEAN NOT NULL DEFAULT FALSE
+    );
+
+CREATE INDEX IF NOT EXISTS terminations_app_killed_at_index ON terminations (app, killed_at);
+
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE schedules;
+DROP TABLE terminations;
@@ -0,0 +1,11 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS schedule_seeds (
+    date         DATE NOT NULL PRIMARY KEY, -- date of termination schedule, in local time zone, as YYYY-MM-DD
+    seed         INTEGER NOT NULL           -- seed the schedule and instance picks derive from
+    );
+
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE schedule_seeds;
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS schedule_amendments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    date         DATE NOT NULL,        -- date of termination schedule, in local time zone, as YYYY-MM-DD
+    op           TEXT NOT NULL,        -- cancel, add or reschedule
+    app          TEXT NOT NULL,
+    account      TEXT NOT NULL,
+    region       TEXT NOT NULL, -- use blank string to indicate not present
+    stack        TEXT NOT NULL, -- use blank string to indicate not present
+    cluster      TEXT NOT NULL, -- use blank string to indicate not present
+    time         DATETIME NULL,        -- time in UTC of the entry cancelled or rescheduled
+    new_time     DATETIME NULL,        -- time in UTC of the entry added or rescheduled
+    amended_by   TEXT NOT NULL,
+    reason       TEXT NOT NULL,
+    amended_at   DATETIME NOT NULL     -- time in UTC
+    );
+
+CREATE INDEX IF NOT EXISTS schedule_amendments_date_index ON schedule_amendments (date);
+
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE schedule_amendments;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS snoozes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    app          TEXT NOT NULL,
+    account      TEXT NOT NULL,        -- use blank string for all accounts
+    cluster      TEXT NOT NULL,        -- use blank string for all clusters
+    expires      DATETIME NOT NULL,    -- time in UTC the snooze ends
+    snoozed_by   TEXT NOT NULL,
+    reason       TEXT NOT NULL,
+    snoozed_at   DATETIME NOT NULL     -- time in UTC
+    );
+
+CREATE INDEX IF NOT EXISTS snoozes_expires_index ON snoozes (expires);
+
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE snoozes;
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE schedule_seeds ADD COLUMN anchor DATETIME NULL; -- time in UTC the schedule was populated for
+
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE schedule_seeds DROP COLUMN anchor;
@@ -0,0 +1,188 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+)
+
+// Amend implements schedstore.Amender.Amend
+func (m MySQL) Amend(date time.Time, a schedstore.Amendment) (err error) {
+	err = a.Check()
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	// We must either commit or rollback at the end
+	defer func() {
+		switch err {
+		case nil:
+			err = tx.Commit()
+		default:
+			_ = tx.Rollback()
+		}
+	}()
+
+	exists, err := schedExists(tx, date)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return schedstore.ErrNoSchedule
+	}
+
+	app, account, region, stack, cluster := columns(a.Group)
+
+	switch a.Op {
+	case schedstore.Add:
+		_, err = tx.Exec("INSERT INTO schedules (date, time, app, account, region, stack, cluster) VALUES (?, ?, ?, ?, ?, ?, ?)",
+			utcDate(date), a.NewTime.In(time.UTC), app, account, region, stack, cluster)
+	case schedstore.Cancel, schedstore.Reschedule:
+		var id int64
+		id, a.Time, err = findEntry(tx, date, a)
+		if err != nil {
+			return err
+		}
+
+		if a.Op == schedstore.Cancel {
+			_, err = tx.Exec("DELETE FROM schedules WHERE id = ?", id)
+		} else {
+			_, err = tx.Exec("UPDATE schedules SET time = ? WHERE id = ?", a.NewTime.In(time.UTC), id)
+		}
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s schedule entry", a.Op)
+	}
+
+	_, err = tx.Exec("INSERT INTO schedule_amendments (date, op, app, account, region, stack, cluster, time, new_time, amended_by, reason, amended_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		utcDate(date), string(a.Op), app, account, region, stack, cluster, nullTime(a.Time), nullTime(a.NewTime), a.By, a.Reason, a.At.In(time.UTC))
+	if err != nil {
+		return errors.Wrap(err, "failed to record schedule amendment")
+	}
+
+	return nil
+}
+
+// findEntry returns the id and time of the entry of the schedule for date
+// that a cancels or reschedules
+func findEntry(tx *sql.Tx, date time.Time, a schedstore.Amendment) (id int64, tm time.Time, err error) {
+	app, account, region, stack, cluster := columns(a.Group)
+	rows, err := tx.Query("SELECT id, time FROM schedules WHERE date = DATE(?) AND app = ? AND account = ? AND region = ? AND stack = ? AND cluster = ?",
+		utcDate(date), app, account, region, stack, cluster)
+	if err != nil {
+		return 0, time.Time{}, errors.Wrap(err, "failed to look up schedule entry")
+	}
+
+	defer func() {
+		if cerr := rows.Close(); cerr != nil && err == nil {
+			err = errors.Wrap(cerr, "rows.Close() failed")
+		}
+	}()
+
+	found := 0
+	for rows.Next() {
+		var rid int64
+		var rtm time.Time
+		err = rows.Scan(&rid, &rtm)
+		if err != nil {
+			return 0, time.Time{}, errors.Wrap(err, "failed to scan row")
+		}
+
+		if a.Matches(rtm) {
+			id, tm = rid, rtm
+			found++
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return 0, time.Time{}, errors.Wrap(err, "rows.Err() errored")
+	}
+
+	switch found {
+	case 0:
+		return 0, time.Time{}, schedstore.ErrNoSuchEntry
+	case 1:
+		return id, tm, nil
+	default:
+		return 0, time.Time{}, schedstore.ErrAmbiguousEntry
+	}
+}
+
+// Amendments implements schedstore.Amender.Amendments
+func (m MySQL) Amendments(date time.Time) (result []schedstore.Amendment, err error) {
+	rows, err := m.db.Query("SELECT op, app, account, region, stack, cluster, time, new_time, amended_by, reason, amended_at FROM schedule_amendments WHERE date = DATE(?) ORDER BY id",
+		utcDate(date))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve schedule amendments for %s", date)
+	}
+
+	defer func() {
+		if cerr := rows.Close(); cerr != nil && err == nil {
+			err = errors.Wrap(cerr, "rows.Close() failed")
+		}
+	}()
+
+	for rows.Next() {
+		var a schedstore.Amendment
+		var op, app, account, region, stack, cluster string
+		var tm, newTime sql.NullTime
+		err = rows.Scan(&op, &app, &account, &region, &stack, &cluster, &tm, &newTime, &a.By, &a.Reason, &a.At)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan row")
+		}
+
+		a.Op = schedstore.Op(op)
+		a.Group = grp.New(app, account, region, stack, cluster)
+		a.Time = tm.Time
+		a.NewTime = newTime.Time
+		result = append(result, a)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "rows.Err() errored")
+	}
+
+	return result, nil
+}
+
+// columns returns the columns of a group, blank if not present
+func columns(group grp.InstanceGroup) (app, account, region, stack, cluster string) {
+	app = group.App()
+	account = group.Account()
+	region, _ = group.Region()
+	stack, _ = group.Stack()
+	cluster, _ = group.Cluster()
+	return
+}
+
+// nullTime returns a time as a nullable DATETIME column value in UTC, NULL
+// if t is zero
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t.In(time.UTC), Valid: !t.IsZero()}
+}
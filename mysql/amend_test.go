@@ -0,0 +1,185 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build docker
+// +build docker
+
+package mysql_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/mysql"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+// location returns the time zone schedules are published in
+func location(t *testing.T) *time.Location {
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+// Test entries are cancelled, moved and added, and the amendments recorded
+func TestAmend(t *testing.T) {
+	err := initDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := mysql.New("localhost", port, "root", password, "chaosmonkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	loc := location(t)
+	date := time.Date(2016, time.June, 20, 0, 0, 0, 0, loc)
+	at := func(hh, mm int) time.Time { return time.Date(2016, time.June, 20, hh, mm, 0, 0, loc) }
+	foo := grp.New("foo", "prod", "us-east-1", "", "foo-prod")
+	bar := grp.New("bar", "prod", "us-east-1", "", "bar-prod")
+	baz := grp.New("baz", "prod", "", "", "")
+	amendedAt := time.Date(2016, time.June, 20, 16, 0, 0, 0, time.UTC)
+
+	// Nothing to amend before the schedule is published
+	err = db.Amend(date, schedstore.Amendment{Op: schedstore.Add, Group: baz, NewTime: at(14, 0), By: "alice", At: amendedAt})
+	if err != schedstore.ErrNoSchedule {
+		t.Fatalf("got err=%v, want %v", err, schedstore.ErrNoSchedule)
+	}
+
+	psched := schedule.New()
+	psched.Add(at(10, 0), foo)
+	psched.Add(at(11, 0), bar)
+	psched.Add(at(12, 30), bar)
+	err = db.Publish(date, psched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		a    schedstore.Amendment
+		want error
+	}{
+		{schedstore.Amendment{Op: schedstore.Cancel, Group: foo, Reason: "incident"}, nil},
+		{schedstore.Amendment{Op: schedstore.Cancel, Group: foo}, schedstore.ErrNoSuchEntry},
+		{schedstore.Amendment{Op: schedstore.Reschedule, Group: bar, NewTime: at(13, 0)}, schedstore.ErrAmbiguousEntry},
+		{schedstore.Amendment{Op: schedstore.Reschedule, Group: bar, Time: at(12, 30), NewTime: at(13, 0)}, nil},
+		{schedstore.Amendment{Op: schedstore.Add, Group: baz, NewTime: at(14, 0)}, nil},
+	}
+
+	for _, tt := range tests {
+		tt.a.By = "alice"
+		tt.a.At = amendedAt
+		if err := db.Amend(date, tt.a); err != tt.want {
+			t.Errorf("%s %s: got err=%v, want %v", tt.a.Op, grp.String(tt.a.Group), err, tt.want)
+		}
+	}
+
+	rsched, err := db.Retrieve(date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, e := range rsched.Entries() {
+		got = append(got, e.Group.App()+"@"+e.Time.In(loc).Format("15:04"))
+	}
+	want := []string{"bar@11:00", "bar@13:00", "baz@14:00"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	amendments, err := db.Amendments(date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(amendments), 3; got != want {
+		t.Fatalf("got %d amendments, want %d", got, want)
+	}
+
+	// The cancelled entry is recorded, even though it wasn't specified
+	cancel := amendments[0]
+	if cancel.Op != schedstore.Cancel || !grp.Equal(cancel.Group, foo) || !cancel.Time.Equal(at(10, 0)) || !cancel.NewTime.IsZero() {
+		t.Errorf("got %+v, want cancellation of foo at 10:00", cancel)
+	}
+	if cancel.By != "alice" || cancel.Reason != "incident" || !cancel.At.Equal(amendedAt) {
+		t.Errorf("got %+v, want by alice for incident at %s", cancel, amendedAt)
+	}
+
+	if move := amendments[1]; !move.Time.Equal(at(12, 30)) || !move.NewTime.Equal(at(13, 0)) {
+		t.Errorf("got %+v, want move from 12:30 to 13:00", move)
+	}
+
+	if add := amendments[2]; add.Op != schedstore.Add || !add.Time.IsZero() || !add.NewTime.Equal(at(14, 0)) {
+		t.Errorf("got %+v, want addition at 14:00", add)
+	}
+}
+
+// A day whose only records are amendments, e.g., a schedule published without
+// a seed whose entries were all cancelled, may be published again
+func TestPublishAfterAmendments(t *testing.T) {
+	err := initDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := mysql.New("localhost", port, "root", password, "chaosmonkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	loc := location(t)
+	date := time.Date(2016, time.June, 20, 0, 0, 0, 0, loc)
+	at := func(hh, mm int) time.Time { return time.Date(2016, time.June, 20, hh, mm, 0, 0, loc) }
+	foo := grp.New("foo", "prod", "us-east-1", "", "foo-prod")
+	bar := grp.New("bar", "prod", "us-east-1", "", "bar-prod")
+
+	psched := schedule.New()
+	psched.Add(at(10, 0), foo)
+	err = db.Publish(date, psched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.Amend(date, schedstore.Amendment{Op: schedstore.Cancel, Group: foo, By: "alice", At: at(9, 0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	psched = schedule.New()
+	psched.Add(at(11, 0), bar)
+	err = db.Publish(date, psched)
+	if err != nil {
+		t.Fatalf("got err=%v publishing after amendments, want none", err)
+	}
+
+	rsched, err := db.Retrieve(date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(rsched.Entries()), 1; got != want {
+		t.Fatalf("got %d entries, want %d", got, want)
+	}
+	if e := rsched.Entries()[0]; !grp.Equal(e.Group, bar) || !e.Time.Equal(at(11, 0)) {
+		t.Errorf("got %s at %s, want bar at 11:00", grp.String(e.Group), e.Time)
+	}
+}
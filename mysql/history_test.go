@@ -0,0 +1,101 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build docker
+// +build docker
+
+package mysql_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	c "github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/history"
+	"github.com/Netflix/chaosmonkey/v2/mock"
+	"github.com/Netflix/chaosmonkey/v2/mysql"
+)
+
+// Test recorded terminations are returned most recent first, filtered and
+// limited
+func TestTerminations(t *testing.T) {
+	err := initDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := mysql.New("localhost", port, "root", password, "chaosmonkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, loc, appCfg := testSetup(t)
+	now := time.Date(2026, time.October, 19, 17, 0, 0, 0, time.UTC)
+
+	// Terminations of different clusters don't conflict. Only bar's is
+	// unleashed.
+	for i, app := range []string{"foo", "bar", "foo"} {
+		ins := mock.Instance{App: app, Account: "prod", Cluster: fmt.Sprintf("%s-prod-%d", app, i), Region: "us-east-1", InstanceID: fmt.Sprintf("i-%d", i)}
+		err := db.Check(c.Termination{Instance: ins, Time: now.Add(time.Duration(i) * time.Hour), Leashed: app != "bar"}, appCfg, endHour, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	leashed, unleashed := true, false
+	tests := []struct {
+		q    history.Query
+		want []string
+	}{
+		{history.Query{}, []string{"i-2", "i-1", "i-0"}},
+		{history.Query{App: "foo"}, []string{"i-2", "i-0"}},
+		{history.Query{App: "foo", Limit: 1}, []string{"i-2"}},
+		{history.Query{Account: "test"}, nil},
+		{history.Query{Cluster: "foo-prod-0"}, []string{"i-0"}},
+		{history.Query{Region: "us-west-2"}, nil},
+		{history.Query{Since: now.Add(time.Hour)}, []string{"i-2", "i-1"}},
+		{history.Query{Until: now.Add(time.Hour)}, []string{"i-0"}},
+		{history.Query{Since: now.Add(time.Hour), Until: now.Add(2 * time.Hour)}, []string{"i-1"}},
+		{history.Query{Leashed: &leashed}, []string{"i-2", "i-0"}},
+		{history.Query{Leashed: &unleashed}, []string{"i-1"}},
+	}
+
+	for _, tt := range tests {
+		records, err := db.Terminations(tt.q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, r := range records {
+			got = append(got, r.InstanceID)
+		}
+
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%+v: got %v, want %v", tt.q, got, tt.want)
+		}
+	}
+
+	records, err := db.Terminations(history.Query{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := history.Record{App: "foo", Account: "prod", Cluster: "foo-prod-2", Region: "us-east-1", InstanceID: "i-2", KilledAt: now.Add(2 * time.Hour), Leashed: true}
+	if got := records[0]; got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
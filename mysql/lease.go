@@ -0,0 +1,95 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/lease"
+)
+
+// Acquire implements lease.Store.Acquire
+func (m MySQL) Acquire(name string, holder string, now time.Time, ttl time.Duration) (result lease.Lease, err error) {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return lease.Lease{}, errors.Wrap(err, "failed to begin transaction")
+	}
+
+	defer func() {
+		switch err {
+		case nil:
+			err = tx.Commit()
+		default:
+			_ = tx.Rollback()
+		}
+	}()
+
+	var cur *lease.Lease
+	l := lease.Lease{Name: name}
+	err = tx.QueryRow("SELECT holder, token, expires_at FROM leases WHERE name = ? FOR UPDATE", name).Scan(&l.Holder, &l.Token, &l.Expires)
+	switch err {
+	case nil:
+		cur = &l
+	case sql.ErrNoRows:
+		// lease doesn't exist yet
+	default:
+		return lease.Lease{}, errors.Wrapf(err, "failed to read lease %s", name)
+	}
+
+	next, ok := lease.Claim(cur, name, holder, now, ttl)
+	if !ok {
+		return next, nil
+	}
+
+	if cur == nil {
+		_, err = tx.Exec("INSERT INTO leases (name, holder, token, expires_at) VALUES (?, ?, ?, ?)", name, next.Holder, next.Token, next.Expires.In(time.UTC))
+	} else {
+		_, err = tx.Exec("UPDATE leases SET holder = ?, token = ?, expires_at = ? WHERE name = ?", next.Holder, next.Token, next.Expires.In(time.UTC), name)
+	}
+
+	if err != nil {
+		return lease.Lease{}, errors.Wrapf(err, "failed to write lease %s", name)
+	}
+
+	return next, nil
+}
+
+// Release implements lease.Store.Release
+func (m MySQL) Release(name string, holder string, now time.Time) error {
+	_, err := m.db.Exec("UPDATE leases SET expires_at = ? WHERE name = ? AND holder = ?", now.In(time.UTC), name, holder)
+	if err != nil {
+		return errors.Wrapf(err, "failed to release lease %s", name)
+	}
+	return nil
+}
+
+// checkFencingToken returns an error if token is not the current token of
+// the leader lease
+func checkFencingToken(tx *sql.Tx, token int64) error {
+	var current int64
+	err := tx.QueryRow("SELECT token FROM leases WHERE name = ?", lease.Name).Scan(&current)
+	if err != nil && err != sql.ErrNoRows {
+		return errors.Wrap(err, "failed to read fencing token")
+	}
+
+	if current != token {
+		return lease.ErrStaleToken{Token: token, Current: current}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build docker
+// +build docker
+
+package mysql_test
+
+import (
+	"testing"
+	"time"
+
+	c "github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/lease"
+	"github.com/Netflix/chaosmonkey/v2/mysql"
+)
+
+// Test the leader lease is acquired, renewed, taken over once expired and
+// released
+func TestLease(t *testing.T) {
+	err := initDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := mysql.New("localhost", port, "root", password, "chaosmonkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	ttl := 30 * time.Second
+
+	// Release steps release the lease as holder, then a tries to acquire it
+	steps := []struct {
+		desc    string
+		holder  string
+		release bool
+		offset  time.Duration
+		want    lease.Lease
+	}{
+		{"new lease", "a", false, 0, lease.Lease{Holder: "a", Token: 1, Expires: now.Add(ttl)}},
+		{"held by a", "b", false, time.Second, lease.Lease{Holder: "a", Token: 1, Expires: now.Add(ttl)}},
+		{"renewed by a", "a", false, 10 * time.Second, lease.Lease{Holder: "a", Token: 1, Expires: now.Add(10*time.Second + ttl)}},
+		{"expired", "b", false, 41 * time.Second, lease.Lease{Holder: "b", Token: 2, Expires: now.Add(41*time.Second + ttl)}},
+		{"not released by a", "a", true, 42 * time.Second, lease.Lease{Holder: "b", Token: 2, Expires: now.Add(41*time.Second + ttl)}},
+		{"released by b", "b", true, 42 * time.Second, lease.Lease{Holder: "a", Token: 3, Expires: now.Add(42*time.Second + ttl)}},
+	}
+
+	for _, s := range steps {
+		tm := now.Add(s.offset)
+		holder := s.holder
+		if s.release {
+			err := db.Release(lease.Name, s.holder, tm)
+			if err != nil {
+				t.Fatalf("%s: %v", s.desc, err)
+			}
+			holder = "a"
+		}
+
+		got, err := db.Acquire(lease.Name, holder, tm, ttl)
+		if err != nil {
+			t.Fatalf("%s: %v", s.desc, err)
+		}
+
+		if got.Holder != s.want.Holder || got.Token != s.want.Token || !got.Expires.Equal(s.want.Expires) {
+			t.Errorf("%s: got %+v, want %+v", s.desc, got, s.want)
+		}
+	}
+}
+
+// A termination made under a lease that has changed hands is refused
+func TestCheckStaleFencingToken(t *testing.T) {
+	err := initDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := mysql.New("localhost", port, "root", password, "chaosmonkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ins, loc, appCfg := testSetup(t)
+	now := time.Now()
+	ttl := 30 * time.Second
+
+	old, err := db.Acquire(lease.Name, "a", now.Add(-time.Minute), ttl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cur, err := db.Acquire(lease.Name, "b", now, ttl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.Check(c.Termination{Instance: ins, Time: now, FencingToken: old.Token}, appCfg, endHour, loc)
+	if !lease.StaleToken(err) {
+		t.Fatalf("got %v, want ErrStaleToken", err)
+	}
+
+	err = db.Check(c.Termination{Instance: ins, Time: now, FencingToken: cur.Token}, appCfg, endHour, loc)
+	if err != nil {
+		t.Fatalf("termination with current token should be allowed: %v", err)
+	}
+}
@@ -138,6 +138,20 @@ func (m MySQL) Retrieve(date time.Time) (sched *schedule.Schedule, err error) {
 		return nil, errors.Wrap(err, "rows.Err() errored")
 	}
 
+	var seed int64
+	var anchor sql.NullTime
+	err = m.db.QueryRow("SELECT seed, anchor FROM schedule_seeds WHERE date = DATE(?)", utcDate(date)).Scan(&seed, &anchor)
+	switch {
+	case err == sql.ErrNoRows:
+		// Schedules published before seeds were recorded have none. Those
+		// published before anchors were recorded have a NULL anchor.
+		err = nil
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to retrieve schedule seed for %s", date)
+	}
+	sched.SetSeed(seed)
+	sched.SetAnchor(anchor.Time)
+
 	return sched, nil
 
 }
@@ -208,13 +222,23 @@ func (m MySQL) PublishWithDelay(date time.Time, sched *schedule.Schedule, delay
 		}
 	}
 
+	if sched.Seed() != 0 {
+		anchor := sql.NullTime{Time: sched.Anchor().In(time.UTC), Valid: !sched.Anchor().IsZero()}
+		_, err = tx.Exec("INSERT INTO schedule_seeds (date, seed, anchor) VALUES (DATE(?), ?, ?)", utcDate(date), sched.Seed(), anchor)
+		if err != nil {
+			return errors.Wrapf(err, "failed to record schedule seed for %s", date)
+		}
+	}
+
 	return nil
 }
 
 // schedExists returns true if a schedule has previously been
-// published for this date
+// published for this date. A schedule without terminations is published if
+// its seed was recorded. Amendments don't count: a day whose entries were all
+// cancelled, and that has no seed, may be published again.
 func schedExists(tx *sql.Tx, date time.Time) (result bool, err error) {
-	rows, err := tx.Query("SELECT COUNT(*) FROM schedules WHERE date = DATE(?)", date)
+	rows, err := tx.Query("SELECT (SELECT COUNT(*) FROM schedules WHERE date = DATE(?)) + (SELECT COUNT(*) FROM schedule_seeds WHERE date = DATE(?))", utcDate(date), utcDate(date))
 	if err != nil {
 		return false, errors.Wrapf(err, "failed to check if schedule exists for %s", date)
 	}
@@ -282,6 +306,13 @@ func (m MySQL) CheckWithDelay(term chaosmonkey.Termination, appCfg chaosmonkey.A
 		}
 	}()
 
+	if term.FencingToken != 0 {
+		err = checkFencingToken(tx, term.FencingToken)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = respectsMinTimeBetweenKills(tx, term.Time, term, appCfg, endHour, loc)
 	if err != nil {
 		return err
@@ -303,7 +334,7 @@ func (m MySQL) CheckWithDelay(term chaosmonkey.Termination, appCfg chaosmonkey.A
 func respectsMinTimeBetweenKills(tx *sql.Tx, now time.Time, term chaosmonkey.Termination, appCfg chaosmonkey.AppConfig, endHour int, loc *time.Location) (err error) {
 	app := term.Instance.AppName()
 	account := term.Instance.AccountName()
-	threshold, err := noKillsSince(appCfg.MinTimeBetweenKillsInWorkDays, now, endHour, loc)
+	threshold, err := cal.NoKillsSince(appCfg.MinTimeBetweenKillsInWorkDays, now, endHour, loc)
 	if err != nil {
 		return err
 	}
@@ -365,74 +396,12 @@ func respectsMinTimeBetweenKills(tx *sql.Tx, now time.Time, term chaosmonkey.Ter
 	return nil
 }
 
-// noKillsSince computes the date of the most recent kill
-// that conforms to the min time between kills specified
-// by days
-//
-// Note that the calculation is min time in work days, so it does not count weekends.
-//
-// chrono is an interface for returning the current time
-// endHour is the hour of the end of a workday in 24-hour time. For example, if
-// workday ends at 5PM, this would be 17
-// loc is the location that corresponds to endHour, e.g. America/Los_Angeles for PST
-//
-// # The returned time will be in UTC
-//
-// If days=1, then we allow
-// kills each day, so the most recent kill will be at the
-// end of the previous workday. For example:
-//
-//	days: 1
-//	endHour: 17 (i.e. work day ends at 5PM local time)
-//	loc:  America/Los_Angeles (PST)
-//	chrono.Now(): Wed, Dec. 16, 2015 2:30 PM PST
-//	Output: Tue, Dec. 15, 2015 5:00 PM PST
-//
-// If days=0, returns the current date, with
-// the time set to endHour. For example:
-//
-//	days: 0
-//	endHour: 17 (i.e. work day ends at 5PM local time)
-//	loc:  America/Los_Angeles (PST)
-//	chrono.Now(): Wed, Dec. 16, 2015 2:30 PM PST
-//	Output: Wed, Dec. 16, 2015 5:00 PM PST
-//
-// noKillsSince returns the a datetime that is the last allowed time that a kill
-// is permitted to have happened.
-func noKillsSince(days int, now time.Time, endHour int, loc *time.Location) (time.Time, error) {
-	if days < 0 {
-		return time.Time{}, errors.Errorf("noKillsSince passed illegal input: days=%d", days)
-	}
-
-	oneDay := time.Hour * 24
-
-	// Tail-recursive helper function reads clearer than writing a
-	// traditional loop
-	//
-	// It expects a time localized to the zone associated with endHour because
-	// workday and year-month-day values depend on the local timezone
-	var helper func(N int, tInLoc time.Time) time.Time
-
-	helper = func(N int, tInLoc time.Time) time.Time {
-		switch {
-		case !cal.IsWorkday(tInLoc):
-			return helper(N, tInLoc.Add(-oneDay))
-		case N == 0:
-			return time.Date(tInLoc.Year(), tInLoc.Month(), tInLoc.Day(), endHour, 0, 0, 0, loc).UTC()
-		default:
-			return helper(N-1, tInLoc.Add(-oneDay))
-		}
-	}
-
-	return helper(days, now.In(loc)), nil
-}
-
 func recordTermination(tx *sql.Tx, term chaosmonkey.Termination, loc *time.Location) (err error) {
 
 	i := term.Instance
 
-	_, err = tx.Exec("INSERT INTO terminations (app, account, stack, cluster, region, asg, instance_id, killed_at, leashed) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
-		i.AppName(), i.AccountName(), i.StackName(), i.ClusterName(), i.RegionName(), i.ASGName(), i.ID(), term.Time.In(time.UTC), term.Leashed)
+	_, err = tx.Exec("INSERT INTO terminations (app, account, stack, cluster, region, asg, instance_id, killed_at, leashed, fencing_token) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		i.AppName(), i.AccountName(), i.StackName(), i.ClusterName(), i.RegionName(), i.ASGName(), i.ID(), term.Time.In(time.UTC), term.Leashed, term.FencingToken)
 
 	return err
 }
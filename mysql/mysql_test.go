@@ -131,7 +131,8 @@ func startMySQLContainer() (*exec.Cmd, error) {
 		for !strings.Contains(s, readyString) {
 			s, err = reader.ReadString('\n')
 			if err != nil {
-				return nil, err
+				// The timeout below reports the failure
+				return
 			}
 			fmt.Print(s)
 		}
@@ -410,3 +410,56 @@ func TestPublishDateDifferentTimes(t *testing.T) {
 	}
 
 }
+
+// The seed and anchor are stored with the schedule, and a schedule without
+// terminations counts as published once its seed is recorded
+func TestPublishRetrieveSeed(t *testing.T) {
+	err := initDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := mysql.New("localhost", port, "root", password, "chaosmonkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	date := time.Date(2016, time.June, 20, 0, 0, 0, 0, loc)
+	anchor := time.Date(2016, time.June, 20, 9, 0, 30, 0, loc)
+
+	psched := schedule.New()
+	psched.SetSeed(42)
+	psched.SetAnchor(anchor)
+	err = m.Publish(date, psched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsched, err := m.Retrieve(date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rsched.Seed(), int64(42); got != want {
+		t.Errorf("got seed=%d, want %d", got, want)
+	}
+	if got, want := rsched.Anchor(), anchor; !got.Equal(want) {
+		t.Errorf("got anchor=%s, want %s", got, want)
+	}
+
+	if err := m.Publish(date, psched); err != schedstore.ErrAlreadyExists {
+		t.Errorf("got err=%v, want %v", err, schedstore.ErrAlreadyExists)
+	}
+
+	rsched, err = m.Retrieve(date.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rsched.Seed(), int64(0); got != want {
+		t.Errorf("got seed=%d on another day, want %d", got, want)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/snooze"
+)
+
+// Snooze implements snooze.Store.Snooze
+func (m MySQL) Snooze(sn snooze.Snooze) (err error) {
+	err = sn.Check()
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	// We must either commit or rollback at the end
+	defer func() {
+		switch err {
+		case nil:
+			err = tx.Commit()
+		default:
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Exec("DELETE FROM snoozes WHERE app = ? AND account = ? AND cluster = ?", sn.App, sn.Account, sn.Cluster)
+	if err != nil {
+		return errors.Wrap(err, "failed to replace snooze")
+	}
+
+	_, err = tx.Exec("INSERT INTO snoozes (app, account, cluster, expires, snoozed_by, reason, snoozed_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		sn.App, sn.Account, sn.Cluster, sn.Until.In(time.UTC), sn.By, sn.Reason, sn.At.In(time.UTC))
+	if err != nil {
+		return errors.Wrap(err, "failed to record snooze")
+	}
+
+	return nil
+}
+
+// Unsnooze implements snooze.Store.Unsnooze
+func (m MySQL) Unsnooze(app string, account string, cluster string, now time.Time) (bool, error) {
+	res, err := m.db.Exec("UPDATE snoozes SET expires = ? WHERE app = ? AND account = ? AND cluster = ? AND expires > ?",
+		now.In(time.UTC), app, account, cluster, now.In(time.UTC))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to end snooze")
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to count ended snoozes")
+	}
+
+	return n > 0, nil
+}
+
+// Snoozes implements snooze.Store.Snoozes
+func (m MySQL) Snoozes(now time.Time) (result []snooze.Snooze, err error) {
+	rows, err := m.db.Query("SELECT app, account, cluster, expires, snoozed_by, reason, snoozed_at FROM snoozes WHERE expires > ? ORDER BY app, account, cluster",
+		now.In(time.UTC))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve snoozes")
+	}
+
+	defer func() {
+		if cerr := rows.Close(); cerr != nil && err == nil {
+			err = errors.Wrap(cerr, "rows.Close() failed")
+		}
+	}()
+
+	for rows.Next() {
+		var sn snooze.Snooze
+		err = rows.Scan(&sn.App, &sn.Account, &sn.Cluster, &sn.Until, &sn.By, &sn.Reason, &sn.At)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan row")
+		}
+		result = append(result, sn)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "rows.Err() errored")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build docker
+// +build docker
+
+package mysql_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/mysql"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
+)
+
+// Test snoozes are replaced, ended early and expire
+func TestSnoozes(t *testing.T) {
+	err := initDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := mysql.New("localhost", port, "root", password, "chaosmonkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	snoozes := []snooze.Snooze{
+		{App: "foo", Until: now.Add(48 * time.Hour), By: "alice", Reason: "migration", At: now},
+		{App: "bar", Account: "prod", Cluster: "bar-prod", Until: now.Add(time.Hour), By: "bob", Reason: "load test", At: now},
+		{App: "baz", Until: now.Add(time.Hour), By: "bob", Reason: "ended early", At: now},
+	}
+	for _, s := range snoozes {
+		err = db.Snooze(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Snoozing again replaces the snooze
+	err = db.Snooze(snooze.Snooze{App: "bar", Account: "prod", Cluster: "bar-prod", Until: now.Add(2 * time.Hour), By: "bob", Reason: "longer load test", At: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ok, err := db.Unsnooze("baz", "", "", now.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Error("baz: got no snooze ended")
+	}
+
+	ok, err = db.Unsnooze("baz", "", "", now.Add(2*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Error("baz: got a snooze ended twice")
+	}
+
+	tests := []struct {
+		offset time.Duration
+		want   []string // app and reason of the active snoozes
+	}{
+		{30 * time.Second, []string{"bar/longer load test", "baz/ended early", "foo/migration"}},
+		{time.Hour, []string{"bar/longer load test", "foo/migration"}},
+		{3 * time.Hour, []string{"foo/migration"}},
+		{48 * time.Hour, nil},
+	}
+
+	for _, tt := range tests {
+		active, err := db.Snoozes(now.Add(tt.offset))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, s := range active {
+			got = append(got, s.App+"/"+s.Reason)
+		}
+
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("at +%s: got snoozes %v, want %v", tt.offset, got, tt.want)
+		}
+	}
+
+	active, err := db.Snoozes(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := active[0], (snooze.Snooze{App: "bar", Account: "prod", Cluster: "bar-prod", Until: now.Add(2 * time.Hour), By: "bob", Reason: "longer load test", At: now}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,383 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outage
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+)
+
+// Alertmanager is an outage checker that reports an outage while alerts that
+// match a set of label matchers are firing in Alertmanager, and for a
+// lookback period after they stop.
+//
+// Since terminations in cron mode each run in their own process, the result
+// of the last check can be kept in a state file, so that it is cached across
+// commands and the lookback period survives the alerts being resolved.
+//
+// Alerts can be scoped to an app, account or region by their labels. An
+// alert that has none of the scope labels affects every instance group.
+//
+// See: https://github.com/prometheus/alertmanager/blob/main/api/v2/openapi.yaml
+type Alertmanager struct {
+	endpoint  string
+	matchers  []string
+	client    *http.Client
+	cacheTTL  time.Duration
+	lookback  time.Duration
+	stateFile string
+	scope     map[string]string
+
+	mu    sync.Mutex
+	state *alertState // nil if not loaded yet
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+// alertState is the result of the last check, as kept in the state file
+type alertState struct {
+	// CheckedAt is when Alertmanager was last queried
+	CheckedAt time.Time `json:"checkedAt"`
+
+	// Alerts are the matching alerts that were firing then, or within the
+	// lookback period before
+	Alerts []seenAlert `json:"alerts"`
+}
+
+// seenAlert is an alert that was seen firing
+type seenAlert struct {
+	Labels     map[string]string `json:"labels"`
+	LastFiring time.Time         `json:"lastFiring"`
+}
+
+// legacyAlertState is the state file format of versions that only kept the
+// names of the firing alerts, and the last time any of them fired
+type legacyAlertState struct {
+	Firing     []string  `json:"firing"`
+	LastFiring time.Time `json:"lastFiring"`
+}
+
+// migrate returns the alerts recorded in a legacy state. Their scope labels
+// weren't kept, so they affect every instance group.
+func (l legacyAlertState) migrate() []seenAlert {
+	var result []seenAlert
+	for _, name := range l.Firing {
+		result = append(result, seenAlert{Labels: map[string]string{"alertname": name}, LastFiring: l.LastFiring})
+	}
+
+	// Alerts that stopped firing still count within the lookback period
+	if len(result) == 0 && !l.LastFiring.IsZero() {
+		result = append(result, seenAlert{Labels: map[string]string{}, LastFiring: l.LastFiring})
+	}
+
+	return result
+}
+
+// alert is an alert returned by the Alertmanager API
+type alert struct {
+	Labels map[string]string `json:"labels"`
+	Status struct {
+		State       string   `json:"state"`
+		SilencedBy  []string `json:"silencedBy"`
+		InhibitedBy []string `json:"inhibitedBy"`
+	} `json:"status"`
+}
+
+// NewAlertmanagerFromConfig creates a new Alertmanager taking config
+// parameters from cfg
+func NewAlertmanagerFromConfig(cfg *config.Monkey) (*Alertmanager, error) {
+	endpoint := cfg.AlertmanagerEndpoint()
+	if endpoint == "" {
+		return nil, errors.New("alertmanager.endpoint not specified")
+	}
+
+	matchers, err := cfg.AlertmanagerMatchers()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve alertmanager matchers")
+	}
+
+	a := NewAlertmanager(endpoint, matchers, cfg.AlertmanagerTimeout(), cfg.AlertmanagerCacheTTL(), cfg.AlertmanagerLookback(), cfg.AlertmanagerStateFile())
+
+	scope := cfg.AlertmanagerScope()
+	for key := range scope {
+		if key != "app" && key != "account" && key != "region" {
+			return nil, errors.Errorf("alertmanager.scope: unknown key %q, want app, account or region", key)
+		}
+	}
+	a.SetScope(scope)
+
+	return a, nil
+}
+
+// NewAlertmanager returns an outage checker that queries the Alertmanager
+// API at endpoint for firing alerts that match all of matchers, e.g.
+// severity=critical. Results are reused for cacheTTL, and kept in stateFile
+// if it isn't blank.
+func NewAlertmanager(endpoint string, matchers []string, timeout time.Duration, cacheTTL time.Duration, lookback time.Duration, stateFile string) *Alertmanager {
+	return &Alertmanager{
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		matchers:  matchers,
+		client:    &http.Client{Timeout: timeout},
+		cacheTTL:  cacheTTL,
+		lookback:  lookback,
+		stateFile: stateFile,
+		now:       time.Now,
+	}
+}
+
+// SetScope sets the alert labels that hold the app, account and region an
+// alert affects, keyed by "app", "account" and "region"
+func (a *Alertmanager) SetScope(scope map[string]string) {
+	a.scope = scope
+}
+
+// Outage implements chaosmonkey.Outage.Outage. It returns true if any
+// matching alert is firing.
+func (a *Alertmanager) Outage() (bool, error) {
+	return a.outage(nil)
+}
+
+// OutageIn implements chaosmonkey.ScopedOutage.OutageIn. It returns true if
+// a matching alert that affects group is firing.
+func (a *Alertmanager) OutageIn(group grp.InstanceGroup) (bool, error) {
+	return a.outage(group)
+}
+
+// outage returns true if a matching alert that affects group is firing, or
+// any matching alert if group is nil
+func (a *Alertmanager) outage(group grp.InstanceGroup) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+
+	if a.state == nil {
+		a.state = a.load()
+	}
+
+	if a.state.CheckedAt.IsZero() || now.Sub(a.state.CheckedAt) >= a.cacheTTL {
+		firing, err := a.firing()
+		if err != nil {
+			return false, err
+		}
+
+		a.update(now, firing)
+		a.save()
+	}
+
+	for _, al := range a.state.Alerts {
+		// Alerts firing at the last check count until the next one
+		if al.LastFiring.Before(a.state.CheckedAt) && now.Sub(al.LastFiring) >= a.lookback {
+			continue
+		}
+
+		if group != nil && !a.affects(al.Labels, group) {
+			continue
+		}
+
+		log.Printf("outage: alert %s was firing at %s", al.Labels["alertname"], al.LastFiring.Format(time.RFC3339))
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// update records the alerts firing at now, and forgets those that stopped
+// firing longer than the lookback period ago
+func (a *Alertmanager) update(now time.Time, firing []map[string]string) {
+	seen := make(map[string]bool)
+	var alerts []seenAlert
+	for _, labels := range firing {
+		k := labelsKey(labels)
+		if !seen[k] {
+			seen[k] = true
+			alerts = append(alerts, seenAlert{Labels: labels, LastFiring: now})
+		}
+	}
+
+	for _, al := range a.state.Alerts {
+		if !seen[labelsKey(al.Labels)] && now.Sub(al.LastFiring) < a.lookback {
+			alerts = append(alerts, al)
+		}
+	}
+
+	a.state.CheckedAt = now
+	a.state.Alerts = alerts
+}
+
+// affects returns true if an alert with labels affects group. Scope labels
+// that the alert doesn't have don't restrict it.
+func (a *Alertmanager) affects(labels map[string]string, group grp.InstanceGroup) bool {
+	// A group in any region may have instances in the alert's region
+	region, _ := group.Region()
+	values := map[string]string{"app": group.App(), "account": group.Account(), "region": region}
+
+	for key, label := range a.scope {
+		v, ok := labels[label]
+		if ok && values[key] != "" && v != values[key] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// labelsKey identifies an alert by its labels
+func labelsKey(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\xff")
+}
+
+// firing returns the labels of the matching alerts that are firing, and not
+// silenced or inhibited
+func (a *Alertmanager) firing() (firing []map[string]string, err error) {
+	q := url.Values{}
+	q.Set("active", "true")
+	q.Set("silenced", "false")
+	q.Set("inhibited", "false")
+	for _, m := range a.matchers {
+		q.Add("filter", m)
+	}
+	u := a.endpoint + "/api/v2/alerts?" + q.Encode()
+
+	resp, err := a.client.Get(u)
+	if err != nil {
+		return nil, errors.Wrapf(err, "http get failed at %s", u)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = errors.Wrapf(cerr, "body close failed at %s", u)
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "body read failed at %s", u)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected response code (%d) from %s: %s", resp.StatusCode, u, body)
+	}
+
+	var alerts []alert
+	err = json.Unmarshal(body, &alerts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse alerts from %s", u)
+	}
+
+	for _, al := range alerts {
+		// Alertmanager already filters these out, but older versions
+		// ignore the silenced and inhibited parameters
+		if al.Status.State == "suppressed" || len(al.Status.SilencedBy) > 0 || len(al.Status.InhibitedBy) > 0 {
+			continue
+		}
+		firing = append(firing, al.Labels)
+	}
+
+	return firing, nil
+}
+
+// load returns the state kept in the state file, or an empty state if there
+// is none
+func (a *Alertmanager) load() *alertState {
+	s := &alertState{}
+	if a.stateFile == "" {
+		return s
+	}
+
+	data, err := os.ReadFile(a.stateFile)
+	if os.IsNotExist(err) {
+		return s
+	}
+	if err != nil {
+		log.Printf("WARNING: could not read alertmanager state file: %v", err)
+		return s
+	}
+
+	err = json.Unmarshal(data, s)
+	if err != nil {
+		log.Printf("WARNING: could not parse alertmanager state file %s: %v", a.stateFile, err)
+		return &alertState{}
+	}
+
+	// A file written by an older version has the same checkedAt field, but
+	// no alerts field. Without migrating it, alerts that were firing would
+	// be forgotten until the cache expires.
+	if s.Alerts == nil {
+		var legacy legacyAlertState
+		err = json.Unmarshal(data, &legacy)
+		if err != nil {
+			log.Printf("WARNING: could not parse alertmanager state file %s: %v", a.stateFile, err)
+			return &alertState{}
+		}
+		s.Alerts = legacy.migrate()
+	}
+
+	return s
+}
+
+// save writes the state to the state file, if there is one. A failure only
+// costs an extra query, so it is logged rather than returned.
+func (a *Alertmanager) save() {
+	if a.stateFile == "" {
+		return
+	}
+
+	data, err := json.Marshal(a.state)
+	if err != nil {
+		log.Printf("WARNING: could not encode alertmanager state: %v", err)
+		return
+	}
+
+	// Write to a temporary file, then rename it, so that concurrent commands
+	// never read a partial file
+	f, err := os.CreateTemp(filepath.Dir(a.stateFile), filepath.Base(a.stateFile)+".*")
+	if err != nil {
+		log.Printf("WARNING: could not write alertmanager state file: %v", err)
+		return
+	}
+
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), a.stateFile)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		log.Printf("WARNING: could not write alertmanager state file %s: %v", a.stateFile, err)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/grp"
+)
+
+const (
+	firingAlert   = `{"labels": {"alertname": "HighErrorRate", "severity": "critical"}, "status": {"state": "active", "silencedBy": [], "inhibitedBy": []}}`
+	silencedAlert = `{"labels": {"alertname": "DiskFull", "severity": "critical"}, "status": {"state": "suppressed", "silencedBy": ["abc"], "inhibitedBy": []}}`
+)
+
+// alertmanagerStandIn serves body as the alerts, and records the queries it
+// receives
+type alertmanagerStandIn struct {
+	body    string
+	queries []map[string][]string
+}
+
+func (s *alertmanagerStandIn) start(t *testing.T) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/alerts" {
+			http.NotFound(w, r)
+			return
+		}
+		s.queries = append(s.queries, r.URL.Query())
+		_, _ = w.Write([]byte(s.body))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestAlertmanagerOutage(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want bool
+	}{
+		{"no alerts", `[]`, false},
+		{"firing", "[" + firingAlert + "]", true},
+		{"silenced", "[" + silencedAlert + "]", false},
+	}
+
+	for _, tt := range tests {
+		s := &alertmanagerStandIn{body: tt.body}
+		ts := s.start(t)
+		a := NewAlertmanager(ts.URL+"/", []string{"severity=critical", `team=~"core|infra"`}, time.Second, 0, 0, "")
+
+		got, err := a.Outage()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("%s: got outage=%t, want %t", tt.name, got, tt.want)
+		}
+
+		want := map[string][]string{
+			"active":    {"true"},
+			"silenced":  {"false"},
+			"inhibited": {"false"},
+			"filter":    {"severity=critical", `team=~"core|infra"`},
+		}
+		if got := s.queries[0]; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got query %v, want %v", tt.name, got, want)
+		}
+	}
+}
+
+func TestAlertmanagerError(t *testing.T) {
+	ts := statusServer(t, 500, "", nil)
+
+	_, err := NewAlertmanager(ts.URL, nil, time.Second, 0, 0, "").Outage()
+	if err == nil {
+		t.Error("got no error")
+	}
+}
+
+// The outage lasts for the lookback period after the alerts stop firing
+func TestAlertmanagerLookback(t *testing.T) {
+	s := &alertmanagerStandIn{body: "[" + firingAlert + "]"}
+	ts := s.start(t)
+
+	start := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	a := NewAlertmanager(ts.URL, nil, time.Second, 0, 10*time.Minute, "")
+
+	checks := []struct {
+		elapsed time.Duration
+		body    string
+		want    bool
+	}{
+		{0, "[" + firingAlert + "]", true},
+		{time.Minute, `[]`, true},
+		{9 * time.Minute, `[]`, true},
+		{10 * time.Minute, `[]`, false},
+	}
+
+	for _, c := range checks {
+		s.body = c.body
+		a.now = func() time.Time { return start.Add(c.elapsed) }
+
+		got, err := a.Outage()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != c.want {
+			t.Errorf("after %v: got outage=%t, want %t", c.elapsed, got, c.want)
+		}
+	}
+}
+
+// Results are cached across checkers that share a state file, as commands in
+// cron mode do
+func TestAlertmanagerStateFile(t *testing.T) {
+	s := &alertmanagerStandIn{body: "[" + firingAlert + "]"}
+	ts := s.start(t)
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+
+	start := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	check := func(elapsed time.Duration) bool {
+		a := NewAlertmanager(ts.URL, nil, time.Second, time.Minute, 5*time.Minute, stateFile)
+		a.now = func() time.Time { return start.Add(elapsed) }
+
+		outage, err := a.Outage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return outage
+	}
+
+	if !check(0) {
+		t.Error("got no outage while firing")
+	}
+
+	// Cached
+	s.body = `[]`
+	if !check(30 * time.Second) {
+		t.Error("got no outage from the cache")
+	}
+
+	if got, want := len(s.queries), 1; got != want {
+		t.Errorf("got %d queries, want %d", got, want)
+	}
+
+	// Resolved, but within the lookback period
+	if !check(2 * time.Minute) {
+		t.Error("got no outage within lookback")
+	}
+
+	if check(6 * time.Minute) {
+		t.Error("got outage after lookback")
+	}
+}
+
+// State files written before alerts were scoped are migrated: the alerts they
+// recorded still count, and affect every instance group
+func TestAlertmanagerLegacyStateFile(t *testing.T) {
+	s := &alertmanagerStandIn{body: `[]`}
+	ts := s.start(t)
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+
+	tests := []struct {
+		name  string
+		state string
+		want  bool
+	}{
+		{"firing", `{"checkedAt": "2026-10-19T09:00:00Z", "firing": ["HighErrorRate"], "lastFiring": "2026-10-19T09:00:00Z"}`, true},
+		{"within lookback", `{"checkedAt": "2026-10-19T09:00:00Z", "firing": null, "lastFiring": "2026-10-19T08:57:00Z"}`, true},
+		{"after lookback", `{"checkedAt": "2026-10-19T09:00:00Z", "firing": null, "lastFiring": "2026-10-19T08:50:00Z"}`, false},
+		{"never fired", `{"checkedAt": "2026-10-19T09:00:00Z", "firing": null, "lastFiring": "0001-01-01T00:00:00Z"}`, false},
+	}
+
+	for _, tt := range tests {
+		err := os.WriteFile(stateFile, []byte(tt.state), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		a := NewAlertmanager(ts.URL, nil, time.Second, time.Minute, 5*time.Minute, stateFile)
+		a.SetScope(map[string]string{"region": "aws_region"})
+		a.now = func() time.Time { return time.Date(2026, time.October, 19, 9, 0, 30, 0, time.UTC) }
+
+		got, err := a.OutageIn(grp.New("foo", "prod", "us-east-1", "", ""))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		if got != tt.want {
+			t.Errorf("%s: got outage=%t, want %t", tt.name, got, tt.want)
+		}
+	}
+
+	// The state was still fresh, so Alertmanager wasn't queried
+	if got, want := len(s.queries), 0; got != want {
+		t.Errorf("got %d queries, want %d", got, want)
+	}
+}
+
+// Alerts only stop terminations in the app, account and region they affect
+func TestAlertmanagerScope(t *testing.T) {
+	s := &alertmanagerStandIn{body: `[
+		{"labels": {"alertname": "RegionDown", "severity": "critical", "aws_region": "us-west-2"}, "status": {"state": "active"}},
+		{"labels": {"alertname": "FooDown", "severity": "critical", "service": "foo", "env": "test"}, "status": {"state": "active"}}
+	]`}
+	ts := s.start(t)
+
+	a := NewAlertmanager(ts.URL, nil, time.Second, time.Minute, 0, "")
+	a.SetScope(map[string]string{"app": "service", "account": "env", "region": "aws_region"})
+
+	tests := []struct {
+		group grp.InstanceGroup
+		want  bool
+	}{
+		{grp.New("bar", "prod", "us-east-1", "", ""), false},
+		{grp.New("bar", "prod", "us-west-2", "", ""), true},
+		{grp.New("bar", "prod", "", "", ""), true},
+		{grp.New("foo", "prod", "us-east-1", "", ""), false},
+		{grp.New("foo", "test", "us-east-1", "", ""), true},
+	}
+
+	for _, tt := range tests {
+		got, err := a.OutageIn(tt.group)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != tt.want {
+			t.Errorf("%s: got outage=%t, want %t", grp.String(tt.group), got, tt.want)
+		}
+	}
+
+	// Any alert is an outage for unscoped checks
+	got, err := a.Outage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got {
+		t.Error("got no outage, want outage")
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outage
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/config"
+)
+
+// maxBodySize is the most of a response body that is read
+const maxBodySize = 1 << 20
+
+// Criteria tell if a response of a health or status endpoint means there is
+// an outage. A response means there is an outage if any criterion matches.
+type Criteria struct {
+	// StatusCodes are the status codes that mean there is an outage. Other
+	// non-2xx status codes are errors.
+	StatusCodes []int
+
+	// JSONPath is the dotted path of a field of the JSON body, e.g.
+	// "status.indicator" or "incidents.0.severity". Ignored if blank.
+	JSONPath string
+
+	// JSONValues are the values of the field at JSONPath that mean there is
+	// an outage. If empty, the field must be a boolean that is true during
+	// an outage.
+	JSONValues []string
+
+	// BodyPattern matches bodies that mean there is an outage. Ignored if
+	// nil.
+	BodyPattern *regexp.Regexp
+}
+
+// HTTPOutage is an outage checker that polls health or incident status
+// endpoints over HTTP
+type HTTPOutage struct {
+	urls     []string
+	criteria Criteria
+	client   *http.Client
+	cacheTTL time.Duration
+	failOpen bool
+
+	mu      sync.Mutex
+	checked time.Time // zero if never checked
+	outage  bool
+	err     error
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+// NewHTTPFromConfig creates a new HTTPOutage taking config parameters from
+// cfg
+func NewHTTPFromConfig(cfg *config.Monkey) (*HTTPOutage, error) {
+	urls, err := cfg.HTTPOutageURLs()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve http outage urls")
+	}
+
+	if len(urls) == 0 {
+		return nil, errors.New("http outage checker has no urls")
+	}
+
+	codes, err := cfg.HTTPOutageStatusCodes()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve http outage status codes")
+	}
+
+	values, err := cfg.HTTPOutageJSONOutageValues()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve http outage json values")
+	}
+
+	criteria := Criteria{StatusCodes: codes, JSONPath: cfg.HTTPOutageJSONPath(), JSONValues: values}
+
+	if pattern := cfg.HTTPOutageBodyPattern(); pattern != "" {
+		criteria.BodyPattern, err = regexp.Compile(pattern)
+		if err != nil {
+			return nil, errors.Wrap(err, "bad http outage body pattern")
+		}
+	}
+
+	return NewHTTP(urls, criteria, cfg.HTTPOutageTimeout(), cfg.HTTPOutageCacheTTL(), cfg.HTTPOutageFailOpen()), nil
+}
+
+// NewHTTP returns an outage checker that reports an outage if the response
+// of any of urls meets criteria. Results are reused for cacheTTL. If
+// failOpen is true, a failed check is treated as no outage, otherwise
+// Outage returns an error.
+func NewHTTP(urls []string, criteria Criteria, timeout time.Duration, cacheTTL time.Duration, failOpen bool) *HTTPOutage {
+	return &HTTPOutage{
+		urls:     urls,
+		criteria: criteria,
+		client:   &http.Client{Timeout: timeout},
+		cacheTTL: cacheTTL,
+		failOpen: failOpen,
+		now:      time.Now,
+	}
+}
+
+// Outage implements chaosmonkey.Outage.Outage
+func (h *HTTPOutage) Outage() (bool, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	if !h.checked.IsZero() && now.Sub(h.checked) < h.cacheTTL {
+		return h.outage, h.err
+	}
+
+	outage, err := h.check()
+	if err != nil && h.failOpen {
+		log.Printf("WARNING: outage check failed, assuming there is no outage: %v", err)
+		outage, err = false, nil
+	}
+
+	h.checked, h.outage, h.err = now, outage, err
+	return outage, err
+}
+
+// check polls every URL. An outage reported by one URL takes precedence over
+// errors checking the others.
+func (h *HTTPOutage) check() (bool, error) {
+	var firstErr error
+	for _, u := range h.urls {
+		outage, err := h.checkURL(u)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		if outage {
+			log.Printf("outage reported by %s", u)
+			return true, nil
+		}
+	}
+
+	return false, firstErr
+}
+
+// checkURL returns true if the response of u means there is an outage
+func (h *HTTPOutage) checkURL(u string) (outage bool, err error) {
+	resp, err := h.client.Get(u)
+	if err != nil {
+		return false, errors.Wrapf(err, "http get failed at %s", u)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = errors.Wrapf(cerr, "body close failed at %s", u)
+		}
+	}()
+
+	for _, code := range h.criteria.StatusCodes {
+		if resp.StatusCode == code {
+			return true, nil
+		}
+	}
+
+	if resp.StatusCode/100 != 2 {
+		return false, errors.Errorf("unexpected response code (%d) from %s", resp.StatusCode, u)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return false, errors.Wrapf(err, "body read failed at %s", u)
+	}
+
+	if h.criteria.BodyPattern != nil && h.criteria.BodyPattern.Match(body) {
+		return true, nil
+	}
+
+	if h.criteria.JSONPath != "" {
+		outage, err := jsonOutage(body, h.criteria.JSONPath, h.criteria.JSONValues)
+		if err != nil {
+			return false, errors.Wrapf(err, "bad response from %s", u)
+		}
+		return outage, nil
+	}
+
+	return false, nil
+}
+
+// jsonOutage returns true if the field at path of the JSON body is one of
+// values, or is true if there are no values
+func jsonOutage(body []byte, path string, values []string) (bool, error) {
+	var doc interface{}
+	err := json.Unmarshal(body, &doc)
+	if err != nil {
+		return false, errors.Wrap(err, "could not parse json")
+	}
+
+	field, err := lookup(doc, path)
+	if err != nil {
+		return false, err
+	}
+
+	if len(values) == 0 {
+		b, ok := field.(bool)
+		if !ok {
+			return false, errors.Errorf("%s is not a boolean: %v", path, field)
+		}
+		return b, nil
+	}
+
+	var s string
+	switch f := field.(type) {
+	case string:
+		s = f
+	case bool:
+		s = strconv.FormatBool(f)
+	case float64:
+		s = strconv.FormatFloat(f, 'f', -1, 64)
+	default:
+		return false, errors.Errorf("%s is not a string, number or boolean: %v", path, field)
+	}
+
+	for _, v := range values {
+		if s == v {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// lookup returns the value at a dotted path of a parsed JSON document.
+// Elements of arrays are selected by index.
+func lookup(doc interface{}, path string) (interface{}, error) {
+	v := doc
+	for _, key := range strings.Split(path, ".") {
+		switch node := v.(type) {
+		case map[string]interface{}:
+			child, ok := node[key]
+			if !ok {
+				return nil, errors.Errorf("%s: no field %q", path, key)
+			}
+			v = child
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, errors.Errorf("%s: no element %q", path, key)
+			}
+			v = node[i]
+		default:
+			return nil, errors.Errorf("%s: cannot select %q of %v", path, key, v)
+		}
+	}
+	return v, nil
+}
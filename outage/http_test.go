@@ -0,0 +1,144 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+)
+
+// statusServer returns a server that responds with status and body, and
+// counts requests in calls
+func statusServer(t *testing.T, status int, body string, calls *int) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls != nil {
+			*calls++
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestHTTPOutageCriteria(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		criteria Criteria
+		want     bool
+	}{
+		{"healthy", 200, "ok", Criteria{}, false},
+		{"outage status code", 503, "", Criteria{StatusCodes: []int{503}}, true},
+		{"json value", 200, `{"status": {"indicator": "major"}}`, Criteria{JSONPath: "status.indicator", JSONValues: []string{"major", "critical"}}, true},
+		{"json other value", 200, `{"status": {"indicator": "none"}}`, Criteria{JSONPath: "status.indicator", JSONValues: []string{"major", "critical"}}, false},
+		{"json number", 200, `{"incidents": [{"sev": 1}]}`, Criteria{JSONPath: "incidents.0.sev", JSONValues: []string{"0", "1"}}, true},
+		{"json boolean", 200, `{"outage": true}`, Criteria{JSONPath: "outage"}, true},
+		{"json false", 200, `{"outage": false}`, Criteria{JSONPath: "outage"}, false},
+		{"body match", 200, "SEV1 in progress", Criteria{BodyPattern: regexp.MustCompile(`SEV[12]\b`)}, true},
+		{"body mismatch", 200, "SEV3 in progress", Criteria{BodyPattern: regexp.MustCompile(`SEV[12]\b`)}, false},
+	}
+
+	for _, tt := range tests {
+		ts := statusServer(t, tt.status, tt.body, nil)
+		h := NewHTTP([]string{ts.URL}, tt.criteria, time.Second, 0, false)
+
+		got, err := h.Outage()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("%s: got outage=%t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestHTTPOutageErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		criteria Criteria
+	}{
+		{"server error", 500, "", Criteria{StatusCodes: []int{503}}},
+		{"not json", 200, "<html>", Criteria{JSONPath: "outage"}},
+		{"missing field", 200, `{"status": {}}`, Criteria{JSONPath: "status.indicator", JSONValues: []string{"major"}}},
+		{"not a boolean", 200, `{"outage": "yes"}`, Criteria{JSONPath: "outage"}},
+	}
+
+	for _, tt := range tests {
+		ts := statusServer(t, tt.status, tt.body, nil)
+
+		// Fail closed
+		_, err := NewHTTP([]string{ts.URL}, tt.criteria, time.Second, 0, false).Outage()
+		if err == nil {
+			t.Errorf("%s: got no error when failing closed", tt.name)
+		}
+
+		// Fail open
+		got, err := NewHTTP([]string{ts.URL}, tt.criteria, time.Second, 0, true).Outage()
+		if err != nil || got {
+			t.Errorf("%s: got outage=%t, err=%v when failing open, want no outage", tt.name, got, err)
+		}
+	}
+}
+
+// An outage reported by one URL wins over a failure of another
+func TestHTTPOutageMultipleURLs(t *testing.T) {
+	failing := statusServer(t, 500, "", nil)
+	healthy := statusServer(t, 200, "", nil)
+	down := statusServer(t, 503, "", nil)
+	criteria := Criteria{StatusCodes: []int{503}}
+
+	got, err := NewHTTP([]string{failing.URL, healthy.URL, down.URL}, criteria, time.Second, 0, false).Outage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got {
+		t.Error("got no outage, want outage")
+	}
+
+	_, err = NewHTTP([]string{healthy.URL, failing.URL}, criteria, time.Second, 0, false).Outage()
+	if err == nil {
+		t.Error("got no error, want error")
+	}
+}
+
+func TestHTTPOutageCache(t *testing.T) {
+	var calls int
+	ts := statusServer(t, 200, "", &calls)
+
+	now := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	h := NewHTTP([]string{ts.URL}, Criteria{}, time.Second, time.Minute, false)
+
+	for _, elapsed := range []time.Duration{0, 30 * time.Second, 59 * time.Second, time.Minute, 90 * time.Second} {
+		h.now = func() time.Time { return now.Add(elapsed) }
+		_, err := h.Outage()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Checked at 0 and 1m
+	if got, want := calls, 2; got != want {
+		t.Errorf("got %d requests, want %d", got, want)
+	}
+}
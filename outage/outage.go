@@ -12,13 +12,16 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package outage provides a default no-op outage implementation
+// Package outage provides outage checkers: a default no-op one, one that
+// polls health or incident status endpoints over HTTP, and one that asks
+// Alertmanager for firing alerts
 package outage
 
 import (
 	"github.com/Netflix/chaosmonkey/v2"
 	"github.com/Netflix/chaosmonkey/v2/config"
 	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/grp"
 	"github.com/pkg/errors"
 )
 
@@ -30,15 +33,53 @@ func (n NullOutage) Outage() (bool, error) {
 	return false, nil
 }
 
+// Scoped returns o as a ScopedOutage. Outage checkers that aren't scoped are
+// adapted so that an outage affects every instance group.
+func Scoped(o chaosmonkey.Outage) chaosmonkey.ScopedOutage {
+	if s, ok := o.(chaosmonkey.ScopedOutage); ok {
+		return s
+	}
+	return global{o}
+}
+
+// global adapts an outage checker that isn't scoped
+type global struct {
+	ou chaosmonkey.Outage
+}
+
+// Outage implements chaosmonkey.Outage.Outage
+func (g global) Outage() (bool, error) {
+	return g.ou.Outage()
+}
+
+// OutageIn returns true if there is an outage anywhere
+func (g global) OutageIn(group grp.InstanceGroup) (bool, error) {
+	return g.ou.Outage()
+}
+
 func init() {
 	deps.GetOutage = GetOutage
 }
 
-// GetOutage returns a do-nothing outage checker
+// GetOutage returns the outage checker selected by the config, which is a
+// do-nothing one by default
 func GetOutage(cfg *config.Monkey) (chaosmonkey.Outage, error) {
-	checker := cfg.OutageChecker()
-	if checker != "" {
+	switch checker := cfg.OutageChecker(); checker {
+	case "":
+		return NullOutage{}, nil
+	case "http":
+		h, err := NewHTTPFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return h, nil
+	case "alertmanager":
+		a, err := NewAlertmanagerFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return a, nil
+	default:
 		return nil, errors.Errorf("unknown outage provider: %s", checker)
 	}
-	return NullOutage{}, nil
 }
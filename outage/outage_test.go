@@ -0,0 +1,46 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outage
+
+import (
+	"testing"
+
+	"github.com/Netflix/chaosmonkey/v2/grp"
+)
+
+// down is a global outage checker that always reports an outage
+type down struct{}
+
+func (down) Outage() (bool, error) {
+	return true, nil
+}
+
+func TestScopedAdaptsGlobalCheckers(t *testing.T) {
+	got, err := Scoped(down{}).OutageIn(grp.New("foo", "prod", "us-east-1", "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !got {
+		t.Error("got no outage, want outage in every group")
+	}
+}
+
+func TestScopedKeepsScopedCheckers(t *testing.T) {
+	a := NewAlertmanager("http://localhost", nil, 0, 0, 0, "")
+	if got := Scoped(a); got != a {
+		t.Errorf("got %v, want the checker itself", got)
+	}
+}
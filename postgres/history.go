@@ -0,0 +1,104 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/history"
+)
+
+// Terminations implements history.Store.Terminations
+func (p Postgres) Terminations(q history.Query) (records []history.Record, err error) {
+	query := "SELECT app, account, stack, cluster, region, asg, instance_id, killed_at, leashed FROM terminations WHERE 1 = 1"
+	var args []interface{}
+
+	// where appends a condition on column to the query
+	where := func(column string, value interface{}) {
+		args = append(args, value)
+		query += fmt.Sprintf(" AND %s = $%d", column, len(args))
+	}
+
+	if q.App != "" {
+		where("app", q.App)
+	}
+
+	if q.Account != "" {
+		where("account", q.Account)
+	}
+
+	if q.Region != "" {
+		where("region", q.Region)
+	}
+
+	if q.Stack != "" {
+		where("stack", q.Stack)
+	}
+
+	if q.Cluster != "" {
+		where("cluster", q.Cluster)
+	}
+
+	if !q.Since.IsZero() {
+		args = append(args, q.Since.UTC())
+		query += fmt.Sprintf(" AND killed_at >= $%d", len(args))
+	}
+
+	if !q.Until.IsZero() {
+		args = append(args, q.Until.UTC())
+		query += fmt.Sprintf(" AND killed_at < $%d", len(args))
+	}
+
+	if q.Leashed != nil {
+		where("leashed", *q.Leashed)
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = history.DefaultLimit
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf(" ORDER BY killed_at DESC LIMIT $%d", len(args))
+
+	rows, err := p.db.Query(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query terminations")
+	}
+
+	defer func() {
+		if cerr := rows.Close(); cerr != nil && err == nil {
+			err = errors.Wrap(cerr, "rows.Close() failed")
+		}
+	}()
+
+	for rows.Next() {
+		var r history.Record
+		err = rows.Scan(&r.App, &r.Account, &r.Stack, &r.Cluster, &r.Region, &r.ASG, &r.InstanceID, &r.KilledAt, &r.Leashed)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan row")
+		}
+		r.KilledAt = r.KilledAt.UTC()
+		records = append(records, r)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "rows.Err() errored")
+	}
+
+	return records, nil
+}
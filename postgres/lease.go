@@ -0,0 +1,106 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/lease"
+)
+
+// Acquire implements lease.Store.Acquire
+//
+// If a concurrent acquisition makes the transaction fail, it is retried.
+func (p Postgres) Acquire(name string, holder string, now time.Time, ttl time.Duration) (result lease.Lease, err error) {
+	err = withRetries(func() error {
+		result, err = p.acquire(name, holder, now, ttl)
+		return err
+	})
+	return result, err
+}
+
+// acquire attempts to acquire the lease in a single transaction
+func (p Postgres) acquire(name string, holder string, now time.Time, ttl time.Duration) (result lease.Lease, err error) {
+	tx, err := p.begin()
+	if err != nil {
+		return lease.Lease{}, err
+	}
+
+	defer func() {
+		switch err {
+		case nil:
+			err = tx.Commit()
+		default:
+			_ = tx.Rollback()
+		}
+	}()
+
+	var cur *lease.Lease
+	l := lease.Lease{Name: name}
+	err = tx.QueryRow("SELECT holder, token, expires_at FROM leases WHERE name = $1 FOR UPDATE", name).Scan(&l.Holder, &l.Token, &l.Expires)
+	switch err {
+	case nil:
+		cur = &l
+	case sql.ErrNoRows:
+		// lease doesn't exist yet
+	default:
+		return lease.Lease{}, errors.Wrapf(err, "failed to read lease %s", name)
+	}
+
+	next, ok := lease.Claim(cur, name, holder, now, ttl)
+	if !ok {
+		return next, nil
+	}
+
+	if cur == nil {
+		_, err = tx.Exec("INSERT INTO leases (name, holder, token, expires_at) VALUES ($1, $2, $3, $4)", name, next.Holder, next.Token, next.Expires.UTC())
+	} else {
+		_, err = tx.Exec("UPDATE leases SET holder = $1, token = $2, expires_at = $3 WHERE name = $4", next.Holder, next.Token, next.Expires.UTC(), name)
+	}
+
+	if err != nil {
+		return lease.Lease{}, errors.Wrapf(err, "failed to write lease %s", name)
+	}
+
+	return next, nil
+}
+
+// Release implements lease.Store.Release
+func (p Postgres) Release(name string, holder string, now time.Time) error {
+	_, err := p.db.Exec("UPDATE leases SET expires_at = $1 WHERE name = $2 AND holder = $3", now.UTC(), name, holder)
+	if err != nil {
+		return errors.Wrapf(err, "failed to release lease %s", name)
+	}
+	return nil
+}
+
+// checkFencingToken returns an error if token is not the current token of
+// the leader lease
+func checkFencingToken(tx *sql.Tx, token int64) error {
+	var current int64
+	err := tx.QueryRow("SELECT token FROM leases WHERE name = $1", lease.Name).Scan(&current)
+	if err != nil && err != sql.ErrNoRows {
+		return errors.Wrap(err, "failed to read fencing token")
+	}
+
+	if current != token {
+		return lease.ErrStaleToken{Token: token, Current: current}
+	}
+
+	return nil
+}
@@ -0,0 +1,487 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package postgres provides a PostgreSQL-backed store for schedules and
+// terminations
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rubenv/sql-migrate"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/cal"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/migration"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+const (
+	// dateFormat is how dates are passed for DATE columns
+	dateFormat = "2006-01-02"
+
+	// maxAttempts is the number of times a transaction is attempted when it
+	// fails because of a conflict with a concurrent transaction
+	maxAttempts = 3
+
+	// retryDelay is how long to wait before retrying a failed transaction.
+	// It is multiplied by the attempt number.
+	retryDelay = 100 * time.Millisecond
+)
+
+// See: https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	serializationFailure = pq.ErrorCode("40001")
+	deadlockDetected     = pq.ErrorCode("40P01")
+)
+
+// Postgres represents a PostgreSQL-backed store for schedules and terminations
+type Postgres struct {
+	db *sql.DB
+}
+
+// TxDeadlock returns true if the error is because of a transaction deadlock
+func TxDeadlock(err error) bool {
+	return hasCode(err, deadlockDetected)
+}
+
+// TxSerializationFailure returns true if the error is because a serializable
+// transaction could not be committed due to a concurrent transaction
+func TxSerializationFailure(err error) bool {
+	return hasCode(err, serializationFailure)
+}
+
+// hasCode returns true if err is a postgres error with the given code
+func hasCode(err error, code pq.ErrorCode) bool {
+	switch err := errors.Cause(err).(type) {
+	case *pq.Error:
+		return err.Code == code
+	default:
+		return false
+	}
+}
+
+// retryable returns true if a transaction that failed with err can be
+// safely retried
+func retryable(err error) bool {
+	return TxSerializationFailure(err) || TxDeadlock(err)
+}
+
+// withRetries calls f until it succeeds, it fails with an error that is not
+// retryable, or it has been called maxAttempts times.
+// Each call to f must run in its own transaction.
+func withRetries(f func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || !retryable(err) || attempt == maxAttempts {
+			return err
+		}
+
+		log.Printf("transaction conflict on attempt %d/%d, retrying: %v", attempt, maxAttempts, err)
+		time.Sleep(time.Duration(attempt) * retryDelay)
+	}
+}
+
+// ViolatesMinTime returns true if the error violates min time between
+// terminations
+func ViolatesMinTime(err error) bool {
+	_, ok := errors.Cause(err).(chaosmonkey.ErrViolatesMinTime)
+	return ok
+}
+
+// NewFromConfig creates a new Postgres taking config parameters from cfg
+func NewFromConfig(cfg *config.Monkey) (Postgres, error) {
+	if cfg.DatabaseHost() == "" {
+		return Postgres{}, errors.Errorf("%s not specified", param.DatabaseHost)
+	}
+
+	decryptor, err := deps.GetDecryptor(cfg)
+	if err != nil {
+		return Postgres{}, err
+	}
+
+	password, err := decryptor.Decrypt(cfg.DatabaseEncryptedPassword())
+	if err != nil {
+		return Postgres{}, err
+	}
+
+	return New(cfg.DatabaseHost(), cfg.DatabasePort(), cfg.DatabaseUser(), password, cfg.DatabaseName(), cfg.DatabaseSSLMode())
+}
+
+// New creates a new Postgres. If sslMode is blank, the driver default is used.
+func New(host string, port int, user string, password string, dbname string, sslMode string) (Postgres, error) {
+	db, err := sql.Open("postgres", dsn(host, port, user, password, dbname, sslMode))
+	if err != nil {
+		return Postgres{}, errors.Wrap(err, "sql.Open failed")
+	}
+
+	return Postgres{db}, nil
+}
+
+// Close closes the underlying sql.DB
+func (p Postgres) Close() error {
+	return p.db.Close()
+}
+
+// dsn returns a postgres connection URL (data source name)
+// See: https://godoc.org/github.com/lib/pq#hdr-Connection_String_Parameters
+func dsn(host string, port int, user string, password string, dbname string, sslMode string) string {
+	query := url.Values{}
+	if sslMode != "" {
+		query.Set("sslmode", sslMode)
+	}
+
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(user, password),
+		Host:     net.JoinHostPort(host, strconv.Itoa(port)),
+		Path:     "/" + dbname,
+		RawQuery: query.Encode(),
+	}
+
+	return u.String()
+}
+
+// begin starts a serializable transaction. We need serializable transactions
+// for atomic test & set behavior
+func (p Postgres) begin() (*sql.Tx, error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+
+	return tx, nil
+}
+
+// sqlDate formats a date in a local time zone as a DATE column value
+func sqlDate(date time.Time) string {
+	return date.Format(dateFormat)
+}
+
+// Retrieve retrieves the schedule for the given date
+func (p Postgres) Retrieve(date time.Time) (sched *schedule.Schedule, err error) {
+	rows, err := p.db.Query("SELECT time, app, account, region, stack, cluster FROM schedules WHERE date = $1", sqlDate(date))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve schedule for %s", date)
+	}
+
+	sched = schedule.New()
+
+	defer func() {
+		if cerr := rows.Close(); cerr != nil && err == nil {
+			err = errors.Wrap(cerr, "rows.Close() failed")
+		}
+	}()
+
+	for rows.Next() {
+		var tm time.Time
+		var app, account, region, stack, cluster string
+
+		err = rows.Scan(&tm, &app, &account, &region, &stack, &cluster)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan row")
+		}
+
+		sched.Add(tm.UTC(), grp.New(app, account, region, stack, cluster))
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "rows.Err() errored")
+	}
+
+	var seed int64
+	var anchor sql.NullTime
+	err = p.db.QueryRow("SELECT seed, anchor FROM schedule_seeds WHERE date = $1", sqlDate(date)).Scan(&seed, &anchor)
+	switch {
+	case err == sql.ErrNoRows:
+		// Schedules published before seeds were recorded have none. Those
+		// published before anchors were recorded have a NULL anchor.
+		err = nil
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to retrieve schedule seed for %s", date)
+	}
+	sched.SetSeed(seed)
+	sched.SetAnchor(anchor.Time)
+
+	return sched, nil
+}
+
+// Publish publishes the schedule for the given date
+func (p Postgres) Publish(date time.Time, sched *schedule.Schedule) error {
+	return p.PublishWithDelay(date, sched, 0)
+}
+
+// PublishWithDelay publishes the schedule with a delay between checking the schedule
+// exists and writing it. The delay is used only for testing race conditions
+//
+// If a concurrent publish makes the transaction fail, it is retried, and
+// will then return schedstore.ErrAlreadyExists
+func (p Postgres) PublishWithDelay(date time.Time, sched *schedule.Schedule, delay time.Duration) error {
+	return withRetries(func() error {
+		return p.publish(date, sched, delay)
+	})
+}
+
+// publish publishes the schedule in a single transaction
+func (p Postgres) publish(date time.Time, sched *schedule.Schedule, delay time.Duration) (err error) {
+	tx, err := p.begin()
+	if err != nil {
+		return err
+	}
+
+	// We must either commit or rollback at the end
+	defer func() {
+		switch err {
+		case nil:
+			err = tx.Commit()
+		default:
+			_ = tx.Rollback()
+		}
+	}()
+
+	exists, err := schedExists(tx, date)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return schedstore.ErrAlreadyExists
+	}
+
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+
+	query := "INSERT INTO schedules (date, time, app, account, region, stack, cluster) VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	stmt, err := tx.Prepare(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to prepare sql statement: %s", query)
+	}
+
+	defer func() {
+		if cerr := stmt.Close(); cerr != nil && err == nil {
+			err = errors.Wrap(cerr, "stmt.Close() failed")
+		}
+	}()
+
+	for _, entry := range sched.Entries() {
+		var app, account, region, stack, cluster string
+		app = entry.Group.App()
+		account = entry.Group.Account()
+		if val, ok := entry.Group.Region(); ok {
+			region = val
+		}
+		if val, ok := entry.Group.Stack(); ok {
+			stack = val
+		}
+		if val, ok := entry.Group.Cluster(); ok {
+			cluster = val
+		}
+
+		_, err = stmt.Exec(sqlDate(date), entry.Time.UTC(), app, account, region, stack, cluster)
+		if err != nil {
+			return errors.Wrapf(err, "failed to execute prepared query")
+		}
+	}
+
+	if sched.Seed() != 0 {
+		anchor := sql.NullTime{Time: sched.Anchor().UTC(), Valid: !sched.Anchor().IsZero()}
+		_, err = tx.Exec("INSERT INTO schedule_seeds (date, seed, anchor) VALUES ($1, $2, $3)", sqlDate(date), sched.Seed(), anchor)
+		if err != nil {
+			return errors.Wrapf(err, "failed to record schedule seed for %s", date)
+		}
+	}
+
+	return nil
+}
+
+// schedExists returns true if a schedule has previously been
+// published for this date. A schedule without terminations is published if
+// its seed was recorded. Amendments don't count: a day whose entries were all
+// cancelled, and that has no seed, may be published again.
+func schedExists(tx *sql.Tx, date time.Time) (bool, error) {
+	var count int
+	err := tx.QueryRow("SELECT (SELECT COUNT(*) FROM schedules WHERE date = $1) + (SELECT COUNT(*) FROM schedule_seeds WHERE date = $1)", sqlDate(date)).Scan(&count)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to check if schedule exists for %s", date)
+	}
+
+	return count > 0, nil
+}
+
+// Check checks if a termination is permitted and, if so, records the
+// termination time on the server
+func (p Postgres) Check(term chaosmonkey.Termination, appCfg chaosmonkey.AppConfig, endHour int, loc *time.Location) error {
+	return p.CheckWithDelay(term, appCfg, endHour, loc, 0)
+}
+
+// CheckWithDelay is the same as Check, but adds a delay between reading and
+// writing to the database (used for testing only)
+//
+// If a concurrent check makes the transaction fail, it is retried, and
+// will then return an ErrViolatesMinTime
+func (p Postgres) CheckWithDelay(term chaosmonkey.Termination, appCfg chaosmonkey.AppConfig, endHour int, loc *time.Location, delay time.Duration) error {
+	return withRetries(func() error {
+		return p.check(term, appCfg, endHour, loc, delay)
+	})
+}
+
+// check checks and records the termination in a single transaction
+func (p Postgres) check(term chaosmonkey.Termination, appCfg chaosmonkey.AppConfig, endHour int, loc *time.Location, delay time.Duration) (err error) {
+	tx, err := p.begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		switch err {
+		case nil:
+			err = tx.Commit()
+		default:
+			_ = tx.Rollback()
+		}
+	}()
+
+	if term.FencingToken != 0 {
+		err = checkFencingToken(tx, term.FencingToken)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = respectsMinTimeBetweenKills(tx, term.Time, term, appCfg, endHour, loc)
+	if err != nil {
+		return err
+	}
+
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+
+	return recordTermination(tx, term)
+}
+
+// respectsMinTimeBetweenKills checks if this termination will respect or
+// violate the min time between kills value. If this termination is too close
+// to the most recent one, this will return an error.
+// If this termination would violate the min time, returns an ErrViolatesMinTime
+func respectsMinTimeBetweenKills(tx *sql.Tx, now time.Time, term chaosmonkey.Termination, appCfg chaosmonkey.AppConfig, endHour int, loc *time.Location) (err error) {
+	app := term.Instance.AppName()
+	account := term.Instance.AccountName()
+	threshold, err := cal.NoKillsSince(appCfg.MinTimeBetweenKillsInWorkDays, now, endHour, loc)
+	if err != nil {
+		return err
+	}
+	query := "SELECT instance_id, killed_at FROM terminations WHERE app = $1 AND account = $2 AND killed_at >= $3"
+
+	args := []interface{}{app, account, threshold.UTC()}
+
+	// where appends a condition on column to the query
+	where := func(column string, value interface{}) {
+		args = append(args, value)
+		query += fmt.Sprintf(" AND %s = $%d", column, len(args))
+	}
+
+	switch appCfg.Grouping {
+	case chaosmonkey.App:
+		// nothing to do
+	case chaosmonkey.Stack:
+		where("stack", term.Instance.StackName())
+	case chaosmonkey.Cluster:
+		where("cluster", term.Instance.ClusterName())
+	default:
+		return errors.Errorf("unknown group: %v", appCfg.Grouping)
+	}
+
+	if appCfg.RegionsAreIndependent {
+		where("region", term.Instance.RegionName())
+	}
+
+	// For unleashed (real) terminations, we only care about previous
+	// terminations that were also unleashed, since a previous leashed
+	// termination wasn't a real one
+	if !term.Leashed {
+		query += " AND leashed = FALSE"
+	}
+
+	// We need at most one entry
+	query += " LIMIT 1"
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		cerr := rows.Close()
+		if err == nil && cerr != nil {
+			err = cerr
+		}
+	}()
+
+	if rows.Next() {
+		var instanceID string
+		var killedAt time.Time
+		err = rows.Scan(&instanceID, &killedAt)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan row")
+		}
+		return chaosmonkey.ErrViolatesMinTime{InstanceID: instanceID, KilledAt: killedAt.UTC(), Loc: loc}
+	}
+
+	return rows.Err()
+}
+
+func recordTermination(tx *sql.Tx, term chaosmonkey.Termination) error {
+	i := term.Instance
+
+	_, err := tx.Exec("INSERT INTO terminations (app, account, stack, cluster, region, asg, instance_id, killed_at, leashed, fencing_token) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+		i.AppName(), i.AccountName(), i.StackName(), i.ClusterName(), i.RegionName(), i.ASGName(), i.ID(), term.Time.UTC(), term.Leashed, term.FencingToken)
+
+	return err
+}
+
+var migrationSource = &migrate.AssetMigrationSource{
+	Asset:    migration.Asset,
+	AssetDir: migration.AssetDir,
+	Dir:      "migration/postgres",
+}
+
+var databaseDialect = "postgres"
+
+// Migrate upgrades a database to the latest database schema version.
+func Migrate(postgresDb Postgres) error {
+	migrationCount, err := migrate.Exec(postgresDb.db, databaseDialect, migrationSource, migrate.Up)
+	if err != nil {
+		return errors.Wrap(err, "database migration failed")
+	}
+	log.Println("Successfully applied database migrations. Number of migrations applied: ", migrationCount)
+
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/snooze"
+)
+
+// Snooze implements snooze.Store.Snooze
+func (p Postgres) Snooze(sn snooze.Snooze) error {
+	err := sn.Check()
+	if err != nil {
+		return err
+	}
+
+	return withRetries(func() error {
+		return p.snooze(sn)
+	})
+}
+
+// snooze replaces the snooze in a single transaction
+func (p Postgres) snooze(sn snooze.Snooze) (err error) {
+	tx, err := p.begin()
+	if err != nil {
+		return err
+	}
+
+	// We must either commit or rollback at the end
+	defer func() {
+		switch err {
+		case nil:
+			err = tx.Commit()
+		default:
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Exec("DELETE FROM snoozes WHERE app = $1 AND account = $2 AND cluster = $3", sn.App, sn.Account, sn.Cluster)
+	if err != nil {
+		return errors.Wrap(err, "failed to replace snooze")
+	}
+
+	_, err = tx.Exec("INSERT INTO snoozes (app, account, cluster, expires, snoozed_by, reason, snoozed_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		sn.App, sn.Account, sn.Cluster, sn.Until.UTC(), sn.By, sn.Reason, sn.At.UTC())
+	if err != nil {
+		return errors.Wrap(err, "failed to record snooze")
+	}
+
+	return nil
+}
+
+// Unsnooze implements snooze.Store.Unsnooze
+func (p Postgres) Unsnooze(app string, account string, cluster string, now time.Time) (bool, error) {
+	res, err := p.db.Exec("UPDATE snoozes SET expires = $1 WHERE app = $2 AND account = $3 AND cluster = $4 AND expires > $1",
+		now.UTC(), app, account, cluster)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to end snooze")
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to count ended snoozes")
+	}
+
+	return n > 0, nil
+}
+
+// Snoozes implements snooze.Store.Snoozes
+func (p Postgres) Snoozes(now time.Time) (result []snooze.Snooze, err error) {
+	rows, err := p.db.Query("SELECT app, account, cluster, expires, snoozed_by, reason, snoozed_at FROM snoozes WHERE expires > $1 ORDER BY app, account, cluster",
+		now.UTC())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve snoozes")
+	}
+
+	defer func() {
+		if cerr := rows.Close(); cerr != nil && err == nil {
+			err = errors.Wrap(cerr, "rows.Close() failed")
+		}
+	}()
+
+	for rows.Next() {
+		var sn snooze.Snooze
+		err = rows.Scan(&sn.App, &sn.Account, &sn.Cluster, &sn.Until, &sn.By, &sn.Reason, &sn.At)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan row")
+		}
+		result = append(result, sn)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "rows.Err() errored")
+	}
+
+	return result, nil
+}
@@ -16,22 +16,118 @@ package schedstore
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/Netflix/chaosmonkey/v2/grp"
 	"github.com/Netflix/chaosmonkey/v2/schedule"
 )
 
-// ErrAlreadyExists is returned when calling Publish if a schedule already
-// exists
-var ErrAlreadyExists = errors.New("schedule already exists")
+var (
+	// ErrAlreadyExists is returned when calling Publish if a schedule already
+	// exists
+	ErrAlreadyExists = errors.New("schedule already exists")
+
+	// ErrNoSchedule is returned when calling Amend if no schedule was
+	// published for the date
+	ErrNoSchedule = errors.New("no schedule published")
+
+	// ErrNoSuchEntry is returned when calling Amend if no entry of the
+	// schedule matches the amendment
+	ErrNoSuchEntry = errors.New("no such entry in schedule")
+
+	// ErrAmbiguousEntry is returned when calling Amend if several entries of
+	// the schedule match the amendment
+	ErrAmbiguousEntry = errors.New("several entries in schedule match, specify the time")
+)
 
 // SchedStore stores schedule of terminations
 type SchedStore interface {
 	// Retrieve retrieves the schedule for the given date
 	// The date must be in the local time zone
+	// If none was published, it returns an empty schedule without a seed,
+	// see Published
 	Retrieve(date time.Time) (*schedule.Schedule, error)
 
 	// Publish publishes the schedule for the given date
 	// The date must be in the local time zone
 	Publish(date time.Time, sched *schedule.Schedule) error
 }
+
+// Published returns true if sched, as retrieved from a SchedStore, was
+// published. Schedules are published with a seed, so an empty schedule
+// without one means that none was published, or, for a schedule published
+// before seeds were recorded, that all of its entries were cancelled.
+func Published(sched *schedule.Schedule) bool {
+	return sched != nil && (len(sched.Entries()) > 0 || sched.Seed() != 0)
+}
+
+// Op is a kind of amendment to a schedule
+type Op string
+
+// Amendments to a schedule
+const (
+	Cancel     Op = "cancel"     // remove an entry
+	Add        Op = "add"        // add an entry
+	Reschedule Op = "reschedule" // move an entry to a new time
+)
+
+// Amendment is a change to a published schedule
+type Amendment struct {
+	Op    Op
+	Group grp.InstanceGroup
+
+	// Time is the time of the entry to cancel or reschedule, to the minute.
+	// If zero, the group must have a single entry. Stores set it to the time
+	// of the entry that was amended.
+	Time time.Time
+
+	// NewTime is the time of the entry to add, or to reschedule to
+	NewTime time.Time
+
+	By     string    // Who made the amendment
+	Reason string    // Why the amendment was made
+	At     time.Time // When the amendment was made
+}
+
+// Check returns an error if the amendment is incomplete
+func (a Amendment) Check() error {
+	switch a.Op {
+	case Cancel:
+	case Add, Reschedule:
+		if a.NewTime.IsZero() {
+			return fmt.Errorf("%s: no new time", a.Op)
+		}
+	default:
+		return fmt.Errorf("unknown amendment %q", a.Op)
+	}
+
+	if a.Group == nil {
+		return fmt.Errorf("%s: no group", a.Op)
+	}
+
+	if a.By == "" {
+		return fmt.Errorf("%s: no author", a.Op)
+	}
+
+	return nil
+}
+
+// Matches returns true if the entry at t is the one the amendment cancels or
+// reschedules, given that the group matches
+func (a Amendment) Matches(t time.Time) bool {
+	return a.Time.IsZero() || t.Truncate(time.Minute).Equal(a.Time.Truncate(time.Minute))
+}
+
+// Amender changes schedules after they are published
+type Amender interface {
+	// Amend applies a to the schedule published for the given date, and
+	// records it. The date must be in the local time zone. Returns
+	// ErrNoSchedule, ErrNoSuchEntry or ErrAmbiguousEntry if a can't be
+	// applied.
+	Amend(date time.Time, a Amendment) error
+
+	// Amendments returns the amendments made to the schedule for the given
+	// date, oldest first. The date must be in the local time zone.
+	Amendments(date time.Time) ([]Amendment, error)
+}
@@ -14,8 +14,75 @@
 
 package schedule
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/grp"
+)
+
 // Constrainer provides additional constraints on a schedule
 type Constrainer interface {
 	// Produce a new schedule that satisfies constraints by eliminating scheduled terminations
 	Filter(schedule Schedule) Schedule
 }
+
+// Explainer is a Constrainer that tells how it changes a schedule
+type Explainer interface {
+	Constrainer
+
+	// Explain is Filter, but also returns the changes made to the schedule,
+	// in order
+	Explain(schedule Schedule) (Schedule, []Change)
+}
+
+// Change is a change that a constrainer made to an entry of a schedule
+type Change struct {
+	Entry  Entry     // Entry as it was before the change
+	Moved  time.Time // Time the entry was moved to, zero if it was removed
+	Reason string    // Why the entry was changed
+}
+
+// Removed returns true if the entry was removed rather than moved
+func (c Change) Removed() bool {
+	return c.Moved.IsZero()
+}
+
+// Explain filters s with c and returns the changes made. If c isn't an
+// Explainer, the changes are the entries missing from the filtered schedule.
+func Explain(c Constrainer, s Schedule) (Schedule, []Change) {
+	if e, ok := c.(Explainer); ok {
+		return e.Explain(s)
+	}
+
+	result := c.Filter(s)
+
+	kept := make(map[string]int)
+	for _, e := range result.Entries() {
+		kept[entryKey(e)]++
+	}
+
+	var changes []Change
+	for _, e := range s.Entries() {
+		k := entryKey(e)
+		if kept[k] > 0 {
+			kept[k]--
+			continue
+		}
+		changes = append(changes, Change{Entry: e, Reason: fmt.Sprintf("removed by %T", c)})
+	}
+	return result, changes
+}
+
+// entryKey identifies an entry by group and time
+func entryKey(e Entry) string {
+	return fmt.Sprintf("%s@%d", grp.String(e.Group), e.Time.UnixNano())
+}
+
+// Warner announces upcoming terminations, so that service owners can watch
+// their app while it happens
+type Warner interface {
+	// Warn announces that an instance of e.Group will be terminated at
+	// e.Time. If leashed is true, the termination will only be simulated.
+	Warn(e Entry, leashed bool) error
+}
@@ -25,22 +25,46 @@ import (
 	"time"
 
 	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/cal"
 	"github.com/Netflix/chaosmonkey/v2/config"
 	"github.com/Netflix/chaosmonkey/v2/deploy"
 	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/metrics"
+	"github.com/Netflix/chaosmonkey/v2/seed"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
 )
 
 // Populate populates the termination schedule with the random
 // terminations for a list of apps. If the specified list of apps is empty,
 // then it will
+//
+// The random choices derive from the seed of the schedule, and the times from
+// its anchor, so that populating with the same seed, anchor, apps and configs
+// reproduces the schedule. A new seed is drawn if the schedule has none, and
+// the anchor defaults to now.
+//
+// Groups that are snoozed at the time chosen for their termination are not
+// scheduled.
 func (s *Schedule) Populate(d deploy.Deployment, getter chaosmonkey.AppConfigGetter, chaosConfig *config.Monkey, apps []string) error {
+	defer metrics.ObserveStage(metrics.StagePopulate, time.Now())
+
+	if s.seed == 0 {
+		s.seed = seed.New()
+	}
+	if s.anchor.IsZero() {
+		s.anchor = time.Now()
+	}
+	log.Printf("schedule seed=%d anchor=%s", s.seed, s.anchor.Format(time.RFC3339))
+
 	c := make(chan *deploy.App)
 
 	// If the caller explicitly a set of apps, use those
 	// If they did not, do all apps
 	if len(apps) == 0 {
 		var err error
+		start := time.Now()
 		apps, err = d.AppNames()
+		metrics.ObserveStage(metrics.StageAppNames, start)
 		if err != nil {
 			return fmt.Errorf("could not retrieve list of apps: %v", err)
 		}
@@ -55,6 +79,7 @@ func (s *Schedule) Populate(d deploy.Deployment, getter chaosmonkey.AppConfigGet
 
 		i++
 
+		start := time.Now()
 		cfg, err := getter.Get(app.Name())
 
 		if err != nil {
@@ -62,8 +87,19 @@ func (s *Schedule) Populate(d deploy.Deployment, getter chaosmonkey.AppConfigGet
 			continue
 		}
 		doScheduleApp(s, app, *cfg, chaosConfig)
+		metrics.ObserveStage(metrics.StageScheduleApp, start, "app:"+app.Name())
 	}
 
+	// Apps arrive in no particular order, so order the entries for the
+	// schedule to be reproducible
+	sort.SliceStable(s.entries, func(i, j int) bool {
+		a, b := s.entries[i], s.entries[j]
+		if !a.Time.Equal(b.Time) {
+			return a.Time.Before(b.Time)
+		}
+		return grp.String(a.Group) < grp.String(b.Group)
+	})
+
 	return nil
 }
 
@@ -77,6 +113,59 @@ func (s *Schedule) Entries() []Entry {
 	return s.entries
 }
 
+// Seed returns the seed the schedule was populated from, or zero if unknown
+func (s *Schedule) Seed() int64 {
+	return s.seed
+}
+
+// SetSeed sets the seed to populate the schedule from
+func (s *Schedule) SetSeed(seed int64) {
+	s.seed = seed
+}
+
+// Anchor returns the time the schedule was populated for, or the zero time if
+// unknown
+func (s *Schedule) Anchor() time.Time {
+	return s.anchor
+}
+
+// SetAnchor sets the time to populate the schedule for. Terminations are
+// scheduled on the day of anchor.
+func (s *Schedule) SetAnchor(anchor time.Time) {
+	s.anchor = anchor
+}
+
+// Hours returns the hours of app recorded when the schedule was populated,
+// and false if there are none
+func (s *Schedule) Hours(app string) (Hours, bool) {
+	h, ok := s.hours[app]
+	return h, ok
+}
+
+// SetHours sets the hours of app, which Populate records for each app it
+// schedules
+func (s *Schedule) SetHours(app string, h Hours) {
+	if s.hours == nil {
+		s.hours = make(map[string]Hours)
+	}
+	s.hours[app] = h
+}
+
+// Derive returns an empty schedule with the seed, anchor and hours of s, for
+// a constrainer to add the entries it keeps to
+func (s *Schedule) Derive() *Schedule {
+	result := New()
+	result.seed = s.seed
+	result.anchor = s.anchor
+	result.hours = s.hours
+	return result
+}
+
+// SetSnoozes sets the snoozes to honour when populating the schedule
+func (s *Schedule) SetSnoozes(snoozes []snooze.Snooze) {
+	s.snoozes = snoozes
+}
+
 // doScheduleApp populates the termination schedule for one app
 func doScheduleApp(schedule *Schedule, app *deploy.App, cfg chaosmonkey.AppConfig, chaosConfig *config.Monkey) {
 
@@ -85,7 +174,9 @@ func doScheduleApp(schedule *Schedule, app *deploy.App, cfg chaosmonkey.AppConfi
 		return
 	}
 
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	// Each app gets its own stream, so that an app's choices don't depend
+	// on the other apps
+	r := seed.Rand(schedule.seed, app.Name())
 	startHour := chaosConfig.StartHour()
 	endHour := chaosConfig.EndHour()
 	location, err := chaosConfig.Location()
@@ -94,6 +185,18 @@ func doScheduleApp(schedule *Schedule, app *deploy.App, cfg chaosmonkey.AppConfi
 		panic(fmt.Sprintf("Could not get Location for time zone calculation: %s", err.Error()))
 	}
 
+	// Apps may have their own time zone and kill windows
+	ownHours := cfg.TimeZone != "" || len(cfg.KillWindows) > 0
+	location, err = cfg.Location(location)
+	if err != nil {
+		log.Printf("WARNING: app=%s not scheduled: %v", app.Name(), err)
+		return
+	}
+	windows := cfg.Windows(startHour, endHour)
+
+	// Constrainers that move terminations keep them within these
+	schedule.SetHours(app.Name(), Hours{Windows: windows, Location: location})
+
 	groups := app.EligibleInstanceGroups(cfg)
 
 	if len(groups) == 0 {
@@ -103,10 +206,27 @@ func doScheduleApp(schedule *Schedule, app *deploy.App, cfg chaosmonkey.AppConfi
 	for _, group := range groups {
 		kill := shouldKillInstance(cfg.MeanTimeBetweenKillsInWorkDays, r)
 		log.Printf("%s mtbk=%d kill=%t\n", grp.String(group), cfg.MeanTimeBetweenKillsInWorkDays, kill)
-		if kill {
-			time := chooseTerminationTime(time.Now(), startHour, endHour, location)
-			schedule.Add(time, group)
+		if !kill {
+			continue
+		}
+
+		var tm time.Time
+		if ownHours {
+			var ok bool
+			tm, ok = chooseAppTerminationTime(schedule.anchor, windows, location, r)
+			if !ok {
+				log.Printf("%s no kill window left today in %s", grp.String(group), location)
+				continue
+			}
+		} else {
+			tm = chooseTerminationTime(schedule.anchor, startHour, endHour, location, r)
+		}
+
+		if sn, ok := snooze.Group(schedule.snoozes, group, tm); ok {
+			log.Printf("%s snoozed until %s by %s: %s", grp.String(group), sn.Until.In(location).Format(time.RFC3339), sn.By, sn.Reason)
+			continue
 		}
+		schedule.Add(tm, group)
 	}
 }
 
@@ -119,7 +239,7 @@ func doScheduleApp(schedule *Schedule, app *deploy.App, cfg chaosmonkey.AppConfi
 // the future
 //
 // now is passed as an argument to simplify testing
-func chooseTerminationTime(now time.Time, startHour int, endHour int, location *time.Location) time.Time {
+func chooseTerminationTime(now time.Time, startHour int, endHour int, location *time.Location, r *rand.Rand) time.Time {
 	if endHour <= startHour {
 		panic(fmt.Sprintf("ChooseTermination called with startHour <= endHour, startHour: %d. endHour: %d", startHour, endHour))
 	}
@@ -128,18 +248,79 @@ func chooseTerminationTime(now time.Time, startHour int, endHour int, location *
 	// pick a random one in there, and then add it to the start time as an
 	// offset
 	minutesInTimeInterval := (endHour - startHour) * 60
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	sample := r.Intn(minutesInTimeInterval)
 
 	// Convert the sample to duration in minutes
 	offset := time.Duration(sample) * time.Minute
 
-	year, month, day := now.Date()
+	year, month, day := now.In(location).Date()
 	startTime := time.Date(year, month, day, startHour, 0, 0, 0, location)
 
 	return startTime.Add(offset)
 }
 
+// chooseAppTerminationTime randomly selects a time to terminate an instance
+// of an app that has its own time zone or kill windows, within its windows on
+// the date of now in location. Returns false if that date isn't a work day in
+// location, or if all of its windows ended by now.
+//
+// Only the part of the windows after now is considered, so that the chosen
+// time is never in the past. Since the date is that of now in location, an
+// app in a time zone ahead of the schedule's may never be terminated on some
+// of its work days, e.g., Mondays in Sydney for a schedule made on US Pacific
+// work days. Panics if a window ends before it starts.
+//
+// now is passed as an argument to simplify testing
+func chooseAppTerminationTime(now time.Time, windows []chaosmonkey.KillWindow, location *time.Location, r *rand.Rand) (time.Time, bool) {
+	for _, w := range windows {
+		if w.EndHour <= w.StartHour {
+			panic(fmt.Sprintf("ChooseTermination called with startHour <= endHour, startHour: %d. endHour: %d", w.StartHour, w.EndHour))
+		}
+	}
+
+	year, month, day := now.In(location).Date()
+	if !cal.IsWorkday(time.Date(year, month, day, 12, 0, 0, 0, location)) {
+		return time.Time{}, false
+	}
+
+	// Compute the number of minutes left in the windows, pick a random one
+	// in there, and then find the window it falls in
+	var starts []time.Time
+	var lengths []int
+	minutesInTimeIntervals := 0
+	for _, w := range windows {
+		start := time.Date(year, month, day, w.StartHour, 0, 0, 0, location)
+		end := time.Date(year, month, day, w.EndHour, 0, 0, 0, location)
+		if start.Before(now) {
+			start = now
+		}
+
+		minutes := int(end.Sub(start) / time.Minute)
+		if minutes <= 0 {
+			continue
+		}
+
+		starts = append(starts, start)
+		lengths = append(lengths, minutes)
+		minutesInTimeIntervals += minutes
+	}
+
+	if minutesInTimeIntervals == 0 {
+		return time.Time{}, false
+	}
+
+	sample := r.Intn(minutesInTimeIntervals)
+	for i, start := range starts {
+		if sample < lengths[i] {
+			// Convert the sample to duration in minutes
+			return start.Add(time.Duration(sample) * time.Minute), true
+		}
+		sample -= lengths[i]
+	}
+
+	return time.Time{}, false
+}
+
 // float64Rand generates random floats on [0, 1)
 type float64Rand interface {
 
@@ -254,6 +435,45 @@ func logRedirect(logPath string) string {
 // Schedule is a collection of termination entries.
 type Schedule struct {
 	entries []Entry
+	seed    int64
+	anchor  time.Time
+	snoozes []snooze.Snooze
+
+	// hours are the hours of each app, by name. They aren't stored.
+	hours map[string]Hours
+}
+
+// Hours are the times of day that the instances of an app may be terminated
+// at: its kill windows, in its time zone
+type Hours struct {
+	Windows  []chaosmonkey.KillWindow
+	Location *time.Location
+}
+
+// Next returns the earliest time at or after t that is within one of the
+// windows on the day of scheduled, in Location. It returns false if there is
+// none.
+func (h Hours) Next(scheduled time.Time, t time.Time) (time.Time, bool) {
+	year, month, day := scheduled.In(h.Location).Date()
+
+	var result time.Time
+	for _, w := range h.Windows {
+		start := time.Date(year, month, day, w.StartHour, 0, 0, 0, h.Location)
+		end := time.Date(year, month, day, w.EndHour, 0, 0, 0, h.Location)
+		if !t.Before(end) {
+			continue
+		}
+
+		c := t
+		if c.Before(start) {
+			c = start
+		}
+		if result.IsZero() || c.Before(result) {
+			result = c
+		}
+	}
+
+	return result, !result.IsZero()
 }
 
 // New returns a new Schedule
@@ -261,7 +481,7 @@ func New() *Schedule {
 	return &Schedule{
 		// We need a zero-element slice instead of a nil slice so that
 		// it will JSON-marshall into '[ ]' instead of 'null'
-		make([]Entry, 0),
+		entries: make([]Entry, 0),
 	}
 }
 
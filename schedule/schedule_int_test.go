@@ -0,0 +1,70 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2"
+)
+
+// Times are chosen on the day of now only, in what is left of the windows
+func TestChooseAppTerminationTime(t *testing.T) {
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	windows := []chaosmonkey.KillWindow{{StartHour: 10, EndHour: 12}, {StartHour: 14, EndHour: 16}}
+	at := func(day, hh, mm int) time.Time { return time.Date(2026, time.October, day, hh, mm, 0, 0, sydney) }
+
+	tests := []struct {
+		desc     string
+		now      time.Time
+		ok       bool
+		from, to time.Time // the chosen time is in [from, to)
+	}{
+		{"before the windows", at(19, 8, 0), true, at(19, 10, 0), at(19, 16, 0)},
+		{"during the first window", at(19, 11, 0), true, at(19, 11, 0), at(19, 16, 0)},
+		{"during the last window", at(19, 15, 0), true, at(19, 15, 0), at(19, 16, 0)},
+		{"after the windows", at(19, 17, 0), false, time.Time{}, time.Time{}},
+		{"on a weekend", at(18, 8, 0), false, time.Time{}, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < 200; i++ {
+			got, ok := chooseAppTerminationTime(tt.now, windows, sydney, r)
+			if ok != tt.ok {
+				t.Fatalf("%s: got ok=%t, want %t", tt.desc, ok, tt.ok)
+			}
+			if !ok {
+				break
+			}
+
+			if got.Before(tt.from) || !got.Before(tt.to) {
+				t.Fatalf("%s: got %s, want in [%s, %s)", tt.desc, got, tt.from, tt.to)
+			}
+			if h := got.Hour(); h == 12 || h == 13 {
+				t.Fatalf("%s: got %s, between the windows", tt.desc, got)
+			}
+			if got.Before(tt.now) {
+				t.Fatalf("%s: got %s, before now %s", tt.desc, got, tt.now)
+			}
+		}
+	}
+}
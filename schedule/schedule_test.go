@@ -17,12 +17,16 @@ package schedule_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/cal"
 	"github.com/Netflix/chaosmonkey/v2/config"
 	"github.com/Netflix/chaosmonkey/v2/config/param"
+	"github.com/Netflix/chaosmonkey/v2/grp"
 	"github.com/Netflix/chaosmonkey/v2/mock"
 	"github.com/Netflix/chaosmonkey/v2/schedule"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
 )
 
 func TestPopulate(t *testing.T) {
@@ -56,6 +60,173 @@ func TestPopulate(t *testing.T) {
 
 }
 
+// Apps are terminated within their own kill windows, in their own time zone
+func TestPopulateAppKillWindows(t *testing.T) {
+	// Make every day a work day, so that the test doesn't depend on the day
+	// it runs
+	cal.Set(cal.New([]time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}))
+	defer cal.Set(cal.Weekdays())
+
+	s := schedule.New()
+	d := mock.Dep()
+
+	cfg := chaosmonkey.NewAppConfig(nil)
+	cfg.Grouping = chaosmonkey.App
+	cfg.MeanTimeBetweenKillsInWorkDays = 1
+	cfg.TimeZone = "Australia/Sydney"
+	cfg.KillWindows = []chaosmonkey.KillWindow{{StartHour: 10, EndHour: 12}, {StartHour: 14, EndHour: 16}}
+
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.SetAnchor(time.Date(2026, time.October, 19, 9, 0, 0, 0, sydney))
+
+	err = s.Populate(d, mock.NewConfigGetter(cfg), config.Defaults(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(s.Entries()), 4; got != want {
+		t.Fatalf("got %d entries, want %d", got, want)
+	}
+
+	for _, e := range s.Entries() {
+		hour := e.Time.In(sydney).Hour()
+		if !(hour >= 10 && hour < 12 || hour >= 14 && hour < 16) {
+			t.Errorf("%s: got termination at %s, want within kill windows", grp.String(e.Group), e.Time.In(sydney))
+		}
+
+		if got, want := e.Time.In(sydney).Format("2006-01-02"), "2026-10-19"; got != want {
+			t.Errorf("%s: got termination on %s, want %s", grp.String(e.Group), got, want)
+		}
+
+		// Constrainers move terminations within the same hours
+		h, ok := s.Hours(e.Group.App())
+		if !ok || h.Location.String() != sydney.String() || len(h.Windows) != 2 {
+			t.Errorf("%s: got hours=%+v, want the kill windows in %s", grp.String(e.Group), h, sydney)
+		}
+	}
+}
+
+// Populating from the same seed reproduces the schedule
+func TestPopulateIsReproducible(t *testing.T) {
+	d := mock.Dep()
+
+	// Kill one day in two, so that which groups are scheduled depends on
+	// the seed
+	cfg := chaosmonkey.NewAppConfig(nil)
+	cfg.Grouping = chaosmonkey.Cluster
+	cfg.MeanTimeBetweenKillsInWorkDays = 2
+
+	var got [][]schedule.Entry
+	for i := 0; i < 2; i++ {
+		s := schedule.New()
+		s.SetSeed(42)
+		err := s.Populate(d, mock.NewConfigGetter(cfg), config.Defaults(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := s.Seed(), int64(42); got != want {
+			t.Errorf("got seed %d, want %d", got, want)
+		}
+		got = append(got, s.Entries())
+	}
+
+	if len(got[0]) != len(got[1]) {
+		t.Fatalf("got %d and %d entries from the same seed", len(got[0]), len(got[1]))
+	}
+	for i := range got[0] {
+		if !got[0][i].Equal(&got[1][i]) {
+			t.Errorf("entry %d: got %+v and %+v from the same seed", i, got[0][i], got[1][i])
+		}
+	}
+}
+
+// Replaying a seed with the anchor of the schedule reproduces its entries,
+// whenever it is replayed
+func TestPopulateReplay(t *testing.T) {
+	cal.Set(cal.New([]time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}))
+	defer cal.Set(cal.Weekdays())
+
+	cfg := chaosmonkey.NewAppConfig(nil)
+	cfg.Grouping = chaosmonkey.Cluster
+	cfg.MeanTimeBetweenKillsInWorkDays = 1
+	cfg.TimeZone = "Australia/Sydney"
+	cfg.KillWindows = []chaosmonkey.KillWindow{{StartHour: 10, EndHour: 12}, {StartHour: 14, EndHour: 16}}
+
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Made on the day, replayed a month later
+	anchor := time.Date(2026, time.September, 15, 8, 0, 0, 0, sydney)
+
+	var got [][]schedule.Entry
+	for i := 0; i < 2; i++ {
+		s := schedule.New()
+		s.SetSeed(42)
+		s.SetAnchor(anchor)
+		err := s.Populate(mock.Dep(), mock.NewConfigGetter(cfg), config.Defaults(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, s.Entries())
+	}
+
+	if len(got[0]) == 0 || len(got[0]) != len(got[1]) {
+		t.Fatalf("got %d and %d entries from the same seed and anchor", len(got[0]), len(got[1]))
+	}
+	for i := range got[0] {
+		if !got[0][i].Equal(&got[1][i]) {
+			t.Errorf("entry %d: got %+v and %+v from the same seed and anchor", i, got[0][i], got[1][i])
+		}
+		if day := got[0][i].Time.In(sydney).Format("2006-01-02"); day != "2026-09-15" {
+			t.Errorf("entry %d: got termination on %s, want the day of the anchor", i, day)
+		}
+	}
+}
+
+// Snoozed groups are not scheduled, unless the snooze has expired
+func TestPopulateHonoursSnoozes(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 7, 0, 0, 0, time.UTC)
+	s := schedule.New()
+	s.SetAnchor(now)
+	s.SetSnoozes([]snooze.Snooze{
+		{App: "foo", Until: now.AddDate(0, 0, 7), By: "alice", Reason: "migration"},
+		{App: "bar", Until: now.Add(-time.Hour), By: "bob", Reason: "expired"},
+	})
+
+	err := s.Populate(mock.Dep(), new(mockConfigGetter), config.Defaults(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(s.Entries()), 3; got != want {
+		t.Fatalf("got %d entries, want %d", got, want)
+	}
+
+	for _, e := range s.Entries() {
+		if e.Group.App() == "foo" {
+			t.Errorf("got snoozed app scheduled: %s", grp.String(e.Group))
+		}
+	}
+}
+
+// A new seed is drawn for a schedule without one
+func TestPopulateDrawsSeed(t *testing.T) {
+	s := schedule.New()
+	err := s.Populate(mock.Dep(), new(mockConfigGetter), config.Defaults(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Seed() == 0 {
+		t.Error("got no seed")
+	}
+}
+
 // mockConfigGetter implements chaosmonkey.Getter
 // returns configs for apps
 type mockConfigGetter struct {
@@ -0,0 +1,53 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package seed provides the randomness used by Chaos Monkey. All random
+// choices of a day derive from a single recorded seed, so that a schedule and
+// the instances picked from it can be reproduced.
+package seed
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math/rand"
+	"time"
+)
+
+// New returns a fresh seed. Seeds are never zero, which stands for no seed.
+func New() int64 {
+	s := time.Now().UnixNano()
+	if s == 0 {
+		s = 1
+	}
+	return s
+}
+
+// Rand returns a random number generator derived from seed and keys. The
+// same seed and keys always give the same sequence of numbers, and different
+// keys give independent sequences, e.g. one per app.
+func Rand(seed int64, keys ...string) *rand.Rand {
+	h := fnv.New64a()
+
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(seed))
+	_, _ = h.Write(b[:])
+
+	for _, k := range keys {
+		// Separate the keys, so that ("ab", "c") and ("a", "bc") differ
+		_, _ = h.Write([]byte(k))
+		_, _ = h.Write([]byte{0})
+	}
+
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import "testing"
+
+func sample(seed int64, keys ...string) []int64 {
+	r := Rand(seed, keys...)
+	result := make([]int64, 5)
+	for i := range result {
+		result[i] = r.Int63()
+	}
+	return result
+}
+
+func equal(a, b []int64) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRandIsReproducible(t *testing.T) {
+	if !equal(sample(42, "app"), sample(42, "app")) {
+		t.Error("got different numbers for the same seed and keys")
+	}
+}
+
+func TestRandKeysAreIndependent(t *testing.T) {
+	tests := []struct {
+		a, b []int64
+	}{
+		{sample(42, "app"), sample(43, "app")},
+		{sample(42, "app"), sample(42, "other")},
+		{sample(42, "ab", "c"), sample(42, "a", "bc")},
+		{sample(42), sample(42, "")},
+	}
+
+	for i, tt := range tests {
+		if equal(tt.a, tt.b) {
+			t.Errorf("%d: got the same numbers for different seeds or keys", i)
+		}
+	}
+}
+
+func TestNewIsNotZero(t *testing.T) {
+	if New() == 0 {
+		t.Error("got a zero seed")
+	}
+}
@@ -0,0 +1,521 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package server implements an HTTP API that exposes Chaos Monkey's
+// schedules, eligible instances, app configs and termination history as
+// JSON, and lets clients trigger terminations.
+//
+// Endpoints:
+//
+//	GET  /v1/schedule?date=YYYY-MM-DD                        schedule for date, today if omitted
+//	GET  /v1/eligible?app=&account=&region=&stack=&cluster=  instances eligible for termination
+//	GET  /v1/config?app=                                     app config
+//	GET  /v1/terminations?app=&account=&region=&stack=&cluster=&since=&until=&leashed=&limit=
+//	                                                         recorded terminations, most recent first
+//	POST /v1/terminations                                    terminate an instance
+//
+// Terminating requires a bearer token, and is made under the leader lease
+// like terminations from cron or the daemon.
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/eligible"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/history"
+	"github.com/Netflix/chaosmonkey/v2/metrics"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+	"github.com/Netflix/chaosmonkey/v2/term"
+)
+
+// dateFormat is the format of the date query parameter
+const dateFormat = "2006-01-02"
+
+// Leader tries to acquire the leader lease. It returns the fencing token of
+// the lease and true if this replica is the leader.
+type Leader func() (int64, bool, error)
+
+// Server serves the HTTP API
+type Server struct {
+	deps    deps.Deps
+	store   schedstore.SchedStore
+	history history.Store
+
+	// token must be presented to terminate, which is refused if it is blank
+	token          string
+	allowUnleashed bool
+	leader         Leader
+
+	// terminate is replaced in tests
+	terminate func(d deps.Deps, app, account, region, stack, cluster string) error
+}
+
+// NewFromConfig returns a Server, taking the token and whether unleashed
+// terminations are allowed from the config of d
+func NewFromConfig(d deps.Deps, store schedstore.SchedStore, hist history.Store, leader Leader) (*Server, error) {
+	var token string
+	if encrypted := d.MonkeyCfg.ServerEncryptedToken(); encrypted != "" {
+		decryptor, err := deps.GetDecryptor(d.MonkeyCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		token, err = decryptor.Decrypt(encrypted)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		log.Printf("WARNING: %s not set, terminations over the API are disabled", param.ServerEncryptedToken)
+	}
+
+	return New(d, store, hist, token, d.MonkeyCfg.ServerAllowUnleashed(), leader), nil
+}
+
+// New returns a Server. Terminations are executed with d, schedules are
+// retrieved from store and past terminations from hist.
+//
+// Clients must present token as a bearer token to terminate, and can't
+// terminate if it is blank. Unless allowUnleashed is true, they can only
+// request leashed terminations. Each termination is made under the lease
+// acquired with leader, or without a lease if leader is nil.
+func New(d deps.Deps, store schedstore.SchedStore, hist history.Store, token string, allowUnleashed bool, leader Leader) *Server {
+	if leader == nil {
+		leader = func() (int64, bool, error) { return 0, true, nil }
+	}
+
+	return &Server{
+		deps:           d,
+		store:          store,
+		history:        hist,
+		token:          token,
+		allowUnleashed: allowUnleashed,
+		leader:         leader,
+		terminate:      term.Terminate,
+	}
+}
+
+// Handler returns the handler for the API. It also serves the Prometheus
+// metrics on /metrics.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/schedule", s.method(http.MethodGet, s.getSchedule))
+	mux.HandleFunc("/v1/eligible", s.method(http.MethodGet, s.getEligible))
+	mux.HandleFunc("/v1/config", s.method(http.MethodGet, s.getConfig))
+	mux.HandleFunc("/v1/terminations", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			s.getTerminations(w, r)
+		case http.MethodPost:
+			s.postTermination(w, r)
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			writeError(w, http.StatusMethodNotAllowed, errors.Errorf("method %s not allowed", r.Method))
+		}
+	})
+	mux.Handle("/metrics", metrics.Default.Handler())
+	return mux
+}
+
+// method restricts a handler to a single HTTP method
+func (s *Server) method(method string, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.Header().Set("Allow", method)
+			writeError(w, http.StatusMethodNotAllowed, errors.Errorf("method %s not allowed", r.Method))
+			return
+		}
+		h(w, r)
+	}
+}
+
+// scheduleResponse is the body returned by GET /v1/schedule
+type scheduleResponse struct {
+	Date    string           `json:"date"`
+	Entries []schedule.Entry `json:"entries"`
+}
+
+func (s *Server) getSchedule(w http.ResponseWriter, r *http.Request) {
+	loc, err := s.deps.MonkeyCfg.Location()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errors.Wrap(err, "could not retrieve location"))
+		return
+	}
+
+	date := s.deps.Cl.Now().In(loc)
+	if param := r.URL.Query().Get("date"); param != "" {
+		date, err = time.ParseInLocation(dateFormat, param, loc)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, errors.Errorf("invalid date %q, want YYYY-MM-DD", param))
+			return
+		}
+	}
+
+	sched, err := s.store.Retrieve(date)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if !schedstore.Published(sched) {
+		writeError(w, http.StatusNotFound, errors.Errorf("no schedule for %s", date.Format(dateFormat)))
+		return
+	}
+
+	entries := sched.Entries()
+	sort.Sort(schedule.ByTime(entries))
+	if entries == nil {
+		entries = []schedule.Entry{}
+	}
+
+	writeJSON(w, http.StatusOK, scheduleResponse{Date: date.Format(dateFormat), Entries: entries})
+}
+
+// instanceResponse is an instance returned by GET /v1/eligible
+type instanceResponse struct {
+	ID            string `json:"id"`
+	App           string `json:"app"`
+	Account       string `json:"account"`
+	Region        string `json:"region"`
+	Stack         string `json:"stack"`
+	Cluster       string `json:"cluster"`
+	ASG           string `json:"asg"`
+	CloudProvider string `json:"cloudProvider"`
+}
+
+func newInstanceResponse(i chaosmonkey.Instance) instanceResponse {
+	return instanceResponse{
+		ID:            i.ID(),
+		App:           i.AppName(),
+		Account:       i.AccountName(),
+		Region:        i.RegionName(),
+		Stack:         i.StackName(),
+		Cluster:       i.ClusterName(),
+		ASG:           i.ASGName(),
+		CloudProvider: i.CloudProvider(),
+	}
+}
+
+func (s *Server) getEligible(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	group, err := groupFrom(q.Get("app"), q.Get("account"), q.Get("region"), q.Get("stack"), q.Get("cluster"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	cfg, ok := s.appConfig(w, group.App())
+	if !ok {
+		return
+	}
+
+	instances, err := eligible.Instances(group, cfg.Exceptions, s.deps.Dep)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	result := []instanceResponse{}
+	for _, i := range instances {
+		result = append(result, newInstanceResponse(i))
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) getConfig(w http.ResponseWriter, r *http.Request) {
+	app := r.URL.Query().Get("app")
+	if app == "" {
+		writeError(w, http.StatusBadRequest, errors.New("app is required"))
+		return
+	}
+
+	cfg, ok := s.appConfig(w, app)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, cfg.JSON())
+}
+
+// appConfig retrieves the config of app. If that fails, it writes the error
+// and returns false.
+func (s *Server) appConfig(w http.ResponseWriter, app string) (*chaosmonkey.AppConfig, bool) {
+	cfg, err := s.deps.ConfGetter.Get(app)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, errors.Wrapf(err, "could not retrieve config for app %s", app))
+		return nil, false
+	}
+
+	return cfg, true
+}
+
+func (s *Server) getTerminations(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	q := history.Query{
+		App:     params.Get("app"),
+		Account: params.Get("account"),
+		Region:  params.Get("region"),
+		Stack:   params.Get("stack"),
+		Cluster: params.Get("cluster"),
+	}
+
+	loc, err := s.deps.MonkeyCfg.Location()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errors.Wrap(err, "could not retrieve location"))
+		return
+	}
+
+	if since := params.Get("since"); since != "" {
+		q.Since, err = history.ParseSince(since, loc)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	if until := params.Get("until"); until != "" {
+		q.Until, err = history.ParseUntil(until, loc)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	if leashed := params.Get("leashed"); leashed != "" {
+		b, err := strconv.ParseBool(leashed)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, errors.Errorf("invalid leashed %q", leashed))
+			return
+		}
+		q.Leashed = &b
+	}
+
+	if limit := params.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			writeError(w, http.StatusBadRequest, errors.Errorf("invalid limit %q", limit))
+			return
+		}
+		q.Limit = n
+	}
+
+	records, err := s.history.Terminations(q)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if records == nil {
+		records = []history.Record{}
+	}
+
+	writeJSON(w, http.StatusOK, records)
+}
+
+// terminationRequest is the body of POST /v1/terminations
+type terminationRequest struct {
+	App     string `json:"app"`
+	Account string `json:"account"`
+	Region  string `json:"region"`
+	Stack   string `json:"stack"`
+	Cluster string `json:"cluster"`
+
+	// Leashed forces a leashed termination. Terminations are always leashed
+	// if the config is leashed, and unleashed requests are refused unless
+	// the server allows them.
+	Leashed bool `json:"leashed"`
+}
+
+// terminationResponse is the body returned by POST /v1/terminations.
+// Instance is nil if no termination was made, e.g., because Chaos Monkey or
+// the app is disabled or no instance is eligible.
+type terminationResponse struct {
+	Terminated bool              `json:"terminated"`
+	Instance   *instanceResponse `json:"instance,omitempty"`
+	Time       *time.Time        `json:"time,omitempty"`
+	Leashed    bool              `json:"leashed"`
+}
+
+func (s *Server) postTermination(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(w, r) {
+		return
+	}
+
+	var req terminationRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	err := dec.Decode(&req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.Wrap(err, "invalid request body"))
+		return
+	}
+
+	_, err = groupFrom(req.App, req.Account, req.Region, req.Stack, req.Cluster)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if !req.Leashed && !s.allowUnleashed {
+		writeError(w, http.StatusForbidden, errors.Errorf("unleashed terminations are disabled, set %s or request a leashed termination", param.ServerAllowUnleashed))
+		return
+	}
+
+	// Only the leader terminates, so that the API can't race the daemon
+	token, leader, err := s.leader()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if !leader {
+		writeError(w, http.StatusServiceUnavailable, errors.New("another replica is the leader, not terminating"))
+		return
+	}
+
+	// The recorder is the last tracker, so it sees the termination only if
+	// the other trackers accepted it
+	rec := &recorder{}
+	d := s.deps
+	d.Leashed = d.Leashed || req.Leashed
+	d.FencingToken = token
+	d.Seed = s.scheduleSeed()
+	d.Trackers = append(d.Trackers[:len(d.Trackers):len(d.Trackers)], rec)
+
+	log.Printf("API termination request: app=%s account=%s region=%s stack=%s cluster=%s leashed=%t", req.App, req.Account, req.Region, req.Stack, req.Cluster, req.Leashed)
+
+	err = s.terminate(d, req.App, req.Account, req.Region, req.Stack, req.Cluster)
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+
+	resp := terminationResponse{}
+	if rec.trm != nil {
+		i := newInstanceResponse(rec.trm.Instance)
+		resp = terminationResponse{Terminated: true, Instance: &i, Time: &rec.trm.Time, Leashed: rec.trm.Leashed}
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// authorized checks that r carries the bearer token, and writes an error to
+// w if it doesn't
+func (s *Server) authorized(w http.ResponseWriter, r *http.Request) bool {
+	if s.token == "" {
+		writeError(w, http.StatusForbidden, errors.Errorf("terminations are disabled, set %s to enable them", param.ServerEncryptedToken))
+		return false
+	}
+
+	const prefix = "Bearer "
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, prefix) || subtle.ConstantTimeCompare([]byte(auth[len(prefix):]), []byte(s.token)) != 1 {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, http.StatusUnauthorized, errors.New("missing or invalid bearer token"))
+		return false
+	}
+
+	return true
+}
+
+// scheduleSeed returns the seed of today's schedule, that picks derive
+// from, or 0 if there is none
+func (s *Server) scheduleSeed() int64 {
+	loc, err := s.deps.MonkeyCfg.Location()
+	if err != nil {
+		log.Printf("WARNING: could not retrieve location: %v", err)
+		return 0
+	}
+
+	sched, err := s.store.Retrieve(s.deps.Cl.Now().In(loc))
+	if err != nil {
+		log.Printf("WARNING: could not retrieve today's schedule seed: %v", err)
+		return 0
+	}
+
+	if !schedstore.Published(sched) {
+		return 0
+	}
+
+	return sched.Seed()
+}
+
+// recorder is a tracker that remembers the termination
+type recorder struct {
+	trm *chaosmonkey.Termination
+}
+
+func (r *recorder) Track(trm chaosmonkey.Termination) error {
+	r.trm = &trm
+	return nil
+}
+
+// statusOf returns the HTTP status for a termination error
+func statusOf(err error) int {
+	switch errors.Cause(err).(type) {
+	case chaosmonkey.ErrViolatesMinTime:
+		return http.StatusConflict
+	case term.UnleashedInTestEnv:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// groupFrom returns the instance group for request parameters
+func groupFrom(app, account, region, stack, cluster string) (grp.InstanceGroup, error) {
+	if app == "" || account == "" {
+		return nil, errors.New("app and account are required")
+	}
+
+	return grp.New(app, account, region, stack, cluster), nil
+}
+
+// errorResponse is the body returned on errors
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	if status >= http.StatusInternalServerError {
+		log.Printf("ERROR: %v", err)
+	}
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(v)
+	if err != nil {
+		log.Printf("WARNING: could not write response: %v", err)
+	}
+}
@@ -0,0 +1,485 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/history"
+	"github.com/Netflix/chaosmonkey/v2/mock"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+// fakeStore holds schedules by date
+type fakeStore map[string]*schedule.Schedule
+
+// Retrieve returns an empty schedule for dates without one, like the real
+// stores
+func (s fakeStore) Retrieve(date time.Time) (*schedule.Schedule, error) {
+	sched, ok := s[date.Format(dateFormat)]
+	if !ok {
+		return schedule.New(), nil
+	}
+	return sched, nil
+}
+
+func (s fakeStore) Publish(date time.Time, sched *schedule.Schedule) error {
+	s[date.Format(dateFormat)] = sched
+	return nil
+}
+
+// fakeHistory returns fixed records and remembers the last query
+type fakeHistory struct {
+	records []history.Record
+	query   history.Query
+}
+
+func (h *fakeHistory) Terminations(q history.Query) ([]history.Record, error) {
+	h.query = q
+	return h.records, nil
+}
+
+var la, _ = time.LoadLocation("America/Los_Angeles")
+
+// testToken is the bearer token of test servers
+const testToken = "s3cr3t"
+
+type testServer struct {
+	*httptest.Server
+	deps    deps.Deps
+	store   fakeStore
+	history *fakeHistory
+}
+
+func newTestServer(t *testing.T) *testServer {
+	d := mock.Deps()
+	d.Cl = mock.Clock{Time: time.Date(2026, time.October, 19, 8, 0, 0, 0, la)}
+
+	ts := &testServer{deps: d, store: make(fakeStore), history: &fakeHistory{}}
+	ts.Server = httptest.NewServer(New(d, ts.store, ts.history, testToken, true, nil).Handler())
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// get does a GET and decodes the JSON response into v, returning the status
+func (ts *testServer) get(t *testing.T, path string, v interface{}) int {
+	resp, err := http.Get(ts.URL + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decode(t, resp, v)
+}
+
+// post does a POST of body with the test token and decodes the JSON response
+// into v, returning the status
+func (ts *testServer) post(t *testing.T, path string, body string, v interface{}) int {
+	return ts.postWithToken(t, path, testToken, body, v)
+}
+
+// postWithToken is post with a bearer token, none if blank
+func (ts *testServer) postWithToken(t *testing.T, path string, token string, body string, v interface{}) int {
+	req, err := http.NewRequest(http.MethodPost, ts.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decode(t, resp, v)
+}
+
+func decode(t *testing.T, resp *http.Response, v interface{}) int {
+	defer func() { _ = resp.Body.Close() }()
+
+	if got, want := resp.Header.Get("Content-Type"), "application/json"; got != want {
+		t.Errorf("got Content-Type=%q, want %q", got, want)
+	}
+
+	if v != nil {
+		err := json.NewDecoder(resp.Body).Decode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return resp.StatusCode
+}
+
+func TestGetSchedule(t *testing.T) {
+	ts := newTestServer(t)
+
+	sched := schedule.New()
+	sched.Add(time.Date(2026, time.October, 19, 13, 0, 0, 0, la), grp.New("bar", "prod", "", "", ""))
+	sched.Add(time.Date(2026, time.October, 19, 10, 0, 0, 0, la), grp.New("foo", "prod", "us-east-1", "", "foo-prod"))
+	ts.store["2026-10-19"] = sched
+
+	for _, path := range []string{"/v1/schedule", "/v1/schedule?date=2026-10-19"} {
+		var got struct {
+			Date    string
+			Entries []schedule.Entry
+		}
+		status := ts.get(t, path, &got)
+		if status != http.StatusOK {
+			t.Fatalf("%s: got status %d, want %d", path, status, http.StatusOK)
+		}
+
+		if got.Date != "2026-10-19" {
+			t.Errorf("%s: got date=%s, want 2026-10-19", path, got.Date)
+		}
+
+		if len(got.Entries) != 2 {
+			t.Fatalf("%s: got %d entries, want 2", path, len(got.Entries))
+		}
+
+		// Entries are sorted by time
+		if got, want := got.Entries[0].Group, grp.New("foo", "prod", "us-east-1", "", "foo-prod"); !grp.Equal(got, want) {
+			t.Errorf("%s: got first group %s, want %s", path, got, want)
+		}
+	}
+}
+
+func TestGetScheduleErrors(t *testing.T) {
+	ts := newTestServer(t)
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{"/v1/schedule", http.StatusNotFound},
+		{"/v1/schedule?date=2026-10-20", http.StatusNotFound},
+		{"/v1/schedule?date=tomorrow", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		var got errorResponse
+		status := ts.get(t, tt.path, &got)
+		if status != tt.status {
+			t.Errorf("%s: got status %d, want %d", tt.path, status, tt.status)
+		}
+		if got.Error == "" {
+			t.Errorf("%s: got no error message", tt.path)
+		}
+	}
+}
+
+func TestGetEligible(t *testing.T) {
+	ts := newTestServer(t)
+
+	var got []instanceResponse
+	status := ts.get(t, "/v1/eligible?app=foo&account=prod&cluster=foo-prod", &got)
+	if status != http.StatusOK {
+		t.Fatalf("got status %d, want %d", status, http.StatusOK)
+	}
+
+	var ids []string
+	for _, i := range got {
+		ids = append(ids, i.ID)
+		if i.App != "foo" || i.Account != "prod" || i.Cluster != "foo-prod" || i.Region != "us-east-1" {
+			t.Errorf("got unexpected instance %+v", i)
+		}
+	}
+
+	if want := []string{"i-63f52e25", "i-d3e3d611"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got ids=%v, want %v", ids, want)
+	}
+
+	status = ts.get(t, "/v1/eligible?app=foo", nil)
+	if status != http.StatusBadRequest {
+		t.Errorf("without account: got status %d, want %d", status, http.StatusBadRequest)
+	}
+}
+
+func TestGetConfig(t *testing.T) {
+	ts := newTestServer(t)
+
+	var got map[string]interface{}
+	status := ts.get(t, "/v1/config?app=foo", &got)
+	if status != http.StatusOK {
+		t.Fatalf("got status %d, want %d", status, http.StatusOK)
+	}
+
+	want := map[string]interface{}{
+		"enabled":                        true,
+		"regionsAreIndependent":          true,
+		"meanTimeBetweenKillsInWorkDays": 5.0,
+		"minTimeBetweenKillsInWorkDays":  1.0,
+		"grouping":                       "cluster",
+		"exceptions":                     []interface{}{},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestGetTerminations(t *testing.T) {
+	ts := newTestServer(t)
+	killedAt := time.Date(2026, time.October, 16, 17, 0, 0, 0, time.UTC)
+	ts.history.records = []history.Record{{App: "foo", Account: "prod", Cluster: "foo-prod", Region: "us-east-1", ASG: "foo-prod-v001", InstanceID: "i-d3e3d611", KilledAt: killedAt}}
+
+	var got []history.Record
+	status := ts.get(t, "/v1/terminations?app=foo&account=prod&region=us-east-1&cluster=foo-prod&since=2026-10-01&until=2026-10-16&leashed=false&limit=5", &got)
+	if status != http.StatusOK {
+		t.Fatalf("got status %d, want %d", status, http.StatusOK)
+	}
+
+	if !reflect.DeepEqual(got, ts.history.records) {
+		t.Errorf("got %+v, want %+v", got, ts.history.records)
+	}
+
+	q := ts.history.query
+	if q.App != "foo" || q.Account != "prod" || q.Region != "us-east-1" || q.Stack != "" || q.Cluster != "foo-prod" || q.Limit != 5 {
+		t.Errorf("got query %+v", q)
+	}
+
+	// Dates are in the configured time zone, and until includes the whole day
+	if want := time.Date(2026, time.October, 1, 0, 0, 0, 0, la); !q.Since.Equal(want) {
+		t.Errorf("got since=%s, want %s", q.Since, want)
+	}
+
+	if want := time.Date(2026, time.October, 17, 0, 0, 0, 0, la); !q.Until.Equal(want) {
+		t.Errorf("got until=%s, want %s", q.Until, want)
+	}
+
+	if q.Leashed == nil || *q.Leashed {
+		t.Errorf("got leashed=%v, want false", q.Leashed)
+	}
+
+	for _, params := range []string{"limit=-1", "since=yesterday", "until=2026-10-32", "leashed=maybe"} {
+		status = ts.get(t, "/v1/terminations?"+params, nil)
+		if status != http.StatusBadRequest {
+			t.Errorf("%s: got status %d, want %d", params, status, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestPostTermination(t *testing.T) {
+	ts := newTestServer(t)
+
+	var got terminationResponse
+	status := ts.post(t, "/v1/terminations", `{"app": "foo", "account": "prod", "cluster": "foo-prod"}`, &got)
+	if status != http.StatusOK {
+		t.Fatalf("got status %d, want %d", status, http.StatusOK)
+	}
+
+	if !got.Terminated || got.Leashed || got.Instance == nil || got.Instance.Cluster != "foo-prod" {
+		t.Errorf("got %+v, want an unleashed termination in foo-prod", got)
+	}
+
+	ttor := ts.deps.T.(*mock.Terminator)
+	if got, want := ttor.Ncalls, 1; got != want {
+		t.Errorf("got %d calls to terminator, want %d", got, want)
+	}
+}
+
+func TestPostTerminationLeashed(t *testing.T) {
+	ts := newTestServer(t)
+
+	var got terminationResponse
+	status := ts.post(t, "/v1/terminations", `{"app": "foo", "account": "prod", "leashed": true}`, &got)
+	if status != http.StatusOK {
+		t.Fatalf("got status %d, want %d", status, http.StatusOK)
+	}
+
+	if !got.Terminated || !got.Leashed {
+		t.Errorf("got %+v, want a leashed termination", got)
+	}
+
+	ttor := ts.deps.T.(*mock.Terminator)
+	if got, want := ttor.Ncalls, 0; got != want {
+		t.Errorf("got %d calls to terminator, want %d", got, want)
+	}
+}
+
+// When nothing is terminated, e.g. no eligible instances, the response says so
+func TestPostTerminationNothingEligible(t *testing.T) {
+	ts := newTestServer(t)
+
+	var got terminationResponse
+	status := ts.post(t, "/v1/terminations", `{"app": "foo", "account": "prod", "cluster": "foo-staging"}`, &got)
+	if status != http.StatusOK {
+		t.Fatalf("got status %d, want %d", status, http.StatusOK)
+	}
+
+	if got.Terminated || got.Instance != nil {
+		t.Errorf("got %+v, want no termination", got)
+	}
+}
+
+func TestPostTerminationErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		body    string
+		checker error
+		status  int
+	}{
+		{"invalid json", `{"app": `, nil, http.StatusBadRequest},
+		{"unknown field", `{"app": "foo", "account": "prod", "instance": "i-d3e3d611"}`, nil, http.StatusBadRequest},
+		{"missing account", `{"app": "foo"}`, nil, http.StatusBadRequest},
+		{"min time", `{"app": "foo", "account": "prod"}`, chaosmonkey.ErrViolatesMinTime{InstanceID: "i-63f52e25", KilledAt: time.Now()}, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		ts := newTestServer(t)
+		srv := New(ts.deps, ts.store, ts.history, testToken, true, nil)
+		srv.deps.Checker = mock.Checker{Error: tt.checker}
+		ts.Config.Handler = srv.Handler()
+
+		var got errorResponse
+		status := ts.post(t, "/v1/terminations", tt.body, &got)
+		if status != tt.status {
+			t.Errorf("%s: got status %d, want %d", tt.desc, status, tt.status)
+		}
+		if got.Error == "" {
+			t.Errorf("%s: got no error message", tt.desc)
+		}
+	}
+}
+
+// Terminating requires the bearer token, and is disabled without one
+func TestPostTerminationAuth(t *testing.T) {
+	ts := newTestServer(t)
+	body := `{"app": "foo", "account": "prod", "leashed": true}`
+
+	tests := []struct {
+		desc   string
+		token  string
+		status int
+	}{
+		{"no token", "", http.StatusUnauthorized},
+		{"wrong token", "guess", http.StatusUnauthorized},
+		{"token", testToken, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		if got, want := ts.postWithToken(t, "/v1/terminations", tt.token, body, nil), tt.status; got != want {
+			t.Errorf("%s: got status %d, want %d", tt.desc, got, want)
+		}
+	}
+
+	ts.Config.Handler = New(ts.deps, ts.store, ts.history, "", true, nil).Handler()
+	if got, want := ts.post(t, "/v1/terminations", body, nil), http.StatusForbidden; got != want {
+		t.Errorf("no token configured: got status %d, want %d", got, want)
+	}
+}
+
+// Unleashed terminations are refused unless the server allows them
+func TestPostTerminationUnleashedNotAllowed(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Config.Handler = New(ts.deps, ts.store, ts.history, testToken, false, nil).Handler()
+
+	var got errorResponse
+	if status := ts.post(t, "/v1/terminations", `{"app": "foo", "account": "prod"}`, &got); status != http.StatusForbidden {
+		t.Errorf("got status %d, want %d", status, http.StatusForbidden)
+	}
+
+	ttor := ts.deps.T.(*mock.Terminator)
+	if got, want := ttor.Ncalls, 0; got != want {
+		t.Errorf("got %d calls to terminator, want %d", got, want)
+	}
+
+	if status := ts.post(t, "/v1/terminations", `{"app": "foo", "account": "prod", "leashed": true}`, nil); status != http.StatusOK {
+		t.Errorf("leashed: got status %d, want %d", status, http.StatusOK)
+	}
+}
+
+// Terminations are made under the leader lease, from the seed of today's
+// schedule
+func TestPostTerminationLeader(t *testing.T) {
+	ts := newTestServer(t)
+	sched := schedule.New()
+	sched.SetSeed(42)
+	ts.store["2026-10-19"] = sched
+
+	tests := []struct {
+		desc   string
+		leader bool
+		status int
+		calls  int
+	}{
+		{"leader", true, http.StatusOK, 1},
+		{"not leader", false, http.StatusServiceUnavailable, 0},
+	}
+
+	for _, tt := range tests {
+		leader := tt.leader
+		srv := New(ts.deps, ts.store, ts.history, testToken, true, func() (int64, bool, error) { return 7, leader, nil })
+
+		var calls []deps.Deps
+		srv.terminate = func(d deps.Deps, app, account, region, stack, cluster string) error {
+			calls = append(calls, d)
+			return nil
+		}
+		ts.Config.Handler = srv.Handler()
+
+		if got, want := ts.post(t, "/v1/terminations", `{"app": "foo", "account": "prod"}`, nil), tt.status; got != want {
+			t.Errorf("%s: got status %d, want %d", tt.desc, got, want)
+		}
+
+		if got, want := len(calls), tt.calls; got != want {
+			t.Fatalf("%s: got %d terminations, want %d", tt.desc, got, want)
+		}
+
+		for _, d := range calls {
+			if d.FencingToken != 7 || d.Seed != 42 {
+				t.Errorf("%s: got fencing token %d and seed %d, want 7 and 42", tt.desc, d.FencingToken, d.Seed)
+			}
+		}
+	}
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	ts := newTestServer(t)
+
+	tests := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodPost, "/v1/schedule"},
+		{http.MethodDelete, "/v1/terminations"},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, ts.URL+tt.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		status := decode(t, resp, nil)
+		if status != http.StatusMethodNotAllowed {
+			t.Errorf("%s %s: got status %d, want %d", tt.method, tt.path, status, http.StatusMethodNotAllowed)
+		}
+	}
+}
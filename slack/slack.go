@@ -0,0 +1,243 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slack posts terminations, and warnings about upcoming ones, to the
+// Slack channels of the apps they affect.
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+// Slack is a tracker that posts terminations to Slack. It also implements
+// schedule.Warner.
+//
+// The channel of an app is, in order of precedence: its entry in the
+// configured channels, the notification channel of its app config, or the
+// default channel. Nothing is posted for apps without a channel.
+type Slack struct {
+	endpoint       string
+	token          string
+	channels       map[string]string
+	defaultChannel string
+	loc            *time.Location
+	client         *http.Client
+	blocking       bool
+
+	// getter is nil if channels aren't looked up in the app configs
+	getter chaosmonkey.AppConfigGetter
+}
+
+// NewFromConfig creates a new Slack taking config parameters from cfg
+func NewFromConfig(cfg *config.Monkey) (*Slack, error) {
+	if cfg.SlackEncryptedToken() == "" {
+		return nil, errors.Errorf("%s not specified", param.SlackEncryptedToken)
+	}
+
+	decryptor, err := deps.GetDecryptor(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := decryptor.Decrypt(cfg.SlackEncryptedToken())
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := cfg.Location()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve location")
+	}
+
+	return New(cfg.SlackEndpoint(), token, cfg.SlackChannels(), cfg.SlackDefaultChannel(), loc, cfg.SlackTimeout(), cfg.SlackBlocking()), nil
+}
+
+// New returns a Slack that posts with token to the Slack Web API at
+// endpoint, e.g. https://slack.com/api. channels maps app names to channels.
+// Times are shown in loc. If blocking is false, terminations that can't be
+// posted are logged instead of being reported to the caller, so they don't
+// prevent the termination.
+func New(endpoint string, token string, channels map[string]string, defaultChannel string, loc *time.Location, timeout time.Duration, blocking bool) *Slack {
+	// App names are case-insensitive, and config keys are lowercased
+	lower := make(map[string]string, len(channels))
+	for app, channel := range channels {
+		lower[strings.ToLower(app)] = channel
+	}
+
+	return &Slack{
+		endpoint:       strings.TrimSuffix(endpoint, "/"),
+		token:          token,
+		channels:       lower,
+		defaultChannel: defaultChannel,
+		loc:            loc,
+		client:         &http.Client{Timeout: timeout},
+		blocking:       blocking,
+	}
+}
+
+// SetAppConfigGetter makes s use the notification channel of the app
+// configs returned by getter
+func (s *Slack) SetAppConfigGetter(getter chaosmonkey.AppConfigGetter) {
+	s.getter = getter
+}
+
+// Track posts a termination to the channel of its app
+func (s *Slack) Track(t chaosmonkey.Termination) error {
+	i := t.Instance
+
+	var text string
+	if t.Leashed {
+		text = fmt.Sprintf("Chaos Monkey would have terminated instance %s of %s in %s %s (leashed, nothing was terminated)",
+			i.ID(), i.ASGName(), i.AccountName(), i.RegionName())
+	} else {
+		text = fmt.Sprintf(":monkey_face: Chaos Monkey terminated instance %s of %s in %s %s",
+			i.ID(), i.ASGName(), i.AccountName(), i.RegionName())
+	}
+
+	err := s.notify(i.AppName(), text)
+	if err != nil && !s.blocking {
+		log.Printf("WARNING: could not post termination to slack: %v", err)
+		return nil
+	}
+	return err
+}
+
+// Warn implements schedule.Warner.Warn
+func (s *Slack) Warn(e schedule.Entry, leashed bool) error {
+	text := fmt.Sprintf(":warning: Chaos Monkey will terminate an instance of %s at %s",
+		describe(e.Group), e.Time.In(s.loc).Format("15:04 MST"))
+	if leashed {
+		text += " (leashed, nothing will be terminated)"
+	}
+
+	return s.notify(e.Group.App(), text)
+}
+
+// describe returns a human-readable description of a group
+func describe(group grp.InstanceGroup) string {
+	result := group.App()
+	if cluster, ok := group.Cluster(); ok {
+		result = cluster
+	} else if stack, ok := group.Stack(); ok {
+		result = fmt.Sprintf("%s-%s", group.App(), stack)
+	}
+
+	result = fmt.Sprintf("%s in %s", result, group.Account())
+	if region, ok := group.Region(); ok {
+		result = fmt.Sprintf("%s %s", result, region)
+	}
+
+	return result
+}
+
+// notify posts text to the channel of app, if it has one
+func (s *Slack) notify(app string, text string) error {
+	channel, err := s.channel(app)
+	if err != nil {
+		return err
+	}
+
+	if channel == "" {
+		return nil
+	}
+
+	return s.post(channel, text)
+}
+
+// channel returns the channel of app, blank if none
+func (s *Slack) channel(app string) (string, error) {
+	if channel, ok := s.channels[strings.ToLower(app)]; ok {
+		return channel, nil
+	}
+
+	if s.getter != nil {
+		cfg, err := s.getter.Get(app)
+		if err != nil {
+			return "", errors.Wrapf(err, "could not retrieve config for app %s", app)
+		}
+
+		if cfg.NotificationChannel != "" {
+			return cfg.NotificationChannel, nil
+		}
+	}
+
+	return s.defaultChannel, nil
+}
+
+// postMessageResponse is the part of the chat.postMessage response that we
+// look at
+type postMessageResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+// post posts a message to a channel
+// See: https://api.slack.com/methods/chat.postMessage
+func (s *Slack) post(channel string, text string) (err error) {
+	body, err := json.Marshal(map[string]string{"channel": channel, "text": text})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal message")
+	}
+
+	url := s.endpoint + "/chat.postMessage"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+s.token)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "http post failed at %s", url)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = errors.Wrapf(cerr, "body close failed at %s", url)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected response code (%d) from %s", resp.StatusCode, url)
+	}
+
+	var parsed postMessageResponse
+	err = json.NewDecoder(resp.Body).Decode(&parsed)
+	if err != nil {
+		return errors.Wrapf(err, "could not decode response from %s", url)
+	}
+
+	if !parsed.OK {
+		return errors.Errorf("could not post to %s: %s", channel, parsed.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/mock"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+// message is a message posted to the stand-in
+type message struct {
+	Channel string `json:"channel"`
+	Text    string `json:"text"`
+}
+
+// standIn is a local stand-in for the Slack Web API
+type standIn struct {
+	t        *testing.T
+	messages []message
+
+	// fail is the error returned for every message, if not blank
+	fail string
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/api/chat.postMessage" {
+		s.t.Errorf("unexpected path: %s", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if got, want := r.Header.Get("Authorization"), "Bearer xoxb-token"; got != want {
+		s.t.Errorf("got Authorization=%q, want %q", got, want)
+	}
+
+	var m message
+	err := json.NewDecoder(r.Body).Decode(&m)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	s.messages = append(s.messages, m)
+
+	if s.fail != "" {
+		fmt.Fprintf(w, `{"ok": false, "error": %q}`, s.fail)
+		return
+	}
+	fmt.Fprint(w, `{"ok": true}`)
+}
+
+var la, _ = time.LoadLocation("America/Los_Angeles")
+
+func newSlack(t *testing.T, channels map[string]string, defaultChannel string) (*Slack, *standIn, func()) {
+	s := &standIn{t: t}
+	ts := httptest.NewServer(s)
+	return New(ts.URL+"/api/", "xoxb-token", channels, defaultChannel, la, time.Second, true), s, ts.Close
+}
+
+func termination(app string, leashed bool) chaosmonkey.Termination {
+	return chaosmonkey.Termination{
+		Instance: mock.Instance{
+			App:        app,
+			Account:    "prod",
+			Region:     "us-east-1",
+			Cluster:    app + "-prod",
+			ASG:        app + "-prod-v001",
+			InstanceID: "i-12345678",
+		},
+		Time:    time.Date(2026, time.October, 13, 18, 30, 0, 0, time.UTC),
+		Leashed: leashed,
+	}
+}
+
+func TestTrack(t *testing.T) {
+	s, api, done := newSlack(t, map[string]string{"Foo": "#foo-team"}, "")
+	defer done()
+
+	err := s.Track(termination("foo", false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []message{{
+		Channel: "#foo-team",
+		Text:    ":monkey_face: Chaos Monkey terminated instance i-12345678 of foo-prod-v001 in prod us-east-1",
+	}}
+	if got := api.messages; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestTrackLeashed(t *testing.T) {
+	s, api, done := newSlack(t, map[string]string{"foo": "#foo-team"}, "")
+	defer done()
+
+	err := s.Track(termination("foo", true))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(api.messages) != 1 || !strings.Contains(api.messages[0].Text, "leashed, nothing was terminated") {
+		t.Errorf("got %+v, want a leashed message", api.messages)
+	}
+}
+
+func TestChannels(t *testing.T) {
+	s, api, done := newSlack(t, map[string]string{"foo": "#foo-team"}, "#chaos")
+	defer done()
+
+	getter := mock.NewConfigGetter(chaosmonkey.AppConfig{NotificationChannel: "#from-app-config"})
+	s.SetAppConfigGetter(getter)
+
+	for _, app := range []string{"foo", "bar"} {
+		err := s.Track(termination(app, false))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The config file takes precedence over the app config
+	var got []string
+	for _, m := range api.messages {
+		got = append(got, m.Channel)
+	}
+	if want := []string{"#foo-team", "#from-app-config"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got channels %v, want %v", got, want)
+	}
+}
+
+func TestDefaultChannel(t *testing.T) {
+	s, api, done := newSlack(t, nil, "#chaos")
+	defer done()
+
+	s.SetAppConfigGetter(mock.NewConfigGetter(chaosmonkey.AppConfig{}))
+
+	err := s.Track(termination("foo", false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(api.messages) != 1 || api.messages[0].Channel != "#chaos" {
+		t.Errorf("got %+v, want a message to #chaos", api.messages)
+	}
+}
+
+func TestNoChannel(t *testing.T) {
+	s, api, done := newSlack(t, nil, "")
+	defer done()
+
+	err := s.Track(termination("foo", false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(api.messages) != 0 {
+		t.Errorf("got %+v, want no messages", api.messages)
+	}
+}
+
+func TestWarn(t *testing.T) {
+	s, api, done := newSlack(t, map[string]string{"foo": "#foo-team"}, "")
+	defer done()
+
+	e := schedule.Entry{
+		Time:  time.Date(2026, time.October, 13, 18, 30, 0, 0, time.UTC),
+		Group: grp.New("foo", "prod", "us-east-1", "", "foo-prod"),
+	}
+
+	err := s.Warn(e, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := message{
+		Channel: "#foo-team",
+		Text:    ":warning: Chaos Monkey will terminate an instance of foo-prod in prod us-east-1 at 11:30 PDT (leashed, nothing will be terminated)",
+	}
+	if len(api.messages) != 1 || api.messages[0] != want {
+		t.Errorf("got %+v, want %+v", api.messages, want)
+	}
+}
+
+func TestPostFailure(t *testing.T) {
+	s, api, done := newSlack(t, nil, "#chaos")
+	defer done()
+	api.fail = "channel_not_found"
+
+	err := s.Track(termination("foo", false))
+	if err == nil || !strings.Contains(err.Error(), "channel_not_found") {
+		t.Errorf("got %v, want channel_not_found error", err)
+	}
+}
+
+// failingGetter is an app config getter that always fails
+type failingGetter struct{}
+
+func (failingGetter) Get(app string) (*chaosmonkey.AppConfig, error) {
+	return nil, errors.New("spinnaker is down")
+}
+
+// Unless blocking, failures to post or to find the channel don't prevent the
+// termination
+func TestTrackNonBlocking(t *testing.T) {
+	s, api, done := newSlack(t, nil, "#chaos")
+	defer done()
+	s.blocking = false
+	api.fail = "channel_not_found"
+
+	err := s.Track(termination("foo", false))
+	if err != nil {
+		t.Errorf("got %v, want failed post to be ignored", err)
+	}
+
+	s.SetAppConfigGetter(failingGetter{})
+	err = s.Track(termination("foo", false))
+	if err != nil {
+		t.Errorf("got %v, want failed channel lookup to be ignored", err)
+	}
+
+	if got, want := len(api.messages), 1; got != want {
+		t.Errorf("got %d messages, want %d", got, want)
+	}
+
+	s.blocking = true
+	err = s.Track(termination("foo", false))
+	if err == nil || !strings.Contains(err.Error(), "spinnaker is down") {
+		t.Errorf("got %v, want failed channel lookup to block", err)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package snooze provides temporary opt-outs from terminations, stored in the
+// database.
+//
+// A snooze keeps Chaos Monkey away from an app, or from one of its accounts
+// or clusters, until it expires. Unlike disabling the app in Spinnaker, it
+// does not need to be reverted.
+package snooze
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+)
+
+// Snooze is a temporary opt-out from terminations
+type Snooze struct {
+	App     string
+	Account string // blank for all accounts
+	Cluster string // blank for all clusters
+
+	Until  time.Time // the snooze expires at this time
+	By     string    // who snoozed
+	Reason string
+	At     time.Time // when it was snoozed
+}
+
+// Store stores snoozes
+type Store interface {
+	// Snooze records s, replacing any snooze of the same app, account and
+	// cluster
+	Snooze(s Snooze) error
+
+	// Unsnooze ends the snooze of app, account and cluster at now. It
+	// returns false if there was no such snooze active at now.
+	Unsnooze(app string, account string, cluster string, now time.Time) (bool, error)
+
+	// Snoozes returns the snoozes active at now, ordered by app, account and
+	// cluster
+	Snoozes(now time.Time) ([]Snooze, error)
+}
+
+// Check returns an error if s is not a valid snooze
+func (s Snooze) Check() error {
+	switch {
+	case s.App == "":
+		return errors.New("snooze has no app")
+	case s.Until.IsZero():
+		return errors.New("snooze has no expiry")
+	case !s.Until.After(s.At):
+		return errors.Errorf("snooze expires at %s, before it starts", s.Until)
+	}
+	return nil
+}
+
+// Active returns true if s is in effect at t
+func (s Snooze) Active(t time.Time) bool {
+	return t.Before(s.Until)
+}
+
+// CoversGroup returns true if s covers all instances of group at t
+func (s Snooze) CoversGroup(group grp.InstanceGroup, t time.Time) bool {
+	if !s.Active(t) || s.App != group.App() {
+		return false
+	}
+
+	if s.Account != "" && s.Account != group.Account() {
+		return false
+	}
+
+	if s.Cluster != "" {
+		cluster, ok := group.Cluster()
+		return ok && s.Cluster == cluster
+	}
+
+	return true
+}
+
+// CoversInstance returns true if s covers instance at t
+func (s Snooze) CoversInstance(instance chaosmonkey.Instance, t time.Time) bool {
+	return s.Active(t) &&
+		s.App == instance.AppName() &&
+		(s.Account == "" || s.Account == instance.AccountName()) &&
+		(s.Cluster == "" || s.Cluster == instance.ClusterName())
+}
+
+// Group returns the first of snoozes that covers all instances of group at
+// t, and false if none does
+func Group(snoozes []Snooze, group grp.InstanceGroup, t time.Time) (Snooze, bool) {
+	for _, s := range snoozes {
+		if s.CoversGroup(group, t) {
+			return s, true
+		}
+	}
+	return Snooze{}, false
+}
+
+// Instance returns the first of snoozes that covers instance at t, and
+// false if none does
+func Instance(snoozes []Snooze, instance chaosmonkey.Instance, t time.Time) (Snooze, bool) {
+	for _, s := range snoozes {
+		if s.CoversInstance(instance, t) {
+			return s, true
+		}
+	}
+	return Snooze{}, false
+}
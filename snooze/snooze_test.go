@@ -0,0 +1,103 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snooze_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/mock"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
+)
+
+func TestCoversGroup(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	until := now.Add(time.Hour)
+
+	tests := []struct {
+		desc  string
+		s     snooze.Snooze
+		group grp.InstanceGroup
+		t     time.Time
+		want  bool
+	}{
+		{"app", snooze.Snooze{App: "foo", Until: until}, grp.New("foo", "prod", "", "", "foo-prod"), now, true},
+		{"other app", snooze.Snooze{App: "bar", Until: until}, grp.New("foo", "prod", "", "", "foo-prod"), now, false},
+		{"account", snooze.Snooze{App: "foo", Account: "prod", Until: until}, grp.New("foo", "prod", "", "", ""), now, true},
+		{"other account", snooze.Snooze{App: "foo", Account: "test", Until: until}, grp.New("foo", "prod", "", "", ""), now, false},
+		{"cluster", snooze.Snooze{App: "foo", Cluster: "foo-prod", Until: until}, grp.New("foo", "prod", "us-east-1", "", "foo-prod"), now, true},
+		{"other cluster", snooze.Snooze{App: "foo", Cluster: "foo-staging", Until: until}, grp.New("foo", "prod", "", "", "foo-prod"), now, false},
+		{"cluster of app group", snooze.Snooze{App: "foo", Cluster: "foo-prod", Until: until}, grp.New("foo", "prod", "", "", ""), now, false},
+		{"expired", snooze.Snooze{App: "foo", Until: until}, grp.New("foo", "prod", "", "", ""), until, false},
+	}
+
+	for _, tt := range tests {
+		if got, want := tt.s.CoversGroup(tt.group, tt.t), tt.want; got != want {
+			t.Errorf("%s: CoversGroup()=%t, want %t", tt.desc, got, want)
+		}
+	}
+}
+
+func TestInstance(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	snoozes := []snooze.Snooze{
+		{App: "foo", Account: "prod", Cluster: "foo-prod", Until: now.Add(time.Hour), Reason: "migration"},
+		{App: "bar", Until: now.Add(-time.Hour), Reason: "expired"},
+	}
+
+	tests := []struct {
+		desc     string
+		instance mock.Instance
+		want     string // reason of the snooze, blank if none
+	}{
+		{"snoozed cluster", mock.Instance{App: "foo", Account: "prod", Cluster: "foo-prod"}, "migration"},
+		{"other cluster", mock.Instance{App: "foo", Account: "prod", Cluster: "foo-staging"}, ""},
+		{"other account", mock.Instance{App: "foo", Account: "test", Cluster: "foo-prod"}, ""},
+		{"expired", mock.Instance{App: "bar", Account: "prod", Cluster: "bar"}, ""},
+	}
+
+	for _, tt := range tests {
+		s, ok := snooze.Instance(snoozes, tt.instance, now)
+		if got, want := ok, tt.want != ""; got != want {
+			t.Errorf("%s: snoozed=%t, want %t", tt.desc, got, want)
+			continue
+		}
+		if got, want := s.Reason, tt.want; got != want {
+			t.Errorf("%s: got snooze %q, want %q", tt.desc, got, want)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		desc string
+		s    snooze.Snooze
+		ok   bool
+	}{
+		{"valid", snooze.Snooze{App: "foo", Until: now.Add(time.Hour), At: now}, true},
+		{"no app", snooze.Snooze{Until: now.Add(time.Hour), At: now}, false},
+		{"no expiry", snooze.Snooze{App: "foo", At: now}, false},
+		{"expires before start", snooze.Snooze{App: "foo", Until: now, At: now}, false},
+	}
+
+	for _, tt := range tests {
+		if got, want := tt.s.Check() == nil, tt.ok; got != want {
+			t.Errorf("%s: valid=%t, want %t", tt.desc, got, want)
+		}
+	}
+}
@@ -48,5 +48,5 @@ func (s Spinnaker) Get(app string) (c *chaosmonkey.AppConfig, err error) {
 		return nil, errors.Wrapf(err, "body read failed at %s", url)
 	}
 
-	return fromJSON(body)
+	return FromJSON(body)
 }
@@ -17,6 +17,7 @@ package spinnaker
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/Netflix/chaosmonkey/v2"
 
@@ -36,6 +37,12 @@ import (
 //	        "minTimeBetweenKillsInWorkDays": 1,
 //	        "grouping": "cluster",
 //	        "regionsAreIndependent": false,
+//	        "notificationChannel": "#abc-team",
+//	        "timeZone": "Europe/Dublin",
+//	        "killWindows": [
+//	          {"startHour": 10, "endHour": 12},
+//	          {"startHour": 14, "endHour": 16}
+//	        ]
 //	      },
 //	      "exceptions" : [
 //	          {
@@ -90,7 +97,7 @@ import (
 //	 	  	}
 //	 	  ]
 //		  }
-func fromJSON(js []byte) (*chaosmonkey.AppConfig, error) {
+func FromJSON(js []byte) (*chaosmonkey.AppConfig, error) {
 	parsed := new(parsedJSON)
 	err := json.Unmarshal(js, parsed)
 
@@ -165,6 +172,11 @@ func fromJSON(js []byte) (*chaosmonkey.AppConfig, error) {
 		}
 	}
 
+	err = chaosmonkey.CheckKillWindows(cm.KillWindows)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid attributes.chaosMonkey.killWindows")
+	}
+
 	cfg := chaosmonkey.AppConfig{
 		Enabled:                        *cm.Enabled,
 		RegionsAreIndependent:          cm.RegionsAreIndependent,
@@ -173,6 +185,14 @@ func fromJSON(js []byte) (*chaosmonkey.AppConfig, error) {
 		MinTimeBetweenKillsInWorkDays:  minTime,
 		Exceptions:                     cm.Exceptions,
 		Whitelist:                      cm.Whitelist,
+		NotificationChannel:            cm.NotificationChannel,
+		KillWindows:                    cm.KillWindows,
+		TimeZone:                       cm.TimeZone,
+	}
+
+	_, err = cfg.Location(time.UTC)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid attributes.chaosMonkey.timeZone")
 	}
 
 	return &cfg, nil
@@ -196,4 +216,7 @@ type parsedChaosMonkey struct {
 	RegionsAreIndependent          bool                     `json:"regionsAreIndependent"`
 	Exceptions                     []chaosmonkey.Exception  `json:"exceptions"`
 	Whitelist                      *[]chaosmonkey.Exception `json:"whitelist"`
+	NotificationChannel            string                   `json:"notificationChannel"`
+	KillWindows                    []chaosmonkey.KillWindow `json:"killWindows"`
+	TimeZone                       string                   `json:"timeZone"`
 }
@@ -15,6 +15,7 @@
 package spinnaker
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/Netflix/chaosmonkey/v2"
@@ -31,6 +32,7 @@ func TestFromJSON(t *testing.T) {
 				  "minTimeBetweenKillsInWorkDays": 1,
 				  "grouping": "cluster",
 				  "regionsAreIndependent": true,
+				  "notificationChannel": "#abc-team",
 				  "exceptions" : [
 				  {
 					  "account": "test",
@@ -49,7 +51,7 @@ func TestFromJSON(t *testing.T) {
 		  }
 	  }
   `
-	actual, err := fromJSON([]byte(input))
+	actual, err := FromJSON([]byte(input))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -69,6 +71,10 @@ func TestFromJSON(t *testing.T) {
 		t.Errorf("Expected grouping to be Cluster, was %s", actual.Grouping)
 	}
 
+	if actual.NotificationChannel != "#abc-team" {
+		t.Errorf("Expected notification channel #abc-team, was %s", actual.NotificationChannel)
+	}
+
 	expectedEx := []chaosmonkey.Exception{
 		{Account: "test", Stack: "*", Detail: "*", Region: "*"},
 		{Account: "prod", Stack: "*", Detail: "*", Region: "eu-west-1"},
@@ -124,7 +130,7 @@ func TestFromJSONDisabled(t *testing.T) {
 	}
 	`
 
-	actual, err := fromJSON([]byte(input))
+	actual, err := FromJSON([]byte(input))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -164,10 +170,17 @@ func TestBadJSON(t *testing.T) {
 				"enabled": true, "grouping": "app", "meanTimeBetweenKillsInWorkDays": 1, "minTimeBetweenKillsInWorkDays": 1,
 				"exceptions": [{"region": "*"}]
 	    }}}`,
+
+		// kill windows must be within a day and not overlap
+		`{"name": "abc", "attributes": {"chaosMonkey": {"enabled": true, "grouping": "app", "meanTimeBetweenKillsInWorkDays": 1, "minTimeBetweenKillsInWorkDays": 1, "killWindows": [{"startHour": 12, "endHour": 10}]}}}`,
+		`{"name": "abc", "attributes": {"chaosMonkey": {"enabled": true, "grouping": "app", "meanTimeBetweenKillsInWorkDays": 1, "minTimeBetweenKillsInWorkDays": 1, "killWindows": [{"startHour": 9, "endHour": 12}, {"startHour": 11, "endHour": 13}]}}}`,
+
+		// time zone must be known
+		`{"name": "abc", "attributes": {"chaosMonkey": {"enabled": true, "grouping": "app", "meanTimeBetweenKillsInWorkDays": 1, "minTimeBetweenKillsInWorkDays": 1, "timeZone": "Europe/Atlantis"}}}`,
 	}
 
 	for _, input := range tests {
-		_, err := fromJSON([]byte(input))
+		_, err := FromJSON([]byte(input))
 		if err == nil {
 			t.Fatalf("Expected an error given missing config: %s", input)
 		}
@@ -204,7 +217,7 @@ func TestFromJSONEmptyWhitelist(t *testing.T) {
 		  }
 	  }
   `
-	actual, err := fromJSON([]byte(input))
+	actual, err := FromJSON([]byte(input))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -249,7 +262,7 @@ func TestFromJSONPopulatedWhitelist(t *testing.T) {
 		  }
 	  }
   `
-	actual, err := fromJSON([]byte(input))
+	actual, err := FromJSON([]byte(input))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -296,3 +309,38 @@ func TestFromJSONPopulatedWhitelist(t *testing.T) {
 		}
 	}
 }
+
+func TestFromJSONKillWindows(t *testing.T) {
+	input := `
+	{
+		"name": "abc",
+		"attributes": {
+			"chaosMonkey": {
+				"enabled": true,
+				"meanTimeBetweenKillsInWorkDays": 5,
+				"minTimeBetweenKillsInWorkDays": 1,
+				"grouping": "cluster",
+				"timeZone": "Australia/Sydney",
+				"killWindows": [{"startHour": 10, "endHour": 12}, {"startHour": 14, "endHour": 16}]
+			}
+		}
+	}`
+
+	actual, err := FromJSON([]byte(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actual.TimeZone != "Australia/Sydney" {
+		t.Errorf("Expected time zone Australia/Sydney, was %s", actual.TimeZone)
+	}
+
+	expected := []chaosmonkey.KillWindow{{StartHour: 10, EndHour: 12}, {StartHour: 14, EndHour: 16}}
+	if !reflect.DeepEqual(actual.KillWindows, expected) {
+		t.Errorf("Expected kill windows %v, was %v", expected, actual.KillWindows)
+	}
+
+	if got, want := actual.EndHour(15), 16; got != want {
+		t.Errorf("Expected end hour %d, was %d", want, got)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spinnaker
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2/metrics"
+)
+
+// endpointSegments are the literal path segments of the Spinnaker API
+// endpoints we call. Other segments are names of apps, accounts, etc., and are
+// replaced by "*" in metric labels to keep their number bounded.
+var endpointSegments = map[string]bool{
+	"applications": true,
+	"clusters":     true,
+	"serverGroups": true,
+	"credentials":  true,
+	"instances":    true,
+	"tasks":        true,
+	"target":       true,
+	"CURRENT":      true,
+}
+
+// instrumentedTransport records the latency and failures of Spinnaker API
+// calls
+type instrumentedTransport struct {
+	next http.RoundTripper
+
+	// prefix is the path of the Spinnaker endpoint, which is left out of
+	// metric labels
+	prefix string
+}
+
+// instrument makes client record metrics about the calls to the Spinnaker
+// API at endpoint
+func instrument(client *http.Client, endpoint string) {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	var prefix string
+	if u, err := url.Parse(endpoint); err == nil {
+		prefix = strings.TrimSuffix(u.Path, "/")
+	}
+
+	client.Transport = instrumentedTransport{next: next, prefix: prefix}
+}
+
+// RoundTrip implements http.RoundTripper.RoundTrip
+func (t instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := endpointLabel(strings.TrimPrefix(req.URL.Path, t.prefix))
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	metrics.SpinnakerRequestDuration.Observe(time.Since(start).Seconds(), req.Method, endpoint)
+
+	if err != nil || resp.StatusCode >= 500 {
+		metrics.SpinnakerRequestErrors.Inc(req.Method, endpoint)
+	}
+
+	return resp, err
+}
+
+// endpointLabel returns the path of an API call with names replaced by "*",
+// e.g. /applications/*/clusters
+func endpointLabel(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, s := range segments {
+		if !endpointSegments[s] {
+			segments[i] = "*"
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spinnaker
+
+import "testing"
+
+func TestEndpointLabel(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/applications/foo", "/applications/*"},
+		{"/applications/foo/clusters/prod/foo-prod", "/applications/*/clusters/*/*"},
+		{"/credentials/prod", "/credentials/*"},
+		{"/applications/foo/serverGroups/prod/foo-prod-v001/instances", "/applications/*/serverGroups/*/*/instances"},
+		{"/tasks/", "/tasks"},
+	}
+
+	for _, tt := range tests {
+		if got := endpointLabel(tt.path); got != tt.want {
+			t.Errorf("endpointLabel(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
@@ -164,6 +164,8 @@ func New(endpoint string, certPath string, password string, x509Cert string, x50
 		client = new(http.Client)
 	}
 
+	instrument(client, endpoint)
+
 	return Spinnaker{endpoint: endpoint, client: client, user: user}, nil
 }
 
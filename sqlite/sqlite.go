@@ -0,0 +1,387 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlite provides a SQLite-backed store for schedules and
+// terminations. It is intended for small deployments and testing, where
+// running a MySQL server is not practical.
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	// Registers the "sqlite3" database/sql driver
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
+	"github.com/rubenv/sql-migrate"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/cal"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/migration"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+)
+
+const (
+	// dateFormat is how DATE columns are stored
+	dateFormat = "2006-01-02"
+
+	// timeFormat is how DATETIME columns are stored. All times are stored in
+	// UTC, so lexical and chronological order are the same
+	timeFormat = "2006-01-02 15:04:05"
+
+	// busyTimeoutMs is how long a connection waits for a lock held by another
+	// connection before giving up
+	busyTimeoutMs = 10000
+)
+
+// SQLite represents a SQLite-backed store for schedules and terminations
+type SQLite struct {
+	db *sql.DB
+}
+
+// NewFromConfig creates a new SQLite taking config parameters from cfg
+// The database name is interpreted as the path to the database file
+func NewFromConfig(cfg *config.Monkey) (SQLite, error) {
+	if cfg.DatabaseName() == "" {
+		return SQLite{}, errors.Errorf("%s not specified", param.DatabaseName)
+	}
+
+	return New(cfg.DatabaseName())
+}
+
+// New creates a new SQLite that stores its data in the file at path.
+// The file is created if it does not exist.
+func New(path string) (SQLite, error) {
+	db, err := sql.Open("sqlite3", dsn(path))
+	if err != nil {
+		return SQLite{}, errors.Wrap(err, "sql.Open failed")
+	}
+
+	return SQLite{db}, nil
+}
+
+// Close closes the underlying sql.DB
+func (s SQLite) Close() error {
+	return s.db.Close()
+}
+
+// dsn returns a go-sqlite3 connection string (data source name)
+// See: https://github.com/mattn/go-sqlite3#connection-string
+func dsn(path string) string {
+	// SQLite transactions are always serializable. Starting them with
+	// BEGIN IMMEDIATE takes the write lock up front, which gives us the atomic
+	// test & set behavior we need, and the busy timeout makes concurrent
+	// writers wait for the lock instead of failing right away
+	return fmt.Sprintf("file:%s?_txlock=immediate&_busy_timeout=%d", path, busyTimeoutMs)
+}
+
+// sqlDate formats a date in a local time zone as a DATE column value
+func sqlDate(date time.Time) string {
+	return date.Format(dateFormat)
+}
+
+// sqlTime formats a time as a DATETIME column value, in UTC
+func sqlTime(tm time.Time) string {
+	return tm.UTC().Format(timeFormat)
+}
+
+// Retrieve retrieves the schedule for the given date
+func (s SQLite) Retrieve(date time.Time) (sched *schedule.Schedule, err error) {
+	rows, err := s.db.Query("SELECT time, app, account, region, stack, cluster FROM schedules WHERE date = ?", sqlDate(date))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve schedule for %s", date)
+	}
+
+	sched = schedule.New()
+
+	defer func() {
+		if cerr := rows.Close(); cerr != nil && err == nil {
+			err = errors.Wrap(cerr, "rows.Close() failed")
+		}
+	}()
+
+	for rows.Next() {
+		var tm time.Time
+		var app, account, region, stack, cluster string
+
+		err = rows.Scan(&tm, &app, &account, &region, &stack, &cluster)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan row")
+		}
+
+		sched.Add(tm, grp.New(app, account, region, stack, cluster))
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "rows.Err() errored")
+	}
+
+	var seed int64
+	var anchor sql.NullTime
+	err = s.db.QueryRow("SELECT seed, anchor FROM schedule_seeds WHERE date = ?", sqlDate(date)).Scan(&seed, &anchor)
+	switch {
+	case err == sql.ErrNoRows:
+		// Schedules published before seeds were recorded have none. Those
+		// published before anchors were recorded have a NULL anchor.
+		err = nil
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to retrieve schedule seed for %s", date)
+	}
+	sched.SetSeed(seed)
+	sched.SetAnchor(anchor.Time)
+
+	return sched, nil
+}
+
+// Publish publishes the schedule for the given date
+func (s SQLite) Publish(date time.Time, sched *schedule.Schedule) error {
+	return s.PublishWithDelay(date, sched, 0)
+}
+
+// PublishWithDelay publishes the schedule with a delay between checking the schedule
+// exists and writing it. The delay is used only for testing race conditions
+func (s SQLite) PublishWithDelay(date time.Time, sched *schedule.Schedule, delay time.Duration) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	// We must either commit or rollback at the end
+	defer func() {
+		switch err {
+		case nil:
+			err = tx.Commit()
+		default:
+			_ = tx.Rollback()
+		}
+	}()
+
+	exists, err := schedExists(tx, date)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return schedstore.ErrAlreadyExists
+	}
+
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+
+	query := "INSERT INTO schedules (date, time, app, account, region, stack, cluster) VALUES (?, ?, ?, ?, ?, ?, ?)"
+	stmt, err := tx.Prepare(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to prepare sql statement: %s", query)
+	}
+
+	defer func() {
+		if cerr := stmt.Close(); cerr != nil && err == nil {
+			err = errors.Wrap(cerr, "stmt.Close() failed")
+		}
+	}()
+
+	for _, entry := range sched.Entries() {
+		var app, account, region, stack, cluster string
+		app = entry.Group.App()
+		account = entry.Group.Account()
+		if val, ok := entry.Group.Region(); ok {
+			region = val
+		}
+		if val, ok := entry.Group.Stack(); ok {
+			stack = val
+		}
+		if val, ok := entry.Group.Cluster(); ok {
+			cluster = val
+		}
+
+		_, err = stmt.Exec(sqlDate(date), sqlTime(entry.Time), app, account, region, stack, cluster)
+		if err != nil {
+			return errors.Wrapf(err, "failed to execute prepared query")
+		}
+	}
+
+	if sched.Seed() != 0 {
+		var anchor interface{}
+		if !sched.Anchor().IsZero() {
+			anchor = sqlTime(sched.Anchor())
+		}
+
+		_, err = tx.Exec("INSERT INTO schedule_seeds (date, seed, anchor) VALUES (?, ?, ?)", sqlDate(date), sched.Seed(), anchor)
+		if err != nil {
+			return errors.Wrapf(err, "failed to record schedule seed for %s", date)
+		}
+	}
+
+	return nil
+}
+
+// schedExists returns true if a schedule has previously been
+// published for this date. A schedule without terminations is published if
+// its seed was recorded. Amendments don't count: a day whose entries were all
+// cancelled, and that has no seed, may be published again.
+func schedExists(tx *sql.Tx, date time.Time) (bool, error) {
+	var count int
+	err := tx.QueryRow("SELECT (SELECT COUNT(*) FROM schedules WHERE date = ?) + (SELECT COUNT(*) FROM schedule_seeds WHERE date = ?)", sqlDate(date), sqlDate(date)).Scan(&count)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to check if schedule exists for %s", date)
+	}
+
+	return count > 0, nil
+}
+
+// Check checks if a termination is permitted and, if so, records the
+// termination time on the server
+func (s SQLite) Check(term chaosmonkey.Termination, appCfg chaosmonkey.AppConfig, endHour int, loc *time.Location) error {
+	return s.CheckWithDelay(term, appCfg, endHour, loc, 0)
+}
+
+// CheckWithDelay is the same as Check, but adds a delay between reading and
+// writing to the database (used for testing only)
+func (s SQLite) CheckWithDelay(term chaosmonkey.Termination, appCfg chaosmonkey.AppConfig, endHour int, loc *time.Location, delay time.Duration) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	defer func() {
+		switch err {
+		case nil:
+			err = tx.Commit()
+		default:
+			_ = tx.Rollback()
+		}
+	}()
+
+	if term.FencingToken != 0 {
+		err = checkFencingToken(tx, term.FencingToken)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = respectsMinTimeBetweenKills(tx, term.Time, term, appCfg, endHour, loc)
+	if err != nil {
+		return err
+	}
+
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+
+	return recordTermination(tx, term)
+}
+
+// respectsMinTimeBetweenKills checks if this termination will respect or
+// violate the min time between kills value. If this termination is too close
+// to the most recent one, this will return an error.
+// If this termination would violate the min time, returns an ErrViolatesMinTime
+func respectsMinTimeBetweenKills(tx *sql.Tx, now time.Time, term chaosmonkey.Termination, appCfg chaosmonkey.AppConfig, endHour int, loc *time.Location) (err error) {
+	app := term.Instance.AppName()
+	account := term.Instance.AccountName()
+	threshold, err := cal.NoKillsSince(appCfg.MinTimeBetweenKillsInWorkDays, now, endHour, loc)
+	if err != nil {
+		return err
+	}
+	query := "SELECT instance_id, killed_at FROM terminations WHERE app = ? AND account = ? AND killed_at >= ?"
+
+	args := []interface{}{app, account, sqlTime(threshold)}
+
+	switch appCfg.Grouping {
+	case chaosmonkey.App:
+		// nothing to do
+	case chaosmonkey.Stack:
+		query += " AND stack = ?"
+		args = append(args, term.Instance.StackName())
+	case chaosmonkey.Cluster:
+		query += " AND cluster = ?"
+		args = append(args, term.Instance.ClusterName())
+	default:
+		return errors.Errorf("unknown group: %v", appCfg.Grouping)
+	}
+
+	if appCfg.RegionsAreIndependent {
+		query += " AND region = ?"
+		args = append(args, term.Instance.RegionName())
+	}
+
+	// For unleashed (real) terminations, we only care about previous
+	// terminations that were also unleashed, since a previous leashed
+	// termination wasn't a real one
+	if !term.Leashed {
+		query += " AND leashed = FALSE"
+	}
+
+	// We need at most one entry
+	query += " LIMIT 1"
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		cerr := rows.Close()
+		if err == nil && cerr != nil {
+			err = cerr
+		}
+	}()
+
+	if rows.Next() {
+		var instanceID string
+		var killedAt time.Time
+		err = rows.Scan(&instanceID, &killedAt)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan row")
+		}
+		return chaosmonkey.ErrViolatesMinTime{InstanceID: instanceID, KilledAt: killedAt, Loc: loc}
+	}
+
+	return rows.Err()
+}
+
+func recordTermination(tx *sql.Tx, term chaosmonkey.Termination) error {
+	i := term.Instance
+
+	_, err := tx.Exec("INSERT INTO terminations (app, account, stack, cluster, region, asg, instance_id, killed_at, leashed, fencing_token) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		i.AppName(), i.AccountName(), i.StackName(), i.ClusterName(), i.RegionName(), i.ASGName(), i.ID(), sqlTime(term.Time), term.Leashed, term.FencingToken)
+
+	return err
+}
+
+var migrationSource = &migrate.AssetMigrationSource{
+	Asset:    migration.Asset,
+	AssetDir: migration.AssetDir,
+	Dir:      "migration/sqlite",
+}
+
+var databaseDialect = "sqlite3"
+
+// Migrate upgrades a database to the latest database schema version.
+func Migrate(sqliteDb SQLite) error {
+	migrationCount, err := migrate.Exec(sqliteDb.db, databaseDialect, migrationSource, migrate.Up)
+	if err != nil {
+		return errors.Wrap(err, "database migration failed")
+	}
+	log.Println("Successfully applied database migrations. Number of migrations applied: ", migrationCount)
+
+	return nil
+}
@@ -0,0 +1,727 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Unlike the mysql tests, these tests do not need any external services:
+// each test runs against a fresh database file in a temporary directory.
+
+package sqlite_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	c "github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/history"
+	"github.com/Netflix/chaosmonkey/v2/lease"
+	"github.com/Netflix/chaosmonkey/v2/mock"
+	"github.com/Netflix/chaosmonkey/v2/schedstore"
+	"github.com/Netflix/chaosmonkey/v2/schedule"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
+	"github.com/Netflix/chaosmonkey/v2/sqlite"
+)
+
+var endHour = 15 // 3PM
+
+// initDB creates a new database with the chaosmonkey schemas in a temporary
+// directory. The caller must invoke the returned cleanup function.
+func initDB(t *testing.T) (sqlite.SQLite, func()) {
+	dir, err := ioutil.TempDir("", "chaosmonkey-sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sqlite.New(filepath.Join(dir, "chaosmonkey.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = sqlite.Migrate(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db, func() {
+		_ = db.Close()
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func location(t *testing.T) *time.Location {
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+// Test we can publish and then retrieve a schedule
+func TestPublishRetrieve(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+
+	loc := location(t)
+	date := time.Date(2016, time.June, 20, 0, 0, 0, 0, loc)
+
+	pEntries := []schedule.Entry{
+		{Time: time.Date(2016, time.June, 20, 11, 40, 0, 0, loc), Group: grp.New("chaosguineapig", "test", "us-east-1", "", "chaosguineapig-test")},
+		{Time: time.Date(2016, time.June, 20, 12, 35, 0, 0, loc), Group: grp.New("foobar", "other", "us-west-2", "baz", "")},
+		{Time: time.Date(2016, time.June, 20, 23, 7, 0, 0, loc), Group: grp.New("quux", "prod", "", "", "")},
+	}
+
+	psched := schedule.New()
+	for _, e := range pEntries {
+		psched.Add(e.Time, e.Group)
+	}
+
+	err := db.Publish(date, psched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsched, err := db.Retrieve(date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rEntries := rsched.Entries()
+	if got, want := len(rEntries), len(pEntries); got != want {
+		t.Fatalf("got len(rEntries)=%d, want %d", got, want)
+	}
+
+	for i := range pEntries {
+		if got, want := rEntries[i], pEntries[i]; !got.Equal(&want) {
+			t.Errorf("got entry[%d]=%v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestNoScheduleRetrievedOnWrongDay(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+
+	loc := location(t)
+
+	psched := schedule.New()
+	psched.Add(time.Date(2016, time.June, 20, 11, 40, 0, 0, loc), grp.New("chaosguineapig", "test", "us-east-1", "", ""))
+
+	err := db.Publish(time.Date(2016, time.June, 20, 0, 0, 0, 0, loc), psched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsched, err := db.Retrieve(time.Date(2016, time.June, 21, 0, 0, 0, 0, loc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(rsched.Entries()), 0; got != want {
+		t.Errorf("got len(rsched.Entries())=%d, want %d", got, want)
+	}
+}
+
+// The seed and anchor are stored with the schedule, and a schedule without
+// terminations counts as published once its seed is recorded
+func TestPublishRetrieveSeed(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+
+	date := time.Date(2016, time.June, 20, 0, 0, 0, 0, location(t))
+	anchor := time.Date(2016, time.June, 20, 9, 0, 30, 0, location(t))
+
+	psched := schedule.New()
+	psched.SetSeed(42)
+	psched.SetAnchor(anchor)
+	err := db.Publish(date, psched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsched, err := db.Retrieve(date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rsched.Seed(), int64(42); got != want {
+		t.Errorf("got seed=%d, want %d", got, want)
+	}
+	if got, want := rsched.Anchor(), anchor; !got.Equal(want) {
+		t.Errorf("got anchor=%s, want %s", got, want)
+	}
+
+	if err := db.Publish(date, psched); err != schedstore.ErrAlreadyExists {
+		t.Errorf("got err=%v, want %v", err, schedstore.ErrAlreadyExists)
+	}
+
+	rsched, err = db.Retrieve(date.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rsched.Seed(), int64(0); got != want {
+		t.Errorf("got seed=%d on another day, want %d", got, want)
+	}
+}
+
+func TestScheduleAlreadyExistsConcurrency(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+
+	loc := location(t)
+	date := time.Date(2016, time.June, 20, 0, 0, 0, 0, loc)
+
+	psched1 := schedule.New()
+	psched1.Add(time.Date(2016, time.June, 20, 11, 40, 0, 0, loc), grp.New("imaginaryproject", "test", "us-west-2", "", ""))
+
+	psched2 := schedule.New()
+	psched2.Add(time.Date(2016, time.June, 20, 12, 35, 0, 0, loc), grp.New("foobar", "other", "us-west-2", "", "foobar-baz-quux"))
+
+	// Try to publish two schedules at once. The first transaction holds the
+	// write lock, so the second one must see the first schedule
+	ch := make(chan error, 2)
+
+	go func() {
+		ch <- db.PublishWithDelay(date, psched1, 1*time.Second)
+	}()
+
+	go func() {
+		ch <- db.PublishWithDelay(date, psched2, 0)
+	}()
+
+	var success, alreadyExists int
+	for i := 0; i < 2; i++ {
+		err := <-ch
+		switch err {
+		case nil:
+			success++
+		case schedstore.ErrAlreadyExists:
+			alreadyExists++
+		default:
+			t.Fatalf("Unexpected error: %+v", err)
+		}
+	}
+
+	if got, want := success, 1; got != want {
+		t.Errorf("got %d successes, want: %d", got, want)
+	}
+
+	if got, want := alreadyExists, 1; got != want {
+		t.Errorf("got %d ErrAlreadyExists, want: %d", got, want)
+	}
+}
+
+// testSetup returns some values useful for checker tests
+func testSetup() (ins c.Instance, appCfg c.AppConfig) {
+	ins = mock.Instance{
+		App:        "myapp",
+		Account:    "prod",
+		Stack:      "mystack",
+		Cluster:    "mycluster",
+		Region:     "us-east-1",
+		ASG:        "myapp-mystack-mycluster-V123",
+		InstanceID: "i-a96a0166",
+	}
+
+	appCfg = c.AppConfig{
+		Enabled:                        true,
+		RegionsAreIndependent:          true,
+		MeanTimeBetweenKillsInWorkDays: 5,
+		MinTimeBetweenKillsInWorkDays:  1,
+		Grouping:                       c.Cluster,
+	}
+
+	return
+}
+
+func TestCheckForbidden(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+
+	ins, appCfg := testSetup()
+	trm := c.Termination{Instance: ins, Time: time.Now(), Leashed: false}
+
+	err := db.Check(trm, appCfg, endHour, location(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.Check(trm, appCfg, endHour, location(t))
+	if _, ok := err.(c.ErrViolatesMinTime); !ok {
+		t.Fatalf("Expected Err.ViolatesMinTime, got %v", err)
+	}
+}
+
+// When we are going to commit an unleashed termination, we only care
+// about unleashed previous terminations
+func TestCheckLeashed(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+
+	ins, appCfg := testSetup()
+
+	err := db.Check(c.Termination{Instance: ins, Time: time.Now(), Leashed: true}, appCfg, endHour, location(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.Check(c.Termination{Instance: ins, Time: time.Now(), Leashed: false}, appCfg, endHour, location(t))
+	if err != nil {
+		t.Fatalf("Should have allowed an unleashed termination after leashed: %v", err)
+	}
+}
+
+// Check that only one termination is permitted on concurrent attempts
+func TestConcurrentChecks(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+
+	ins, appCfg := testSetup()
+	loc := location(t)
+	trm := c.Termination{Instance: ins, Time: time.Now()}
+
+	ch := make(chan error, 2)
+
+	go func() {
+		ch <- db.CheckWithDelay(trm, appCfg, endHour, loc, 1*time.Second)
+	}()
+
+	go func() {
+		ch <- db.Check(trm, appCfg, endHour, loc)
+	}()
+
+	var success, violatesMinTime int
+	for i := 0; i < 2; i++ {
+		err := <-ch
+		switch errors.Cause(err).(type) {
+		case nil:
+			success++
+		case c.ErrViolatesMinTime:
+			violatesMinTime++
+		default:
+			t.Fatalf("Unexpected error: %+v", err)
+		}
+	}
+
+	if got, want := success, 1; got != want {
+		t.Errorf("got %d successes, want: %d", got, want)
+	}
+
+	if got, want := violatesMinTime, 1; got != want {
+		t.Errorf("got %d ErrViolatesMinTime, want: %d", got, want)
+	}
+}
+
+func TestCheckMinTimeEnforced(t *testing.T) {
+	ins, cfg := testSetup()
+	cfg.MinTimeBetweenKillsInWorkDays = 2
+	loc := location(t)
+
+	// this is a magic date used by go for parsing strings
+	refDate := "Mon Jan  2 15:04:05 2006 -0700"
+	now, err := time.Parse(refDate, "Thu Dec 17 11:35:00 2015 -0800")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Since MinTimeBetweenKillsInWorkDays is 2 here, the most recent kill
+	// permitted is Tue Dec 15 15:00:00 2015 -0800
+	tests := []struct {
+		last    string
+		allowed bool
+	}{
+		{"Tue Dec 15 15:01:00 2015 -0800", false},
+		{"Tue Dec 15 14:59:59 2015 -0800", true},
+	}
+
+	for _, tt := range tests {
+		func() {
+			db, cleanup := initDB(t)
+			defer cleanup()
+
+			last, err := time.Parse(refDate, tt.last)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.Check(c.Termination{Instance: ins, Time: last}, cfg, endHour, loc)
+			if err != nil {
+				t.Fatalf("Failed to write the initial termination, should always succeed: %v", err)
+			}
+
+			err = db.Check(c.Termination{Instance: ins, Time: now}, cfg, endHour, loc)
+			switch err.(type) {
+			case nil:
+				if !tt.allowed {
+					t.Errorf("%s termination should have been forbidden, was allowed", tt.last)
+				}
+			case c.ErrViolatesMinTime:
+				if tt.allowed {
+					t.Errorf("%s termination should have been allowed, got: %v", tt.last, err)
+				}
+			default:
+				t.Errorf("%s termination returned unexpected err: %v", tt.last, err)
+			}
+		}()
+	}
+}
+
+// Test the leader lease is acquired, renewed, taken over once expired and
+// released
+func TestLease(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	ttl := 30 * time.Second
+
+	// Release steps release the lease as holder, then a tries to acquire it
+	steps := []struct {
+		desc    string
+		holder  string
+		release bool
+		offset  time.Duration
+		want    lease.Lease
+	}{
+		{"new lease", "a", false, 0, lease.Lease{Holder: "a", Token: 1, Expires: now.Add(ttl)}},
+		{"held by a", "b", false, time.Second, lease.Lease{Holder: "a", Token: 1, Expires: now.Add(ttl)}},
+		{"renewed by a", "a", false, 10 * time.Second, lease.Lease{Holder: "a", Token: 1, Expires: now.Add(10*time.Second + ttl)}},
+		{"expired", "b", false, 41 * time.Second, lease.Lease{Holder: "b", Token: 2, Expires: now.Add(41*time.Second + ttl)}},
+		{"not released by a", "a", true, 42 * time.Second, lease.Lease{Holder: "b", Token: 2, Expires: now.Add(41*time.Second + ttl)}},
+		{"released by b", "b", true, 42 * time.Second, lease.Lease{Holder: "a", Token: 3, Expires: now.Add(42*time.Second + ttl)}},
+	}
+
+	for _, s := range steps {
+		tm := now.Add(s.offset)
+		holder := s.holder
+		if s.release {
+			err := db.Release(lease.Name, s.holder, tm)
+			if err != nil {
+				t.Fatalf("%s: %v", s.desc, err)
+			}
+			holder = "a"
+		}
+
+		got, err := db.Acquire(lease.Name, holder, tm, ttl)
+		if err != nil {
+			t.Fatalf("%s: %v", s.desc, err)
+		}
+
+		if got.Holder != s.want.Holder || got.Token != s.want.Token || !got.Expires.Equal(s.want.Expires) {
+			t.Errorf("%s: got %+v, want %+v", s.desc, got, s.want)
+		}
+	}
+}
+
+// A termination made under a lease that has changed hands is refused
+func TestCheckStaleFencingToken(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+
+	ins, appCfg := testSetup()
+	now := time.Now()
+	ttl := 30 * time.Second
+
+	old, err := db.Acquire(lease.Name, "a", now.Add(-time.Minute), ttl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cur, err := db.Acquire(lease.Name, "b", now, ttl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.Check(c.Termination{Instance: ins, Time: now, FencingToken: old.Token}, appCfg, endHour, location(t))
+	if !lease.StaleToken(err) {
+		t.Fatalf("got %v, want ErrStaleToken", err)
+	}
+
+	err = db.Check(c.Termination{Instance: ins, Time: now, FencingToken: cur.Token}, appCfg, endHour, location(t))
+	if err != nil {
+		t.Fatalf("termination with current token should be allowed: %v", err)
+	}
+}
+
+// Test recorded terminations are returned most recent first, filtered and
+// limited
+func TestTerminations(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+
+	_, appCfg := testSetup()
+	now := time.Date(2026, time.October, 19, 17, 0, 0, 0, time.UTC)
+
+	// Terminations of different clusters don't conflict. Only bar's is
+	// unleashed.
+	for i, app := range []string{"foo", "bar", "foo"} {
+		ins := mock.Instance{App: app, Account: "prod", Cluster: fmt.Sprintf("%s-prod-%d", app, i), Region: "us-east-1", InstanceID: fmt.Sprintf("i-%d", i)}
+		err := db.Check(c.Termination{Instance: ins, Time: now.Add(time.Duration(i) * time.Hour), Leashed: app != "bar"}, appCfg, endHour, location(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	leashed, unleashed := true, false
+	tests := []struct {
+		q    history.Query
+		want []string
+	}{
+		{history.Query{}, []string{"i-2", "i-1", "i-0"}},
+		{history.Query{App: "foo"}, []string{"i-2", "i-0"}},
+		{history.Query{App: "foo", Limit: 1}, []string{"i-2"}},
+		{history.Query{Account: "test"}, nil},
+		{history.Query{Cluster: "foo-prod-0"}, []string{"i-0"}},
+		{history.Query{Region: "us-west-2"}, nil},
+		{history.Query{Since: now.Add(time.Hour)}, []string{"i-2", "i-1"}},
+		{history.Query{Until: now.Add(time.Hour)}, []string{"i-0"}},
+		{history.Query{Since: now.Add(time.Hour), Until: now.Add(2 * time.Hour)}, []string{"i-1"}},
+		{history.Query{Leashed: &leashed}, []string{"i-2", "i-0"}},
+		{history.Query{Leashed: &unleashed}, []string{"i-1"}},
+	}
+
+	for _, tt := range tests {
+		records, err := db.Terminations(tt.q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, r := range records {
+			got = append(got, r.InstanceID)
+		}
+
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%+v: got %v, want %v", tt.q, got, tt.want)
+		}
+	}
+
+	records, err := db.Terminations(history.Query{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := history.Record{App: "foo", Account: "prod", Cluster: "foo-prod-2", Region: "us-east-1", InstanceID: "i-2", KilledAt: now.Add(2 * time.Hour), Leashed: true}
+	if got := records[0]; got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestAmend(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+
+	loc := location(t)
+	date := time.Date(2016, time.June, 20, 0, 0, 0, 0, loc)
+	at := func(hh, mm int) time.Time { return time.Date(2016, time.June, 20, hh, mm, 0, 0, loc) }
+	foo := grp.New("foo", "prod", "us-east-1", "", "foo-prod")
+	bar := grp.New("bar", "prod", "us-east-1", "", "bar-prod")
+	baz := grp.New("baz", "prod", "", "", "")
+	amendedAt := time.Date(2016, time.June, 20, 16, 0, 0, 0, time.UTC)
+
+	// Nothing to amend before the schedule is published
+	err := db.Amend(date, schedstore.Amendment{Op: schedstore.Add, Group: baz, NewTime: at(14, 0), By: "alice", At: amendedAt})
+	if err != schedstore.ErrNoSchedule {
+		t.Fatalf("got err=%v, want %v", err, schedstore.ErrNoSchedule)
+	}
+
+	psched := schedule.New()
+	psched.Add(at(10, 0), foo)
+	psched.Add(at(11, 0), bar)
+	psched.Add(at(12, 30), bar)
+	err = db.Publish(date, psched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		a    schedstore.Amendment
+		want error
+	}{
+		{schedstore.Amendment{Op: schedstore.Cancel, Group: foo, Reason: "incident"}, nil},
+		{schedstore.Amendment{Op: schedstore.Cancel, Group: foo}, schedstore.ErrNoSuchEntry},
+		{schedstore.Amendment{Op: schedstore.Reschedule, Group: bar, NewTime: at(13, 0)}, schedstore.ErrAmbiguousEntry},
+		{schedstore.Amendment{Op: schedstore.Reschedule, Group: bar, Time: at(12, 30), NewTime: at(13, 0)}, nil},
+		{schedstore.Amendment{Op: schedstore.Add, Group: baz, NewTime: at(14, 0)}, nil},
+	}
+
+	for _, tt := range tests {
+		tt.a.By = "alice"
+		tt.a.At = amendedAt
+		if err := db.Amend(date, tt.a); err != tt.want {
+			t.Errorf("%s %s: got err=%v, want %v", tt.a.Op, grp.String(tt.a.Group), err, tt.want)
+		}
+	}
+
+	rsched, err := db.Retrieve(date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, e := range rsched.Entries() {
+		got = append(got, e.Group.App()+"@"+e.Time.In(loc).Format("15:04"))
+	}
+	want := []string{"bar@11:00", "bar@13:00", "baz@14:00"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	amendments, err := db.Amendments(date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(amendments), 3; got != want {
+		t.Fatalf("got %d amendments, want %d", got, want)
+	}
+
+	// The cancelled entry is recorded, even though it wasn't specified
+	cancel := amendments[0]
+	if cancel.Op != schedstore.Cancel || !grp.Equal(cancel.Group, foo) || !cancel.Time.Equal(at(10, 0)) || !cancel.NewTime.IsZero() {
+		t.Errorf("got %+v, want cancellation of foo at 10:00", cancel)
+	}
+	if cancel.By != "alice" || cancel.Reason != "incident" || !cancel.At.Equal(amendedAt) {
+		t.Errorf("got %+v, want by alice for incident at %s", cancel, amendedAt)
+	}
+
+	if move := amendments[1]; !move.Time.Equal(at(12, 30)) || !move.NewTime.Equal(at(13, 0)) {
+		t.Errorf("got %+v, want move from 12:30 to 13:00", move)
+	}
+
+	if add := amendments[2]; add.Op != schedstore.Add || !add.Time.IsZero() || !add.NewTime.Equal(at(14, 0)) {
+		t.Errorf("got %+v, want addition at 14:00", add)
+	}
+}
+
+// A day whose only records are amendments, e.g., a schedule published without
+// a seed whose entries were all cancelled, may be published again
+func TestPublishAfterAmendments(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+	loc := location(t)
+	date := time.Date(2016, time.June, 20, 0, 0, 0, 0, loc)
+	at := func(hh, mm int) time.Time { return time.Date(2016, time.June, 20, hh, mm, 0, 0, loc) }
+	foo := grp.New("foo", "prod", "us-east-1", "", "foo-prod")
+	bar := grp.New("bar", "prod", "us-east-1", "", "bar-prod")
+
+	psched := schedule.New()
+	psched.Add(at(10, 0), foo)
+	err := db.Publish(date, psched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.Amend(date, schedstore.Amendment{Op: schedstore.Cancel, Group: foo, By: "alice", At: at(9, 0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	psched = schedule.New()
+	psched.Add(at(11, 0), bar)
+	err = db.Publish(date, psched)
+	if err != nil {
+		t.Fatalf("got err=%v publishing after amendments, want none", err)
+	}
+
+	rsched, err := db.Retrieve(date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(rsched.Entries()), 1; got != want {
+		t.Fatalf("got %d entries, want %d", got, want)
+	}
+	if e := rsched.Entries()[0]; !grp.Equal(e.Group, bar) || !e.Time.Equal(at(11, 0)) {
+		t.Errorf("got %s at %s, want bar at 11:00", grp.String(e.Group), e.Time)
+	}
+}
+
+func TestSnoozes(t *testing.T) {
+	db, cleanup := initDB(t)
+	defer cleanup()
+
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	snoozes := []snooze.Snooze{
+		{App: "foo", Until: now.Add(48 * time.Hour), By: "alice", Reason: "migration", At: now},
+		{App: "bar", Account: "prod", Cluster: "bar-prod", Until: now.Add(time.Hour), By: "bob", Reason: "load test", At: now},
+		{App: "baz", Until: now.Add(time.Hour), By: "bob", Reason: "ended early", At: now},
+	}
+	for _, s := range snoozes {
+		err := db.Snooze(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Snoozing again replaces the snooze
+	err := db.Snooze(snooze.Snooze{App: "bar", Account: "prod", Cluster: "bar-prod", Until: now.Add(2 * time.Hour), By: "bob", Reason: "longer load test", At: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ok, err := db.Unsnooze("baz", "", "", now.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Error("baz: got no snooze ended")
+	}
+
+	ok, err = db.Unsnooze("baz", "", "", now.Add(2*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Error("baz: got a snooze ended twice")
+	}
+
+	tests := []struct {
+		offset time.Duration
+		want   []string // app and reason of the active snoozes
+	}{
+		{30 * time.Second, []string{"bar/longer load test", "baz/ended early", "foo/migration"}},
+		{time.Hour, []string{"bar/longer load test", "foo/migration"}},
+		{3 * time.Hour, []string{"foo/migration"}},
+		{48 * time.Hour, nil},
+	}
+
+	for _, tt := range tests {
+		active, err := db.Snoozes(now.Add(tt.offset))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, s := range active {
+			got = append(got, s.App+"/"+s.Reason)
+		}
+
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("at +%s: got snoozes %v, want %v", tt.offset, got, tt.want)
+		}
+	}
+
+	active, err := db.Snoozes(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := active[0], (snooze.Snooze{App: "bar", Account: "prod", Cluster: "bar-prod", Until: now.Add(2 * time.Hour), By: "bob", Reason: "longer load test", At: now}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package statsd sends Chaos Monkey metrics to a StatsD server over UDP,
+// with DogStatsD tags and, optionally, events.
+//
+// See: https://docs.datadoghq.com/developers/dogstatsd/datagram_shell/
+package statsd
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2/config"
+)
+
+// Client sends metrics to a StatsD server. It implements metrics.Sink and
+// chaosmonkey.ErrorCounter.
+//
+// Since StatsD is fire-and-forget, metrics that can't be sent are dropped.
+type Client struct {
+	conn   net.Conn
+	prefix string
+	tags   []string
+	events bool
+}
+
+// NewFromConfig creates a new Client taking config parameters from cfg
+func NewFromConfig(cfg *config.Monkey) (*Client, error) {
+	addr := cfg.StatsdAddress()
+	if addr == "" {
+		return nil, errors.New("statsd.address not specified")
+	}
+
+	tags, err := cfg.StatsdTags()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve statsd tags")
+	}
+
+	return New(addr, cfg.StatsdPrefix(), tags, cfg.StatsdEvents())
+}
+
+// New returns a client that sends metrics to the StatsD server at addr. The
+// names of metrics are prefixed with prefix and a period, and tags are added
+// to every metric. Events are only sent if events is true, since they are a
+// DogStatsD extension.
+func New(addr string, prefix string, tags []string, events bool) (*Client, error) {
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to statsd at %s", addr)
+	}
+
+	if prefix != "" && !strings.HasSuffix(prefix, ".") {
+		prefix += "."
+	}
+
+	return &Client{conn: conn, prefix: prefix, tags: tags, events: events}, nil
+}
+
+// Close closes the connection to the StatsD server
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Increment implements chaosmonkey.ErrorCounter.Increment
+func (c *Client) Increment() error {
+	return c.send(c.metric("errors", "1", "c", nil))
+}
+
+// Count implements metrics.Sink.Count
+func (c *Client) Count(name string, value int64, tags ...string) {
+	_ = c.send(c.metric(name, strconv.FormatInt(value, 10), "c", tags))
+}
+
+// Gauge implements metrics.Sink.Gauge
+func (c *Client) Gauge(name string, value float64, tags ...string) {
+	_ = c.send(c.metric(name, strconv.FormatFloat(value, 'f', -1, 64), "g", tags))
+}
+
+// Timing implements metrics.Sink.Timing. Durations are sent in milliseconds.
+func (c *Client) Timing(name string, d time.Duration, tags ...string) {
+	ms := float64(d) / float64(time.Millisecond)
+	_ = c.send(c.metric(name, strconv.FormatFloat(ms, 'f', -1, 64), "ms", tags))
+}
+
+// Event implements metrics.Sink.Event
+func (c *Client) Event(title string, text string, alertType string, tags ...string) {
+	if !c.events {
+		return
+	}
+
+	title = eventEscaper.Replace(title)
+	text = eventEscaper.Replace(text)
+	datagram := fmt.Sprintf("_e{%d,%d}:%s|%s|t:%s", len(title), len(text), title, text, alertType)
+	_ = c.send(datagram + c.tagString(tags))
+}
+
+// metric formats a metric datagram, e.g. chaosmonkey.errors:1|c|#env:prod
+func (c *Client) metric(name string, value string, kind string, tags []string) string {
+	return c.prefix + nameEscaper.Replace(name) + ":" + value + "|" + kind + c.tagString(tags)
+}
+
+// tagString formats the tags of c and tags as |#a:x,b:y, or returns "" if
+// there are none
+func (c *Client) tagString(tags []string) string {
+	all := make([]string, 0, len(c.tags)+len(tags))
+	for _, t := range c.tags {
+		all = append(all, tagEscaper.Replace(t))
+	}
+	for _, t := range tags {
+		all = append(all, tagEscaper.Replace(t))
+	}
+
+	if len(all) == 0 {
+		return ""
+	}
+	return "|#" + strings.Join(all, ",")
+}
+
+func (c *Client) send(datagram string) error {
+	_, err := c.conn.Write([]byte(datagram))
+	if err != nil {
+		return errors.Wrap(err, "could not send to statsd")
+	}
+	return nil
+}
+
+var (
+	// Characters that delimit the fields of a datagram are replaced
+	nameEscaper  = strings.NewReplacer(":", "_", "|", "_", "@", "_", "#", "_", "\n", "_")
+	tagEscaper   = strings.NewReplacer(",", "_", "|", "_", "#", "_", "\n", "_")
+	eventEscaper = strings.NewReplacer("\n", `\n`, "|", "/")
+)
@@ -0,0 +1,101 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statsd
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+// listen returns a UDP connection that stands in for a StatsD server
+func listen(t *testing.T) net.PacketConn {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// receive returns the next datagram received by conn
+func receive(t *testing.T, conn net.PacketConn) string {
+	buf := make([]byte, 1024)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(buf[:n])
+}
+
+func TestDatagrams(t *testing.T) {
+	server := listen(t)
+	c, err := New(server.LocalAddr().String(), "chaosmonkey", []string{"env:prod"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	tests := []struct {
+		send func()
+		want string
+	}{
+		{
+			func() { _ = c.Increment() },
+			"chaosmonkey.errors:1|c|#env:prod",
+		},
+		{
+			func() { c.Count("terminations.skipped", 1, "app:foo", "reason:outage") },
+			"chaosmonkey.terminations.skipped:1|c|#env:prod,app:foo,reason:outage",
+		},
+		{
+			func() { c.Gauge("schedule.terminations", 12) },
+			"chaosmonkey.schedule.terminations:12|g|#env:prod",
+		},
+		{
+			func() { c.Timing("terminate.kill", 1500*time.Microsecond, "app:foo") },
+			"chaosmonkey.terminate.kill:1.5|ms|#env:prod,app:foo",
+		},
+		{
+			func() { c.Event("Chaos Monkey termination failed", "no\nluck", "error", "app:a,b") },
+			"_e{31,8}:Chaos Monkey termination failed|no\\nluck|t:error|#env:prod,app:a_b",
+		},
+	}
+
+	for _, tt := range tests {
+		tt.send()
+		if got := receive(t, server); got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestNoEvents(t *testing.T) {
+	server := listen(t)
+	c, err := New(server.LocalAddr().String(), "", nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	c.Event("title", "text", "info")
+	c.Count("terminations.attempted", 1)
+
+	// The event was not sent, so the count is the first datagram
+	if got, want := receive(t, server), "terminations.attempted:1|c"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -16,8 +16,10 @@
 package term
 
 import (
+	"fmt"
 	"log"
 	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
@@ -27,6 +29,10 @@ import (
 	"github.com/Netflix/chaosmonkey/v2/deps"
 	"github.com/Netflix/chaosmonkey/v2/eligible"
 	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/metrics"
+	"github.com/Netflix/chaosmonkey/v2/outage"
+	"github.com/Netflix/chaosmonkey/v2/seed"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
 )
 
 type leashedKiller struct {
@@ -50,6 +56,41 @@ func (err UnleashedInTestEnv) Error() string {
 //
 // region, stack, and cluster may be blank
 func Terminate(d deps.Deps, app string, account string, region string, stack string, cluster string) error {
+	tags := metricTags(app, account)
+	metrics.RecordAttempt(tags...)
+
+	start := time.Now()
+	err := terminate(d, app, account, region, stack, cluster)
+	metrics.ObserveStage(metrics.StageTerminate, start, tags...)
+
+	if err != nil {
+		metrics.RecordFailure(skipReason(err), err, tags...)
+	}
+
+	return err
+}
+
+// metricTags returns the metric tags of a termination in app and account
+func metricTags(app string, account string) []string {
+	return []string{"app:" + app, "account:" + account}
+}
+
+// skipReason returns the reason a termination failed with err, for metrics
+func skipReason(err error) string {
+	switch errors.Cause(err).(type) {
+	case UnleashedInTestEnv:
+		return metrics.ReasonTestEnv
+	case chaosmonkey.ErrViolatesMinTime:
+		return metrics.ReasonMinTimeViolation
+	default:
+		return metrics.ReasonError
+	}
+}
+
+// terminate is the implementation of Terminate
+func terminate(d deps.Deps, app string, account string, region string, stack string, cluster string) error {
+	tags := metricTags(app, account)
+
 	enabled, err := d.MonkeyCfg.Enabled()
 	if err != nil {
 		return errors.Wrap(err, "not terminating: could not determine if monkey is enabled")
@@ -57,10 +98,16 @@ func Terminate(d deps.Deps, app string, account string, region string, stack str
 
 	if !enabled {
 		log.Println("not terminating: enabled=false")
+		metrics.RecordSkip(metrics.ReasonDisabled, tags...)
 		return nil
 	}
 
-	problem, err := d.Ou.Outage()
+	// create an instance group from the command-line parameters
+	group := grp.New(app, account, region, stack, cluster)
+
+	start := time.Now()
+	problem, err := outage.Scoped(d.Ou).OutageIn(group)
+	metrics.ObserveStage(metrics.StageOutageCheck, start)
 
 	// If the check for ongoing outage fails, we err on the safe side nd don't terminate an instance
 	if err != nil {
@@ -68,7 +115,8 @@ func Terminate(d deps.Deps, app string, account string, region string, stack str
 	}
 
 	if problem {
-		log.Println("not terminating: outage in progress")
+		log.Printf("not terminating: outage in progress affecting %s", grp.String(group))
+		metrics.RecordSkip(metrics.ReasonOutage, tags...)
 		return nil
 	}
 
@@ -80,12 +128,10 @@ func Terminate(d deps.Deps, app string, account string, region string, stack str
 
 	if !accountEnabled {
 		log.Printf("Not terminating: account=%s is not enabled in Chaos Monkey", account)
+		metrics.RecordSkip(metrics.ReasonAccountNotEnabled, tags...)
 		return nil
 	}
 
-	// create an instance group from the command-line parameters
-	group := grp.New(app, account, region, stack, cluster)
-
 	// do the actual termination
 	return doTerminate(d, group)
 
@@ -99,6 +145,8 @@ func doTerminate(d deps.Deps, group grp.InstanceGroup) error {
 		return errors.Wrap(err, "not terminating: could not determine leashed status")
 	}
 
+	leashed = leashed || d.Leashed
+
 	/*
 		Do not allow running unleashed in the test environment.
 
@@ -119,8 +167,11 @@ func doTerminate(d deps.Deps, group grp.InstanceGroup) error {
 	}
 
 	// get Chaos Monkey config info for this app
+	tags := metricTags(group.App(), group.Account())
 	appName := group.App()
+	start := time.Now()
 	appCfg, err := d.ConfGetter.Get(appName)
+	metrics.ObserveStage(metrics.StageAppConfig, start, tags...)
 
 	if err != nil {
 		return errors.Wrapf(err, "not terminating: Could not retrieve config for app=%s", appName)
@@ -128,17 +179,46 @@ func doTerminate(d deps.Deps, group grp.InstanceGroup) error {
 
 	if !appCfg.Enabled {
 		log.Printf("not terminating: enabled=false for app=%s", appName)
+		metrics.RecordSkip(metrics.ReasonAppDisabled, tags...)
 		return nil
 	}
 
 	if appCfg.Whitelist != nil {
 		log.Printf("not terminating: app=%s has a whitelist which is no longer supported", appName)
+		metrics.RecordSkip(metrics.ReasonWhitelist, tags...)
+		return nil
+	}
+
+	// Owners may have snoozed the whole group, or only some of its
+	// instances, which are then not eligible
+	now := d.Cl.Now()
+	var snoozes []snooze.Snooze
+	if d.Snoozes != nil {
+		snoozes, err = d.Snoozes.Snoozes(now)
+		if err != nil {
+			return errors.Wrap(err, "not terminating: could not retrieve snoozes")
+		}
+	}
+
+	if s, ok := snooze.Group(snoozes, group, now); ok {
+		log.Printf("not terminating: %s snoozed until %s by %s: %s", grp.String(group), s.Until.Format(time.RFC3339), s.By, s.Reason)
+		metrics.RecordSkip(metrics.ReasonSnoozed, tags...)
 		return nil
 	}
 
-	instance, ok := PickRandomInstance(group, *appCfg, d.Dep)
+	// Picks derive from the seed of the day's schedule, so that they can be
+	// reproduced
+	pickSeed := d.Seed
+	if pickSeed == 0 {
+		pickSeed = seed.New()
+	}
+
+	start = time.Now()
+	instance, numEligible, ok := pickRandomInstance(group, *appCfg, d.Dep, seed.Rand(pickSeed, grp.String(group)), snoozes, now)
+	metrics.ObserveStage(metrics.StagePickInstance, start, tags...)
 	if !ok {
 		log.Printf("No eligible instances in group, nothing to terminate: %+v", group)
+		metrics.RecordSkip(metrics.ReasonNoEligibleInstances, tags...)
 		return nil
 	}
 
@@ -149,12 +229,30 @@ func doTerminate(d deps.Deps, group grp.InstanceGroup) error {
 		return errors.Wrap(err, "not terminating: could not retrieve location")
 	}
 
-	trm := chaosmonkey.Termination{Instance: instance, Time: d.Cl.Now(), Leashed: leashed}
+	// Work days end at the end of the app's last kill window, in its own
+	// time zone
+	loc, err = appCfg.Location(loc)
+	if err != nil {
+		return errors.Wrap(err, "not terminating: could not retrieve app location")
+	}
+	endHour := appCfg.EndHour(d.MonkeyCfg.EndHour())
+
+	decision := &chaosmonkey.Decision{
+		AppConfig: *appCfg,
+		Group:     grp.String(group),
+		Eligible:  numEligible,
+		Reason:    fmt.Sprintf("picked at random from %d eligible instance(s)", numEligible),
+		Seed:      pickSeed,
+	}
+
+	trm := chaosmonkey.Termination{Instance: instance, Time: d.Cl.Now(), Leashed: leashed, FencingToken: d.FencingToken, Decision: decision}
 
 	//
 	// Check that we don't violate min time between terminations
 	//
-	err = d.Checker.Check(trm, *appCfg, d.MonkeyCfg.EndHour(), loc)
+	start = time.Now()
+	err = d.Checker.Check(trm, *appCfg, endHour, loc)
+	metrics.ObserveStage(metrics.StageMinTimeCheck, start, tags...)
 	if err != nil {
 		return errors.Wrap(err, "not terminating: check for min time between terminations failed")
 	}
@@ -162,36 +260,57 @@ func doTerminate(d deps.Deps, group grp.InstanceGroup) error {
 	//
 	// Record the termination with configured trackers
 	//
+	start = time.Now()
 	for _, tracker := range d.Trackers {
 		err = tracker.Track(trm)
 		if err != nil {
 			return errors.Wrap(err, "not terminating: recording termination event failed")
 		}
 	}
+	metrics.ObserveStage(metrics.StageTrack, start, tags...)
 
 	//
 	// Actual instance termination happens here
 	//
+	start = time.Now()
 	err = killer.Execute(trm)
+	metrics.ObserveStage(metrics.StageKill, start, tags...)
 	if err != nil {
 		return errors.Wrap(err, "termination failed")
 	}
 
+	metrics.RecordTermination(leashed, instance.ID(), grp.String(group), tags...)
 	return nil
 }
 
 // PickRandomInstance randomly selects an eligible instance from a group
 func PickRandomInstance(group grp.InstanceGroup, cfg chaosmonkey.AppConfig, dep deploy.Deployment) (chaosmonkey.Instance, bool) {
-	instances, err := eligible.Instances(group, cfg.Exceptions, dep)
+	instance, _, ok := pickRandomInstance(group, cfg, dep, seed.Rand(seed.New()), nil, time.Time{})
+	return instance, ok
+}
+
+// pickRandomInstance is PickRandomInstance, but picks with r and also returns
+// the number of eligible instances. Instances are picked in order of ID, so
+// that the same r picks the same instance. Instances covered by one of
+// snoozes at now are not eligible.
+func pickRandomInstance(group grp.InstanceGroup, cfg chaosmonkey.AppConfig, dep deploy.Deployment, r *rand.Rand, snoozes []snooze.Snooze, now time.Time) (chaosmonkey.Instance, int, bool) {
+	all, err := eligible.Instances(group, cfg.Exceptions, dep)
 	if err != nil {
 		log.Printf("WARNING: eligible.Instances failed for %s: %v", group, err)
-		return nil, false
+		return nil, 0, false
+	}
+
+	instances := all[:0]
+	for _, instance := range all {
+		if _, ok := snooze.Instance(snoozes, instance, now); !ok {
+			instances = append(instances, instance)
+		}
 	}
 	if len(instances) == 0 {
-		return nil, false
+		return nil, 0, false
 	}
 
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	sort.Slice(instances, func(i, j int) bool { return instances[i].ID() < instances[j].ID() })
 	index := r.Intn(len(instances))
-	return instances[index], true
+	return instances[index], len(instances), true
 }
@@ -24,7 +24,10 @@ import (
 	"github.com/Netflix/chaosmonkey/v2/config"
 	"github.com/Netflix/chaosmonkey/v2/config/param"
 	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/grp"
+	"github.com/Netflix/chaosmonkey/v2/metrics"
 	"github.com/Netflix/chaosmonkey/v2/mock"
+	"github.com/Netflix/chaosmonkey/v2/snooze"
 )
 
 func mockDeps() deps.Deps {
@@ -134,6 +137,23 @@ func TestTerminateDoesntKillInLeashedMode(t *testing.T) {
 
 }
 
+// TestTerminateDoesntKillWhenForcedLeashed ensures Deps.Leashed overrides an
+// unleashed config
+func TestTerminateDoesntKillWhenForcedLeashed(t *testing.T) {
+	deps := mockDeps()
+	deps.Leashed = true
+
+	err := Terminate(deps, "foo", "prod", "us-east-1", "", "foo-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ttor := deps.T.(*mock.Terminator)
+	if got, want := ttor.Ncalls, 0; got != want {
+		t.Errorf("Expected terminator to not be called, got ttor.Ncalls=%d", ttor.Ncalls)
+	}
+}
+
 // TestNeverTerminateInTestEnv checks that unleasshed terms are not allowed in
 // test
 func TestNeverTerminateUnleashedInTestEnv(t *testing.T) {
@@ -197,3 +217,221 @@ func TestDoesNotTerminateIfAppIsDisabled(t *testing.T) {
 		t.Errorf("Expected terminator to not be called, got ttor.Ncalls=%d", ttor.Ncalls)
 	}
 }
+
+// recordingTracker records the terminations it tracks
+type recordingTracker struct {
+	trms []chaosmonkey.Termination
+}
+
+func (r *recordingTracker) Track(trm chaosmonkey.Termination) error {
+	r.trms = append(r.trms, trm)
+	return nil
+}
+
+// Trackers are told how the instance was picked
+func TestTerminateRecordsDecision(t *testing.T) {
+	deps := mockDeps()
+	tracker := &recordingTracker{}
+	deps.Trackers = []chaosmonkey.Tracker{tracker}
+
+	err := Terminate(deps, "foo", "prod", "us-east-1", "", "foo-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(tracker.trms), 1; got != want {
+		t.Fatalf("got %d terminations tracked, want %d", got, want)
+	}
+
+	dec := tracker.trms[0].Decision
+	if dec == nil {
+		t.Fatal("got no decision")
+	}
+
+	if got, want := dec.Group, "app=foo account=prod region=us-east-1 cluster=foo-prod"; got != want {
+		t.Errorf("got group %q, want %q", got, want)
+	}
+
+	if got, want := dec.AppConfig.MeanTimeBetweenKillsInWorkDays, mock.DefaultConfigGetter().Config.MeanTimeBetweenKillsInWorkDays; got != want {
+		t.Errorf("got mean time between kills %d, want %d", got, want)
+	}
+
+	if dec.Eligible < 1 || dec.Reason == "" {
+		t.Errorf("got %+v, want eligible instances and a reason", dec)
+	}
+
+	if dec.Seed == 0 {
+		t.Error("got no seed")
+	}
+}
+
+// Picks derive from the schedule seed, so the same seed picks the same
+// instance
+func TestTerminatePicksFromSeed(t *testing.T) {
+	deps := mockDeps()
+	deps.Seed = 42
+	tracker := &recordingTracker{}
+	deps.Trackers = []chaosmonkey.Tracker{tracker}
+
+	for i := 0; i < 8; i++ {
+		err := Terminate(deps, "foo", "prod", "us-east-1", "", "foo-prod")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := tracker.trms[0].Instance.ID()
+	for _, trm := range tracker.trms {
+		if got := trm.Instance.ID(); got != want {
+			t.Errorf("got instance %s, want %s", got, want)
+		}
+
+		if got, want := trm.Decision.Seed, int64(42); got != want {
+			t.Errorf("got seed %d, want %d", got, want)
+		}
+	}
+}
+
+// Terminations are counted by outcome
+func TestTerminateMetrics(t *testing.T) {
+	attempted := metrics.TerminationsAttempted.Value()
+	executed := metrics.TerminationsExecuted.Value("false")
+	skipped := metrics.TerminationsSkipped.Value(metrics.ReasonTestEnv)
+
+	err := Terminate(mockDeps(), "foo", "prod", "us-east-1", "", "foo-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deps := mockDeps()
+	deps.Env = mock.Env{IsInTest: true}
+	_ = Terminate(deps, "foo", "prod", "us-east-1", "", "foo-prod")
+
+	if got, want := metrics.TerminationsAttempted.Value()-attempted, 2.0; got != want {
+		t.Errorf("got %v attempts, want %v", got, want)
+	}
+
+	if got, want := metrics.TerminationsExecuted.Value("false")-executed, 1.0; got != want {
+		t.Errorf("got %v unleashed terminations, want %v", got, want)
+	}
+
+	if got, want := metrics.TerminationsSkipped.Value(metrics.ReasonTestEnv)-skipped, 1.0; got != want {
+		t.Errorf("got %v skipped in test env, want %v", got, want)
+	}
+}
+
+// regionOutage is an outage in a single region
+type regionOutage struct {
+	region string
+}
+
+func (o regionOutage) Outage() (bool, error) {
+	return true, nil
+}
+
+func (o regionOutage) OutageIn(group grp.InstanceGroup) (bool, error) {
+	region, ok := group.Region()
+	return !ok || region == o.region, nil
+}
+
+// A scoped outage only stops terminations in the groups it affects
+func TestTerminateDuringScopedOutage(t *testing.T) {
+	for _, tt := range []struct {
+		region string
+		want   int
+	}{
+		{"us-east-1", 1},
+		{"us-west-2", 0},
+	} {
+		deps := mockDeps()
+		deps.Ou = regionOutage{region: "us-west-2"}
+
+		err := Terminate(deps, "foo", "prod", tt.region, "", "foo-prod")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ttor := deps.T.(*mock.Terminator)
+		if got := ttor.Ncalls; got != tt.want {
+			t.Errorf("%s: got %d terminations, want %d", tt.region, got, tt.want)
+		}
+	}
+}
+
+// checkRecorder records the arguments of the min time between kills check
+type checkRecorder struct {
+	endHour int
+	loc     *time.Location
+}
+
+func (c *checkRecorder) Check(term chaosmonkey.Termination, appCfg chaosmonkey.AppConfig, endHour int, loc *time.Location) error {
+	c.endHour = endHour
+	c.loc = loc
+	return nil
+}
+
+// The min time between kills is counted in the app's own work days
+func TestTerminateChecksAppEndHour(t *testing.T) {
+	deps := mockDeps()
+	cfg := mock.DefaultConfigGetter().Config
+	cfg.TimeZone = "Europe/Dublin"
+	cfg.KillWindows = []chaosmonkey.KillWindow{{StartHour: 10, EndHour: 12}, {StartHour: 13, EndHour: 17}}
+	deps.ConfGetter = mock.NewConfigGetter(cfg)
+
+	checker := &checkRecorder{}
+	deps.Checker = checker
+
+	err := Terminate(deps, "foo", "prod", "us-east-1", "", "foo-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := checker.endHour, 17; got != want {
+		t.Errorf("got end hour %d, want %d", got, want)
+	}
+
+	if got, want := checker.loc.String(), "Europe/Dublin"; got != want {
+		t.Errorf("got location %s, want %s", got, want)
+	}
+}
+
+// snoozeStore is a snooze.Store of fixed snoozes
+type snoozeStore []snooze.Snooze
+
+func (s snoozeStore) Snooze(snooze.Snooze) error { return nil }
+
+func (s snoozeStore) Unsnooze(string, string, string, time.Time) (bool, error) { return false, nil }
+
+func (s snoozeStore) Snoozes(time.Time) ([]snooze.Snooze, error) { return s, nil }
+
+// Snoozed groups and instances are not terminated until the snooze expires
+func TestTerminateHonoursSnoozes(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		desc    string
+		snoozes snoozeStore
+		cluster string
+		want    int
+	}{
+		{"snoozed app", snoozeStore{{App: "foo", Until: now.Add(time.Hour)}}, "foo-prod", 0},
+		{"snoozed cluster", snoozeStore{{App: "foo", Account: "prod", Cluster: "foo-prod", Until: now.Add(time.Hour)}}, "foo-prod", 0},
+		{"only eligible cluster snoozed", snoozeStore{{App: "foo", Cluster: "foo-prod", Until: now.Add(time.Hour)}}, "", 0},
+		{"other cluster snoozed", snoozeStore{{App: "foo", Cluster: "foo-staging", Until: now.Add(time.Hour)}}, "foo-prod", 1},
+		{"expired", snoozeStore{{App: "foo", Until: now.Add(-time.Hour)}}, "foo-prod", 1},
+	}
+
+	for _, tt := range tests {
+		deps := mockDeps()
+		deps.Snoozes = tt.snoozes
+
+		err := Terminate(deps, "foo", "prod", "us-east-1", "", tt.cluster)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.desc, err)
+		}
+
+		ttor := deps.T.(*mock.Terminator)
+		if got, want := ttor.Ncalls, tt.want; got != want {
+			t.Errorf("%s: got %d terminations, want %d", tt.desc, got, want)
+		}
+	}
+}
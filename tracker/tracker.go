@@ -17,8 +17,11 @@ package tracker
 
 import (
 	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/auditlog"
 	"github.com/Netflix/chaosmonkey/v2/config"
 	"github.com/Netflix/chaosmonkey/v2/deps"
+	"github.com/Netflix/chaosmonkey/v2/slack"
+	"github.com/Netflix/chaosmonkey/v2/webhook"
 	"github.com/pkg/errors"
 )
 
@@ -46,11 +49,28 @@ func getTrackers(cfg *config.Monkey) ([]chaosmonkey.Tracker, error) {
 }
 
 // getTracker returns a tracker by name
-// No trackers have been implemented yet
 func getTracker(kind string, cfg *config.Monkey) (chaosmonkey.Tracker, error) {
 	switch kind {
 	// As trackers are contributed to the open source project, they should
 	// be instantiated here
+	case "webhook":
+		w, err := webhook.NewFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return w, nil
+	case "slack":
+		s, err := slack.NewFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	case "file":
+		a, err := auditlog.NewFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return a, nil
 	default:
 		return nil, errors.Errorf("unsupported tracker: %s", kind)
 	}
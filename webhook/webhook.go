@@ -0,0 +1,231 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook provides a tracker that posts terminations to HTTP
+// endpoints as JSON.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/config"
+	"github.com/Netflix/chaosmonkey/v2/config/param"
+	"github.com/Netflix/chaosmonkey/v2/deps"
+)
+
+const (
+	// TimestampHeader is the header that holds the time a request was
+	// signed, in seconds since the Unix epoch
+	TimestampHeader = "X-Chaosmonkey-Timestamp"
+
+	// SignatureHeader is the header that holds the request signature:
+	// "sha256=" followed by the hex-encoded HMAC-SHA256 of the timestamp,
+	// a period and the body, keyed with the shared secret
+	SignatureHeader = "X-Chaosmonkey-Signature"
+)
+
+// Webhook is a tracker that posts terminations to a list of URLs
+type Webhook struct {
+	urls        []string
+	secret      []byte
+	client      *http.Client
+	maxAttempts int
+	backoff     time.Duration
+	blocking    bool
+
+	// sleep is replaced in tests
+	sleep func(time.Duration)
+}
+
+// Event is the JSON body posted for each termination
+type Event struct {
+	App           string    `json:"app"`
+	Account       string    `json:"account"`
+	Region        string    `json:"region"`
+	Stack         string    `json:"stack"`
+	Cluster       string    `json:"cluster"`
+	ASG           string    `json:"asg"`
+	InstanceID    string    `json:"instanceId"`
+	CloudProvider string    `json:"cloudProvider"`
+	Time          time.Time `json:"time"`
+	Leashed       bool      `json:"leashed"`
+}
+
+// NewFromConfig creates a new Webhook taking config parameters from cfg
+func NewFromConfig(cfg *config.Monkey) (*Webhook, error) {
+	urls, err := cfg.WebhookURLs()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(urls) == 0 {
+		return nil, errors.Errorf("%s not specified", param.WebhookURLs)
+	}
+
+	if cfg.WebhookMaxAttempts() < 1 {
+		return nil, errors.Errorf("%s must be at least 1", param.WebhookMaxAttempts)
+	}
+
+	var secret string
+	if cfg.WebhookEncryptedSecret() != "" {
+		decryptor, err := deps.GetDecryptor(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		secret, err = decryptor.Decrypt(cfg.WebhookEncryptedSecret())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return New(urls, secret, cfg.WebhookTimeout(), cfg.WebhookMaxAttempts(), cfg.WebhookBackoff(), cfg.WebhookBlocking()), nil
+}
+
+// New returns a Webhook that posts terminations to urls.
+//
+// If secret is not blank, requests are signed with it. Each request times out
+// after timeout, and delivery to a URL is attempted up to maxAttempts times,
+// waiting backoff before the first retry and twice as long before each
+// following one. If blocking is false, failed deliveries are logged instead
+// of being reported to the caller, so they don't prevent the termination.
+func New(urls []string, secret string, timeout time.Duration, maxAttempts int, backoff time.Duration, blocking bool) *Webhook {
+	return &Webhook{
+		urls:        urls,
+		secret:      []byte(secret),
+		client:      &http.Client{Timeout: timeout},
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		blocking:    blocking,
+		sleep:       time.Sleep,
+	}
+}
+
+// Track posts the termination to each URL
+func (w *Webhook) Track(t chaosmonkey.Termination) error {
+	i := t.Instance
+	body, err := json.Marshal(Event{
+		App:           i.AppName(),
+		Account:       i.AccountName(),
+		Region:        i.RegionName(),
+		Stack:         i.StackName(),
+		Cluster:       i.ClusterName(),
+		ASG:           i.ASGName(),
+		InstanceID:    i.ID(),
+		CloudProvider: i.CloudProvider(),
+		Time:          t.Time.UTC(),
+		Leashed:       t.Leashed,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal webhook event")
+	}
+
+	var failures []string
+	for _, url := range w.urls {
+		err := w.deliver(url, body)
+		if err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	err = errors.Errorf("webhook delivery failed: %s", strings.Join(failures, "; "))
+	if !w.blocking {
+		log.Printf("WARNING: %v", err)
+		return nil
+	}
+	return err
+}
+
+// deliver posts body to url, retrying on errors that may be transient
+func (w *Webhook) deliver(url string, body []byte) error {
+	delay := w.backoff
+	for attempt := 1; ; attempt++ {
+		retry, err := w.post(url, body)
+		if err == nil {
+			return nil
+		}
+
+		if !retry || attempt >= w.maxAttempts {
+			return errors.Wrapf(err, "%s: gave up after %d attempt(s)", url, attempt)
+		}
+
+		log.Printf("WARNING: webhook delivery to %s failed, retrying in %s: %v", url, delay, err)
+		w.sleep(delay)
+		delay *= 2
+	}
+}
+
+// post makes a single delivery attempt. It returns true if a failed attempt
+// is worth retrying.
+func (w *Webhook) post(url string, body []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if len(w.secret) > 0 {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(TimestampHeader, ts)
+		req.Header.Set(SignatureHeader, Sign(w.secret, ts, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, errors.Wrap(err, "request failed")
+	}
+
+	defer func() {
+		// Drain the body so that the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+
+	// Server errors and rate limiting may go away, other client errors won't
+	retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return retry, errors.Errorf("unexpected response: %s", resp.Status)
+}
+
+// Sign returns the signature of a request with the given timestamp and body,
+// as sent in the SignatureHeader. Receivers can compare it to the header to
+// verify a request.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("sha256=%s", hex.EncodeToString(mac.Sum(nil)))
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 Netflix, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Netflix/chaosmonkey/v2"
+	"github.com/Netflix/chaosmonkey/v2/mock"
+)
+
+// receiver is a webhook endpoint that responds with the given statuses in
+// turn, and then with 200
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+
+	status := http.StatusOK
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func termination() chaosmonkey.Termination {
+	return chaosmonkey.Termination{
+		Instance: mock.Instance{
+			App:        "foo",
+			Account:    "prod",
+			Stack:      "staging",
+			Cluster:    "foo-staging",
+			Region:     "us-east-1",
+			ASG:        "foo-staging-v001",
+			InstanceID: "i-12345678",
+		},
+		Time:    time.Date(2026, time.October, 13, 11, 30, 0, 0, time.UTC),
+		Leashed: true,
+	}
+}
+
+// newWebhook returns a Webhook that records its sleeps instead of sleeping
+func newWebhook(urls []string, secret string, blocking bool) (*Webhook, *[]time.Duration) {
+	var sleeps []time.Duration
+	w := New(urls, secret, time.Second, 3, time.Second, blocking)
+	w.sleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+	return w, &sleeps
+}
+
+func TestTrack(t *testing.T) {
+	r := &receiver{}
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	w, _ := newWebhook([]string{ts.URL, ts.URL + "/other"}, "", true)
+	err := w.Track(termination())
+	if err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+
+	if got, want := len(r.requests), 2; got != want {
+		t.Fatalf("got %d requests, want %d", got, want)
+	}
+
+	if got, want := r.requests[1].URL.Path, "/other"; got != want {
+		t.Errorf("got path %s, want %s", got, want)
+	}
+
+	var ev Event
+	err = json.Unmarshal(r.bodies[0], &ev)
+	if err != nil {
+		t.Fatalf("bad body %s: %v", r.bodies[0], err)
+	}
+
+	want := Event{
+		App:           "foo",
+		Account:       "prod",
+		Region:        "us-east-1",
+		Stack:         "staging",
+		Cluster:       "foo-staging",
+		ASG:           "foo-staging-v001",
+		InstanceID:    "i-12345678",
+		CloudProvider: "aws",
+		Time:          time.Date(2026, time.October, 13, 11, 30, 0, 0, time.UTC),
+		Leashed:       true,
+	}
+	if ev != want {
+		t.Errorf("got event %+v, want %+v", ev, want)
+	}
+
+	if got := r.requests[0].Header.Get(SignatureHeader); got != "" {
+		t.Errorf("got signature %s without a secret", got)
+	}
+}
+
+func TestTrackSigned(t *testing.T) {
+	r := &receiver{}
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	w, _ := newWebhook([]string{ts.URL}, "s3cr3t", true)
+	err := w.Track(termination())
+	if err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+
+	req := r.requests[0]
+	timestamp := req.Header.Get(TimestampHeader)
+	if timestamp == "" {
+		t.Fatalf("no %s header", TimestampHeader)
+	}
+
+	if got, want := req.Header.Get(SignatureHeader), Sign([]byte("s3cr3t"), timestamp, r.bodies[0]); got != want {
+		t.Errorf("got signature %s, want %s", got, want)
+	}
+
+	if got := Sign([]byte("wrong"), timestamp, r.bodies[0]); got == req.Header.Get(SignatureHeader) {
+		t.Error("signature verified with the wrong secret")
+	}
+}
+
+func TestTrackRetries(t *testing.T) {
+	r := &receiver{statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}}
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	w, sleeps := newWebhook([]string{ts.URL}, "", true)
+	err := w.Track(termination())
+	if err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+
+	if got, want := len(r.requests), 3; got != want {
+		t.Errorf("got %d requests, want %d", got, want)
+	}
+
+	want := []time.Duration{time.Second, 2 * time.Second}
+	if len(*sleeps) != len(want) || (*sleeps)[0] != want[0] || (*sleeps)[1] != want[1] {
+		t.Errorf("got backoff %v, want %v", *sleeps, want)
+	}
+}
+
+func TestTrackGivesUp(t *testing.T) {
+	r := &receiver{statuses: []int{500, 500, 500, 500}}
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	w, _ := newWebhook([]string{ts.URL}, "", true)
+	err := w.Track(termination())
+	if err == nil {
+		t.Fatal("Track succeeded, want error")
+	}
+
+	if got, want := len(r.requests), 3; got != want {
+		t.Errorf("got %d requests, want %d", got, want)
+	}
+}
+
+func TestTrackDoesntRetryClientErrors(t *testing.T) {
+	r := &receiver{statuses: []int{http.StatusBadRequest}}
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	w, _ := newWebhook([]string{ts.URL}, "", true)
+	err := w.Track(termination())
+	if err == nil {
+		t.Fatal("Track succeeded, want error")
+	}
+
+	if got, want := len(r.requests), 1; got != want {
+		t.Errorf("got %d requests, want %d", got, want)
+	}
+}
+
+func TestTrackNonBlocking(t *testing.T) {
+	r := &receiver{statuses: []int{400}}
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	w, _ := newWebhook([]string{ts.URL, ts.URL}, "", false)
+	err := w.Track(termination())
+	if err != nil {
+		t.Errorf("got %v, want failed deliveries to be ignored", err)
+	}
+
+	// Delivery continues to the other URLs
+	if got, want := len(r.requests), 2; got != want {
+		t.Errorf("got %d requests, want %d", got, want)
+	}
+}
+
+func TestTrackTimeout(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	w := New([]string{ts.URL}, "", 50*time.Millisecond, 1, 0, true)
+	err := w.Track(termination())
+	if err == nil {
+		t.Fatal("Track succeeded, want timeout")
+	}
+}